@@ -77,7 +77,8 @@ func (cl *commandline) backup(cmd *cobra.Command) {
 		Use:   "backup [--dbdir] [--manual-stop-start] [--uncompressed]",
 		Short: "Make a copy of the database files and folders",
 		Long: "Pause the immudb server, create and save on the server machine a snapshot " +
-			"of the database files and folders (zip on Windows, tar.gz on Linux or uncompressed).",
+			"of the database files and folders (zip on Windows, tar.gz on Linux or uncompressed). " +
+			"Databases are copied concurrently and the snapshot includes a manifest with the index and root of each one.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			dbDir, err := cmd.Flags().GetString("dbdir")
 			if err != nil {
@@ -113,22 +114,27 @@ func (cl *commandline) backup(cmd *cobra.Command) {
 func (cl *commandline) restore(cmd *cobra.Command) {
 	defaultDbDir := server.DefaultOptions().Dir
 	ccmd := &cobra.Command{
-		Use:   "restore snapshot-path [--dbdir] [--manual-stop-start]",
+		Use:   "restore snapshot-path [--dbdir] [--databases] [--manual-stop-start]",
 		Short: "Restore the database from a snapshot archive or folder",
 		Long: "Pause the immudb server and restore the database files and folders from a snapshot " +
-			"file (zip or tar.gz) or folder (uncompressed) residing on the server machine.",
+			"file (zip or tar.gz) or folder (uncompressed) residing on the server machine. " +
+			"With --databases only the listed databases are replaced, after checking them against the snapshot manifest.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			snapshotPath := args[0]
 			dbDir, err := cmd.Flags().GetString("dbdir")
 			if err != nil {
 				c.QuitToStdErr(err)
 			}
+			databases, err := cmd.Flags().GetStringSlice("databases")
+			if err != nil {
+				c.QuitToStdErr(err)
+			}
 			manualStopStart, err := cmd.Flags().GetBool("manual-stop-start")
 			if err != nil {
 				c.QuitToStdErr(err)
 			}
 			cl.askUserConfirmation("restore", manualStopStart)
-			autoBackupPath, err := offlineRestore(snapshotPath, dbDir, manualStopStart)
+			autoBackupPath, err := offlineRestore(snapshotPath, dbDir, databases, manualStopStart)
 			if err != nil {
 				c.QuitToStdErr(err)
 			}
@@ -139,6 +145,7 @@ func (cl *commandline) restore(cmd *cobra.Command) {
 		Args: cobra.ExactArgs(1),
 	}
 	ccmd.Flags().String("dbdir", defaultDbDir, fmt.Sprintf("path to the server database directory which will be replaced by the backup (default %s)", defaultDbDir))
+	ccmd.Flags().StringSlice("databases", nil, "comma separated list of the databases to restore, all of them when empty")
 	ccmd.Flags().Bool("manual-stop-start", false, "server stop before and restart after the backup are to be handled manually by the user (default false)")
 	cmd.AddCommand(ccmd)
 }
@@ -226,7 +233,8 @@ func offlineBackup(src string, uncompressed bool, manualStopStart bool) (string,
 
 	srcBase := filepath.Base(src)
 	snapshotPath := srcBase + "_bkp_" + time.Now().Format("2006-01-02_15-04-05")
-	if err = fs.CopyDir(src, snapshotPath); err != nil {
+	if _, err = backupDatabases(src, snapshotPath); err != nil {
+		os.RemoveAll(snapshotPath)
 		return "", err
 	}
 	// remove the immudb.identifier file from the backup
@@ -277,7 +285,7 @@ func offlineBackup(src string, uncompressed bool, manualStopStart bool) (string,
 	return absArchivePath, nil
 }
 
-func offlineRestore(src string, dst string, manualStopStart bool) (string, error) {
+func offlineRestore(src string, dst string, databases []string, manualStopStart bool) (string, error) {
 	snapshotPath := src
 	_, err := os.Stat(snapshotPath)
 	if err != nil {
@@ -323,6 +331,35 @@ func offlineRestore(src string, dst string, manualStopStart bool) (string, error
 			return "", err
 		}
 	}
+	manifest, err := readBackupManifest(extractedSnapshotDir)
+	if err != nil {
+		return "", err
+	}
+	if len(databases) > 0 {
+		if extract != nil {
+			defer os.RemoveAll(extractedSnapshotDir)
+		}
+		if manifest == nil {
+			return "", fmt.Errorf("snapshot %s has no manifest, only the whole data directory can be restored", snapshotPath)
+		}
+		if err = verifyDatabases(extractedSnapshotDir, manifest, databases); err != nil {
+			return "", err
+		}
+		dbDirAutoBackupPath := dst + "_bkp_before_restore_" + now
+		if err = restoreDatabases(extractedSnapshotDir, dst, databases, dbDirAutoBackupPath); err != nil {
+			return "", err
+		}
+		return dbDirAutoBackupPath, nil
+	}
+	if manifest != nil {
+		var names []string
+		for _, db := range manifest.Databases {
+			names = append(names, db.Name)
+		}
+		if err = verifyDatabases(extractedSnapshotDir, manifest, names); err != nil {
+			return "", err
+		}
+	}
 	// keep the same db identifier
 	if err = fs.CopyFile(
 		path.Join(dst, server.IDENTIFIER_FNAME),
@@ -0,0 +1,207 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immuadmin
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/fs"
+	"github.com/codenotary/immudb/pkg/logger"
+	"github.com/codenotary/immudb/pkg/store"
+)
+
+const backupManifestFilename = "manifest.json"
+
+// backupManifest describes the state of every database captured by a backup
+type backupManifest struct {
+	CreatedAt time.Time          `json:"createdAt"`
+	Databases []databaseSnapshot `json:"databases"`
+}
+
+// databaseSnapshot is the last index and merkle root of a database at backup time
+type databaseSnapshot struct {
+	Name  string `json:"name"`
+	Index uint64 `json:"index"`
+	Root  string `json:"root"`
+}
+
+func (m *backupManifest) database(name string) (databaseSnapshot, bool) {
+	for _, db := range m.Databases {
+		if db.Name == name {
+			return db, true
+		}
+	}
+	return databaseSnapshot{}, false
+}
+
+// snapshotDatabase reads the last index and root of the store found in dir. Opening a store can write to its files,
+// so a temporary copy of dir is opened and dir is left untouched.
+func snapshotDatabase(dir string) (databaseSnapshot, error) {
+	tmp, err := ioutil.TempDir("", "immuadmin_snapshot")
+	if err != nil {
+		return databaseSnapshot{}, err
+	}
+	defer os.RemoveAll(tmp)
+	copyDir := filepath.Join(tmp, filepath.Base(dir))
+	if err = fs.CopyDir(dir, copyDir); err != nil {
+		return databaseSnapshot{}, err
+	}
+	log := logger.NewSimpleLoggerWithLevel("immuadmin", os.Stderr, logger.LogError)
+	st, err := store.Open(store.DefaultOptions(copyDir, log))
+	if err != nil {
+		return databaseSnapshot{}, err
+	}
+	defer st.Close()
+	root, err := st.CurrentRoot()
+	if err != nil {
+		return databaseSnapshot{}, err
+	}
+	return databaseSnapshot{
+		Name:  filepath.Base(dir),
+		Index: root.GetIndex(),
+		Root:  hex.EncodeToString(root.GetRoot()),
+	}, nil
+}
+
+// backupDatabases copies the data directory src into dst, copying each database concurrently, and records
+// in dst a manifest with the state of each copied database
+func backupDatabases(src string, dst string) (*backupManifest, error) {
+	entries, err := ioutil.ReadDir(src)
+	if err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(dst, 0755); err != nil {
+		return nil, err
+	}
+	manifest := &backupManifest{CreatedAt: time.Now().UTC()}
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs []error
+	for _, entry := range entries {
+		srcPath := filepath.Join(src, entry.Name())
+		dstPath := filepath.Join(dst, entry.Name())
+		if !entry.IsDir() {
+			if entry.Mode()&os.ModeSymlink != 0 {
+				continue
+			}
+			if err = fs.CopyFile(srcPath, dstPath); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+				break
+			}
+			continue
+		}
+		wg.Add(1)
+		go func(name, srcPath, dstPath string) {
+			defer wg.Done()
+			err := fs.CopyDir(srcPath, dstPath)
+			var db databaseSnapshot
+			if err == nil {
+				db, err = snapshotDatabase(dstPath)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("error backing up database %s: %v", name, err))
+				return
+			}
+			manifest.Databases = append(manifest.Databases, db)
+		}(entry.Name(), srcPath, dstPath)
+	}
+	wg.Wait()
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+	sort.Slice(manifest.Databases, func(i, j int) bool {
+		return manifest.Databases[i].Name < manifest.Databases[j].Name
+	})
+	content, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err = ioutil.WriteFile(filepath.Join(dst, backupManifestFilename), content, 0644); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// readBackupManifest returns the manifest of the snapshot in dir, or nil for snapshots taken without one
+func readBackupManifest(dir string) (*backupManifest, error) {
+	content, err := ioutil.ReadFile(filepath.Join(dir, backupManifestFilename))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	manifest := &backupManifest{}
+	if err = json.Unmarshal(content, manifest); err != nil {
+		return nil, fmt.Errorf("invalid backup manifest: %v", err)
+	}
+	return manifest, nil
+}
+
+// verifyDatabases checks that the given databases of the snapshot in dir are in the state recorded by the manifest
+func verifyDatabases(dir string, manifest *backupManifest, names []string) error {
+	for _, name := range names {
+		expected, ok := manifest.database(name)
+		if !ok {
+			return fmt.Errorf("database %s is not in the backup", name)
+		}
+		actual, err := snapshotDatabase(filepath.Join(dir, name))
+		if err != nil {
+			return fmt.Errorf("error reading database %s from the backup: %v", name, err)
+		}
+		if actual.Index != expected.Index || actual.Root != expected.Root {
+			return fmt.Errorf(
+				"database %s does not match the backup manifest: index %d root %s, expected index %d root %s",
+				name, actual.Index, actual.Root, expected.Index, expected.Root)
+		}
+	}
+	return nil
+}
+
+// restoreDatabases replaces the given databases of the data directory dst with the ones of the snapshot in src,
+// moving the replaced ones into autoBackupPath
+func restoreDatabases(src string, dst string, names []string, autoBackupPath string) error {
+	if err := os.MkdirAll(autoBackupPath, 0755); err != nil {
+		return err
+	}
+	for _, name := range names {
+		dbPath := filepath.Join(dst, name)
+		if _, err := os.Stat(dbPath); err == nil {
+			if err = os.Rename(dbPath, filepath.Join(autoBackupPath, name)); err != nil {
+				return fmt.Errorf("error moving previous database %s to %s during restore: %v", name, autoBackupPath, err)
+			}
+		} else if !os.IsNotExist(err) {
+			return err
+		}
+		if err := fs.CopyDir(filepath.Join(src, name), dbPath); err != nil {
+			return fmt.Errorf("error restoring database %s: %v", name, err)
+		}
+	}
+	return nil
+}
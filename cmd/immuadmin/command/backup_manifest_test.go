@@ -0,0 +1,118 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immuadmin
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/logger"
+	"github.com/codenotary/immudb/pkg/store"
+	"github.com/stretchr/testify/require"
+)
+
+func setKeys(t *testing.T, dir string, keys ...string) {
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	st, err := store.Open(store.DefaultOptions(dir, logger.NewSimpleLoggerWithLevel("test", os.Stderr, logger.LogError)))
+	require.NoError(t, err)
+	defer st.Close()
+	for _, key := range keys {
+		_, err = st.Set(schema.KeyValue{Key: []byte(key), Value: []byte(key)})
+		require.NoError(t, err)
+	}
+}
+
+// dirFiles returns the size and modification time of every file under dir
+func dirFiles(t *testing.T, dir string) map[string]string {
+	files := map[string]string{}
+	require.NoError(t, filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		files[path] = fmt.Sprintf("%d %s", info.Size(), info.ModTime())
+		return nil
+	}))
+	return files
+}
+
+func TestBackupManifest(t *testing.T) {
+	root, err := ioutil.TempDir("", "immuadmin_backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	dataDir := filepath.Join(root, "data")
+	setKeys(t, filepath.Join(dataDir, "rome"), "a", "b", "c")
+	setKeys(t, filepath.Join(dataDir, "milan"), "a")
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dataDir, "immudb.identifier"), []byte("id"), 0644))
+
+	snapshotDir := filepath.Join(root, "snapshot")
+	manifest, err := backupDatabases(dataDir, snapshotDir)
+	require.NoError(t, err)
+	require.Len(t, manifest.Databases, 2)
+	require.Equal(t, "milan", manifest.Databases[0].Name)
+	require.Equal(t, uint64(0), manifest.Databases[0].Index)
+	require.Equal(t, "rome", manifest.Databases[1].Name)
+	require.Equal(t, uint64(2), manifest.Databases[1].Index)
+	require.FileExists(t, filepath.Join(snapshotDir, "immudb.identifier"))
+
+	read, err := readBackupManifest(snapshotDir)
+	require.NoError(t, err)
+	require.Equal(t, manifest.Databases, read.Databases)
+	before := dirFiles(t, snapshotDir)
+	require.NoError(t, verifyDatabases(snapshotDir, read, []string{"milan", "rome"}))
+	require.Equal(t, before, dirFiles(t, snapshotDir), "verifying must not modify the backup")
+	require.Error(t, verifyDatabases(snapshotDir, read, []string{"venice"}))
+
+	// only rome is rolled back to the backup, milan keeps its newer entries
+	setKeys(t, filepath.Join(dataDir, "rome"), "d")
+	setKeys(t, filepath.Join(dataDir, "milan"), "b")
+	autoBackupPath, err := offlineRestore(snapshotDir, dataDir, []string{"rome"}, true)
+	require.NoError(t, err)
+	defer os.RemoveAll(autoBackupPath)
+	require.DirExists(t, filepath.Join(autoBackupPath, "rome"))
+
+	rome, err := snapshotDatabase(filepath.Join(dataDir, "rome"))
+	require.NoError(t, err)
+	require.Equal(t, manifest.Databases[1], rome)
+	milan, err := snapshotDatabase(filepath.Join(dataDir, "milan"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), milan.Index)
+
+	// a tampered database is refused
+	setKeys(t, filepath.Join(snapshotDir, "milan"), "x")
+	_, err = offlineRestore(snapshotDir, dataDir, []string{"milan"}, true)
+	require.Error(t, err)
+}
+
+func TestBackupDatabasesFileError(t *testing.T) {
+	root, err := ioutil.TempDir("", "immuadmin_backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	dataDir := filepath.Join(root, "data")
+	setKeys(t, filepath.Join(dataDir, "milan"), "a")
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dataDir, "zz.file"), []byte("zz"), 0644))
+
+	// the file can't be copied over a directory, the database copy started before must still be waited for
+	snapshotDir := filepath.Join(root, "snapshot")
+	require.NoError(t, os.MkdirAll(filepath.Join(snapshotDir, "zz.file"), 0755))
+	_, err = backupDatabases(dataDir, snapshotDir)
+	require.Error(t, err)
+	require.NoError(t, os.RemoveAll(snapshotDir))
+}
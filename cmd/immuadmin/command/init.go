@@ -53,6 +53,8 @@ func Init(cmd *cobra.Command, cmdName string, o *c.Options) {
 	cl.backup(cmd)
 	cl.restore(cmd)
 	cl.printTree(cmd)
+	cl.report(cmd)
+	cl.logs(cmd)
 
 	cld := new(commandlineDisc)
 	cld.service(cmd)
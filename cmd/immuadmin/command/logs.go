@@ -0,0 +1,71 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immuadmin
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	c "github.com/codenotary/immudb/cmd/helper"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/spf13/cobra"
+)
+
+func (cl *commandline) logs(cmd *cobra.Command) {
+	ccmd := &cobra.Command{
+		Use:               "logs",
+		Short:             "Show the recent server log lines, and follow the new ones with the '-f' option",
+		Aliases:           []string{"lg"},
+		PersistentPreRunE: cl.checkLoggedInAndConnect,
+		PersistentPostRun: cl.disconnect,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			lines, err := cmd.Flags().GetUint32("lines")
+			if err != nil {
+				c.QuitToStdErr(err)
+			}
+			level, err := cmd.Flags().GetString("level")
+			if err != nil {
+				c.QuitToStdErr(err)
+			}
+			follow, err := cmd.Flags().GetBool("follow")
+			if err != nil {
+				c.QuitToStdErr(err)
+			}
+			req := &schema.LogRequest{Lines: lines, Level: level, Follow: follow}
+			if err := cl.immuClient.Logs(cl.context, req, func(line *schema.LogLine) error {
+				return printLogLine(os.Stdout, line)
+			}); err != nil {
+				c.QuitWithUserError(err)
+			}
+			return nil
+		},
+		Args: cobra.NoArgs,
+	}
+	ccmd.Flags().Uint32P("lines", "n", 100, "number of recent lines to show (0 shows all the lines retained by the server)")
+	ccmd.Flags().String("level", "info", "minimum level of the lines to show: debug, info, warn or error")
+	ccmd.Flags().BoolP("follow", "f", false, "keep showing the new lines until interrupted")
+	cmd.AddCommand(ccmd)
+}
+
+func printLogLine(out io.Writer, line *schema.LogLine) error {
+	_, err := fmt.Fprintf(out, "%s %-5s %s\n",
+		time.Unix(0, line.LoggedAt).Format("2006/01/02 15:04:05.000"), strings.ToUpper(line.Level), line.Message)
+	return err
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immuadmin
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	c "github.com/codenotary/immudb/cmd/helper"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/spf13/cobra"
+)
+
+func (cl *commandline) report(cmd *cobra.Command) {
+	ccmd := &cobra.Command{
+		Use:               "report",
+		Short:             "Show keys per prefix, value sizes distribution and growth of the current database",
+		Aliases:           []string{"rp"},
+		PersistentPreRunE: cl.checkLoggedInAndConnect,
+		PersistentPostRun: cl.disconnect,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prefixLength, err := cmd.Flags().GetUint32("prefix-length")
+			if err != nil {
+				c.QuitToStdErr(err)
+			}
+			report, err := cl.immuClient.Report(cl.context, prefixLength)
+			if err != nil {
+				c.QuitWithUserError(err)
+			}
+			printReport(os.Stdout, report)
+			return nil
+		},
+		Args: cobra.NoArgs,
+	}
+	ccmd.Flags().Uint32P("prefix-length", "l", 1, "number of leading key bytes used to group keys (0 disables the per prefix summary)")
+	cmd.AddCommand(ccmd)
+}
+
+func printReport(out io.Writer, report *schema.StoreReport) {
+	fmt.Fprintf(out, "Keys:\t\t%d\n", report.Keys)
+	fmt.Fprintf(out, "Entries:\t%d\n", report.Entries)
+	fmt.Fprintf(out, "Value bytes:\t%d\n", report.ValueBytes)
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	if len(report.Prefixes) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "Prefix\tKeys\tEntries\tValue bytes")
+		for _, p := range report.Prefixes {
+			fmt.Fprintf(w, "%s\t%d\t%d\t%d\n", strconv.Quote(string(p.Prefix)), p.Keys, p.Entries, p.ValueBytes)
+		}
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Value size\tEntries")
+	var min uint64
+	for _, b := range report.ValueSizes {
+		if b.MaxSize == 0 {
+			fmt.Fprintf(w, "> %d B\t%d\n", min, b.Entries)
+			continue
+		}
+		fmt.Fprintf(w, "<= %d B\t%d\n", b.MaxSize, b.Entries)
+		min = b.MaxSize
+	}
+
+	if len(report.Growth) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "Day (structured values)\tEntries\tValue bytes")
+		for _, g := range report.Growth {
+			fmt.Fprintf(w, "%s\t%d\t%d\n", g.Day, g.Entries, g.ValueBytes)
+		}
+	}
+	w.Flush()
+}
@@ -36,4 +36,9 @@ audit = false
 audit-interval = "5m"
 audit-username = "immugwauditor"
 # password can be plaintext or base64
-audit-password = ""`)
+audit-password = ""
+proof-service = false
+proof-service-interval = "1m"
+proof-service-username = ""
+# password can be plaintext or base64
+proof-service-password = ""`)
@@ -53,12 +53,29 @@ var (
 		"audit_prev_root_per_server",
 		"Previous root index used for the latest audit.",
 	)
+	AuditRunsPerServer = newAuditCounterVec(
+		"audit_runs_per_server",
+		"Number of audits run.",
+	)
+	AuditVerificationFailuresPerServer = newAuditCounterVec(
+		"audit_verification_failures_per_server",
+		"Number of audits whose consistency proof failed to verify, any of them is a tamper signal.",
+	)
+	AuditVerifiedRootPerDatabase = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "audit_verified_root_per_database",
+			Help:      "Root index last verified as consistent by the audits of a database.",
+		},
+		[]string{"server_id", "server_address", "database"},
+	)
 )
 
 func (p *prometheusMetrics) init(serverid string) {
 	p.server_address = fmt.Sprintf("%s:%s", viper.GetString("immudb-address"), viper.GetString("immudb-port"))
 	p.server_id = serverid
-	prometheus.MustRegister(AuditResultPerServer, AuditCurrRootPerServer, AuditRunAtPerServer, AuditPrevRootPerServer)
+	prometheus.MustRegister(AuditResultPerServer, AuditCurrRootPerServer, AuditRunAtPerServer, AuditPrevRootPerServer,
+		AuditRunsPerServer, AuditVerificationFailuresPerServer, AuditVerifiedRootPerDatabase)
 	AuditResultPerServer.WithLabelValues(p.server_id, p.server_address).Set(-1)
 	AuditCurrRootPerServer.WithLabelValues(p.server_id, p.server_address).Set(-1)
 	AuditRunAtPerServer.WithLabelValues(p.server_id, p.server_address).SetToCurrentTime()
@@ -88,9 +105,21 @@ func newAuditGaugeVec(name string, help string) *prometheus.GaugeVec {
 	)
 }
 
+func newAuditCounterVec(name string, help string) *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      name,
+			Help:      help,
+		},
+		[]string{"server_id", "server_address"},
+	)
+}
+
 func (p *prometheusMetrics) updateMetrics(
 	serverID string,
 	serverAddress string,
+	database string,
 	checked bool,
 	withError bool,
 	result bool,
@@ -126,4 +155,14 @@ func (p *prometheusMetrics) updateMetrics(
 		WithLabelValues(p.server_id, p.server_address).Set(currRootIndex)
 	AuditRunAtPerServer.
 		WithLabelValues(p.server_id, p.server_address).SetToCurrentTime()
+	AuditRunsPerServer.
+		WithLabelValues(p.server_id, p.server_address).Inc()
+	if checked && !result {
+		AuditVerificationFailuresPerServer.
+			WithLabelValues(p.server_id, p.server_address).Inc()
+	}
+	if checked && result && !withError {
+		AuditVerifiedRootPerDatabase.
+			WithLabelValues(p.server_id, p.server_address, database).Set(currRootIndex)
+	}
 }
@@ -66,7 +66,11 @@ func (cli *cli) initCommands() {
 	cli.Register(&command{"history", "Fetch history for the item having the specified key", cli.history, []string{"key"}, false})
 	cli.Register(&command{"version", "Print version", cli.version, nil, false})
 
+	// Attestation commands
+	cli.Register(&command{"attest", "Record and verify the attestation of an image or artifact digest, with an optional SBOM digest", cli.attest, []string{"digest", "builder"}, true})
+	cli.Register(&command{"verify-attestation", "Verify the attestation of an image or artifact digest, checking the builder and SBOM digest if given", cli.verifyAttestation, []string{"digest"}, true})
+
 	// Admin Commands
-	cli.Register(&command{"database", "Database operatons (help,create,list)", cli.CreateDatabase, nil, true})
+	cli.Register(&command{"database", "Database operatons (help,create,list,delete,deleted,restore,purge)", cli.CreateDatabase, nil, true})
 	cli.Register(&command{"user", "User operations (help, create, list, activate/deactivate, changepassword,permission grant, permission revoke)", cli.UserOperations, nil, true})
 }
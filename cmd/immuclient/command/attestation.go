@@ -0,0 +1,61 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package immuclient
+
+import (
+	"fmt"
+
+	c "github.com/codenotary/immudb/cmd/helper"
+	"github.com/spf13/cobra"
+)
+
+func (cl *commandline) attest(cmd *cobra.Command) {
+	ccmd := &cobra.Command{
+		Use:               "attest digest builder [sbomdigest]",
+		Short:             "Record and verify the attestation of an image or artifact digest, e.g. sha256:3f2a...",
+		PersistentPreRunE: cl.connect,
+		PersistentPostRun: cl.disconnect,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resp, err := cl.immucl.Attest(args)
+			if err != nil {
+				c.QuitToStdErr(err)
+			}
+			fmt.Println(resp)
+			return nil
+		},
+		Args: cobra.RangeArgs(2, 3),
+	}
+	cmd.AddCommand(ccmd)
+}
+
+func (cl *commandline) verifyAttestation(cmd *cobra.Command) {
+	ccmd := &cobra.Command{
+		Use:               "verify-attestation digest [builder [sbomdigest]]",
+		Short:             "Verify the attestation of an image or artifact digest, checking the builder and SBOM digest if given",
+		PersistentPreRunE: cl.connect,
+		PersistentPostRun: cl.disconnect,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resp, err := cl.immucl.VerifyAttestation(args)
+			if err != nil {
+				c.QuitToStdErr(err)
+			}
+			fmt.Println(resp)
+			return nil
+		},
+		Args: cobra.RangeArgs(1, 3),
+	}
+	cmd.AddCommand(ccmd)
+}
@@ -105,6 +105,9 @@ IMPORTANT: All get and safeget functions return base64-encoded keys and values,
 	cl.user(cmd)
 	cl.database(cmd)
 	cl.use(cmd)
+	// attestations
+	cl.attest(cmd)
+	cl.verifyAttestation(cmd)
 	// man file generator
 	cmd.AddCommand(man.Generate(cmd, "immuclient", "./cmd/docs/man/immuclient"))
 	return cmd
@@ -125,7 +125,7 @@ func (cl *commandline) database(cmd *cobra.Command) {
 		Aliases:           []string{"d"},
 		PersistentPreRunE: cl.connect,
 		PersistentPostRun: cl.disconnect,
-		ValidArgs:         []string{"help", "list", "create databasename"},
+		ValidArgs:         []string{"help", "list", "create databasename", "create databasename collation", "create databasename from templatename withdata", "delete databasename", "deleted", "restore databasename", "purge databasename"},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			resp, err := cl.immucl.CreateDatabase(args)
 			if err != nil {
@@ -134,7 +134,7 @@ func (cl *commandline) database(cmd *cobra.Command) {
 			fmt.Println(resp)
 			return nil
 		},
-		Args: cobra.MaximumNArgs(3),
+		Args: cobra.MaximumNArgs(5),
 	}
 	cmd.AddCommand(ccmd)
 }
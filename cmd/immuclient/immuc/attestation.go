@@ -0,0 +1,83 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package immuc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/codenotary/immudb/pkg/client/attestation"
+)
+
+func printAttestation(r *attestation.Record) string {
+	str := strings.Builder{}
+	str.WriteString(fmt.Sprintf("index:		%d\n", r.Index))
+	str.WriteString(fmt.Sprintf("digest:		%s\n", r.Digest))
+	if r.Builder != "" {
+		str.WriteString(fmt.Sprintf("builder:	%s\n", r.Builder))
+	}
+	if r.SBOMDigest != "" {
+		str.WriteString(fmt.Sprintf("sbom:		%s\n", r.SBOMDigest))
+	}
+	str.WriteString(fmt.Sprintf("verified:	%t", r.Verified))
+	return str.String()
+}
+
+func (i *immuc) Attest(args []string) (string, error) {
+	a := &attestation.Attestation{Digest: args[0], Builder: args[1]}
+	if len(args) > 2 {
+		a.SBOMDigest = args[2]
+	}
+	ctx := context.Background()
+	r, err := attestation.Attest(ctx, i.ImmuClient, a)
+	if err != nil {
+		rpcerrors := strings.SplitAfter(err.Error(), "=")
+		if len(rpcerrors) > 1 {
+			return rpcerrors[len(rpcerrors)-1], nil
+		}
+		return "", err
+	}
+	return printAttestation(r), nil
+}
+
+func (i *immuc) VerifyAttestation(args []string) (string, error) {
+	expected := &attestation.Attestation{}
+	if len(args) > 1 {
+		expected.Builder = args[1]
+	}
+	if len(args) > 2 {
+		expected.SBOMDigest = args[2]
+	}
+	ctx := context.Background()
+	r, err := attestation.Verify(ctx, i.ImmuClient, args[0], expected)
+	switch err {
+	case nil:
+		return printAttestation(r), nil
+	case attestation.ErrNotAttested:
+		return fmt.Sprintf("artifact not attested: %s", args[0]), nil
+	case attestation.ErrMismatch:
+		if r == nil {
+			return "", err
+		}
+		return "", fmt.Errorf("%v, recorded attestation:\n%s", err, printAttestation(r))
+	}
+	rpcerrors := strings.SplitAfter(err.Error(), "=")
+	if len(rpcerrors) > 1 {
+		return rpcerrors[len(rpcerrors)-1], nil
+	}
+	return "", err
+}
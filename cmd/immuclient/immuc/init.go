@@ -62,6 +62,8 @@ type Client interface {
 	CreateDatabase(args []string) (string, error)
 	UseDatabase(args []string) (string, error)
 	UserOperations(args []string) (string, error)
+	Attest(args []string) (string, error)
+	VerifyAttestation(args []string) (string, error)
 }
 
 // Init ...
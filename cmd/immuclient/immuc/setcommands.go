@@ -25,6 +25,7 @@ import (
 	"io/ioutil"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/client"
@@ -196,7 +197,17 @@ func (i *immuc) CreateDatabase(args []string) (string, error) {
 	case "help":
 		fmt.Println("database list  -- shows databases and their details")
 		fmt.Println()
-		fmt.Println("database create database_name  -- create a new database")
+		fmt.Println("database create database_name [collation]  -- create a new database, scans order its keys by the collation: binary (default), case-insensitive or numeric")
+		fmt.Println()
+		fmt.Println("database create database_name from template_name [withdata]  -- create a new database from a template database, optionally copying its data")
+		fmt.Println()
+		fmt.Println("database delete database_name  -- hide a database and reject writes into it, its data is retained until it is purged")
+		fmt.Println()
+		fmt.Println("database deleted  -- shows the deleted databases which can be restored")
+		fmt.Println()
+		fmt.Println("database restore database_name  -- restore a deleted database")
+		fmt.Println()
+		fmt.Println("database purge database_name  -- permanently drop a deleted database once its restore window is over")
 		return "", nil
 	case "create":
 		if len(args) < 2 {
@@ -205,9 +216,27 @@ func (i *immuc) CreateDatabase(args []string) (string, error) {
 		dbname := []byte(args[1])
 
 		ctx := context.Background()
-		resp, err := i.ImmuClient.CreateDatabase(ctx, &schema.Database{
+		if len(args) > 3 {
+			if args[2] != "from" || (len(args) == 5 && args[4] != "withdata") || len(args) > 5 {
+				return "Incorrect parameters for this command. Please type 'database help' for more information.", nil
+			}
+			resp, err := i.ImmuClient.CreateDatabaseFromTemplate(ctx, &schema.DatabaseTemplateRequest{
+				Databasename: string(dbname),
+				Template:     args[3],
+				WithData:     len(args) == 5,
+			})
+			if err != nil {
+				return "", err
+			}
+			return resp.Error.Errormessage, nil
+		}
+		db := &schema.Database{
 			Databasename: string(dbname),
-		})
+		}
+		if len(args) == 3 {
+			db.Collation = args[2]
+		}
+		resp, err := i.ImmuClient.CreateDatabase(ctx, db)
 		if err != nil {
 			return "", err
 		}
@@ -218,7 +247,43 @@ func (i *immuc) CreateDatabase(args []string) (string, error) {
 			return "", err
 		}
 		for _, val := range resp.Databases {
-			fmt.Println(val.Databasename)
+			if val.Collation == "" || val.Collation == "binary" {
+				fmt.Println(val.Databasename)
+				continue
+			}
+			fmt.Printf("%s\t%s collation\n", val.Databasename, val.Collation)
+		}
+		return "", nil
+	case "delete", "restore", "purge":
+		if len(args) != 2 {
+			return "Incorrect number of parameters for this command. Please type 'database help' for more information.", nil
+		}
+		db := &schema.Database{Databasename: args[1]}
+		ctx := context.Background()
+		switch command {
+		case "delete":
+			resp, err := i.ImmuClient.DeleteDatabase(ctx, db)
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("Deleted Database: %s, it can be purged after %s", resp.Databasename, time.Unix(resp.PurgeableAt, 0)), nil
+		case "restore":
+			if err := i.ImmuClient.RestoreDatabase(ctx, db); err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("Restored Database: %s", args[1]), nil
+		}
+		if err := i.ImmuClient.PurgeDatabase(ctx, db); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Purged Database: %s", args[1]), nil
+	case "deleted":
+		resp, err := i.ImmuClient.DeletedDatabaseList(context.Background())
+		if err != nil {
+			return "", err
+		}
+		for _, val := range resp.Databases {
+			fmt.Printf("%s\tdeleted at %s\tpurgeable after %s\n", val.Databasename, time.Unix(val.DeletedAt, 0), time.Unix(val.PurgeableAt, 0))
 		}
 		return "", nil
 	}
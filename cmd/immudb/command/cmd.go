@@ -19,12 +19,14 @@ package immudb
 import (
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/codenotary/immudb/cmd/docs/man"
 	c "github.com/codenotary/immudb/cmd/helper"
 	"github.com/codenotary/immudb/cmd/version"
 	"github.com/codenotary/immudb/pkg/logger"
 	"github.com/codenotary/immudb/pkg/server"
+	"github.com/codenotary/immudb/pkg/store"
 	"github.com/spf13/cobra"
 	"github.com/spf13/cobra/doc"
 	"github.com/spf13/viper"
@@ -161,6 +163,59 @@ func parseOptions(cmd *cobra.Command) (options server.Options, err error) {
 	devMode := viper.GetBool("devmode")
 	adminPassword := viper.GetString("admin-password")
 	maintenance := viper.GetBool("maintenance")
+	maxResultItems := viper.GetInt("max-result-items")
+	maxResultBytes := viper.GetInt("max-result-bytes")
+	maxExecutionMemory := viper.GetInt("max-execution-memory")
+	maxTimestampSkew := viper.GetDuration("max-timestamp-skew")
+	authorizerAddress := viper.GetString("authorizer-address")
+	quotaSoftBytes := viper.GetUint64("quota-soft-bytes")
+	quotaHardBytes := viper.GetUint64("quota-hard-bytes")
+	singlePort := viper.GetBool("single-port")
+	shedPendingEntries := viper.GetUint64("shed-pending-entries")
+	shedHeapBytes := viper.GetUint64("shed-heap-bytes")
+	adminPort := viper.GetInt("admin-port")
+	adminMTLs := viper.GetBool("admin-mtls")
+	logSinkAddress := viper.GetString("log-sink-address")
+	logSinkPort := viper.GetInt("log-sink-port")
+	logSinkToken := viper.GetString("log-sink-token")
+	logSinkSyslogPort := viper.GetInt("log-sink-syslog-port")
+	logSinkDatabase := viper.GetString("log-sink-database")
+	logSinkPrefix := viper.GetString("log-sink-prefix")
+	logSinkBatchSize := viper.GetInt("log-sink-batch-size")
+	dbRestoreWindow := viper.GetDuration("db-restore-window")
+	validatorAddress := viper.GetString("validator-address")
+	validatorPrefixes := viper.GetStringSlice("validator-prefixes")
+	hooksMTLs := viper.GetBool("hooks-mtls")
+	logBufferLines := viper.GetInt("log-buffer-lines")
+	storeProfile := viper.GetString("store-profile")
+	if !store.ValidProfile(storeProfile) {
+		return options, store.ErrUnknownProfile
+	}
+	bulkLoadDir := viper.GetString("bulk-load-dir")
+	adminCertificate, err := c.ResolvePath(viper.GetString("admin-certificate"), true)
+	if err != nil {
+		return options, err
+	}
+	adminPkey, err := c.ResolvePath(viper.GetString("admin-pkey"), true)
+	if err != nil {
+		return options, err
+	}
+	adminClientcas, err := c.ResolvePath(viper.GetString("admin-clientcas"), true)
+	if err != nil {
+		return options, err
+	}
+	hooksCertificate, err := c.ResolvePath(viper.GetString("hooks-certificate"), true)
+	if err != nil {
+		return options, err
+	}
+	hooksPkey, err := c.ResolvePath(viper.GetString("hooks-pkey"), true)
+	if err != nil {
+		return options, err
+	}
+	hooksClientcas, err := c.ResolvePath(viper.GetString("hooks-clientcas"), true)
+	if err != nil {
+		return options, err
+	}
 
 	options = server.
 		DefaultOptions().
@@ -177,7 +232,33 @@ func parseOptions(cmd *cobra.Command) (options server.Options, err error) {
 		WithCorruptionCheck(consistencyCheck).
 		WithDevMode(devMode).
 		WithAdminPassword(adminPassword).
-		WithMaintenance(maintenance)
+		WithMaintenance(maintenance).
+		WithMaxResultItems(maxResultItems).
+		WithMaxResultBytes(maxResultBytes).
+		WithMaxExecutionMemory(maxExecutionMemory).
+		WithMaxTimestampSkew(maxTimestampSkew).
+		WithAuthorizerAddress(authorizerAddress).
+		WithQuotaSoftBytes(quotaSoftBytes).
+		WithQuotaHardBytes(quotaHardBytes).
+		WithSinglePort(singlePort).
+		WithShedPendingEntries(shedPendingEntries).
+		WithShedHeapBytes(shedHeapBytes).
+		WithAdminPort(adminPort).
+		WithAdminMTLs(adminMTLs).
+		WithLogSinkAddress(logSinkAddress).
+		WithLogSinkPort(logSinkPort).
+		WithLogSinkToken(logSinkToken).
+		WithLogSinkSyslogPort(logSinkSyslogPort).
+		WithLogSinkDatabase(logSinkDatabase).
+		WithLogSinkPrefix(logSinkPrefix).
+		WithLogSinkBatchSize(logSinkBatchSize).
+		WithDbRestoreWindow(dbRestoreWindow).
+		WithValidatorAddress(validatorAddress).
+		WithValidatorPrefixes(validatorPrefixes).
+		WithHooksMTLs(hooksMTLs).
+		WithLogBufferLines(logBufferLines).
+		WithStoreProfile(storeProfile).
+		WithBulkLoadDir(bulkLoadDir)
 	if mtls {
 		// todo https://golang.org/src/crypto/x509/root_linux.go
 		options.MTLsOptions = server.DefaultMTLsOptions().
@@ -185,6 +266,18 @@ func parseOptions(cmd *cobra.Command) (options server.Options, err error) {
 			WithPkey(pkey).
 			WithClientCAs(clientcas)
 	}
+	if adminMTLs {
+		options.AdminMTLsOptions = server.DefaultMTLsOptions().
+			WithCertificate(adminCertificate).
+			WithPkey(adminPkey).
+			WithClientCAs(adminClientcas)
+	}
+	if hooksMTLs {
+		options.HooksMTLsOptions = server.DefaultMTLsOptions().
+			WithCertificate(hooksCertificate).
+			WithPkey(hooksPkey).
+			WithClientCAs(hooksClientcas)
+	}
 	return options, nil
 }
 
@@ -206,6 +299,38 @@ func setupFlags(cmd *cobra.Command, options server.Options, mtlsOptions server.M
 	cmd.Flags().Bool("devmode", options.DevMode, "enable dev mode: accept remote connections without auth")
 	cmd.Flags().String("admin-password", options.AdminPassword, "admin password (default is 'immu') as plain-text or base64 encoded (must be prefixed with 'enc:' if it is encoded)")
 	cmd.Flags().Bool("maintenance", options.GetMaintenance(), "override the authentication flag")
+	cmd.Flags().Int("max-result-items", options.MaxResultItems, "maximum number of items a single query can return (0 means no limit)")
+	cmd.Flags().Int("max-result-bytes", options.MaxResultBytes, "maximum size in bytes of a single query result (0 means no limit)")
+	cmd.Flags().Int("max-execution-memory", options.MaxExecutionMemory, "maximum memory in bytes a single query can hold while it runs, its result included (0 means no limit)")
+	cmd.Flags().Duration("max-timestamp-skew", options.MaxTimestampSkew, "reject structured values whose timestamp goes backwards or ahead of the server clock by more than this (0 disables the check)")
+	cmd.Flags().String("authorizer-address", options.AuthorizerAddress, "address of an external ImmuAuthorizer gRPC service consulted on each operation, e.g. 127.0.0.1:9000")
+	cmd.Flags().Uint64("quota-soft-bytes", options.QuotaSoftBytes, "bytes a user can write before warnings are logged and returned to the client (0 means no limit)")
+	cmd.Flags().Uint64("quota-hard-bytes", options.QuotaHardBytes, "bytes a user can write before further writes are rejected (0 means no limit)")
+	cmd.Flags().Bool("single-port", options.SinglePort, "serve the REST gateway, metrics and health endpoints on the gRPC port (not supported with MTLS)")
+	cmd.Flags().Uint64("shed-pending-entries", options.ShedPendingEntries, "reject scans, histories and other expensive reads while more entries than this wait to be added into the merkle tree (0 disables it)")
+	cmd.Flags().Uint64("shed-heap-bytes", options.ShedHeapBytes, "reject scans, histories and other expensive reads while the heap is larger than this (0 disables it)")
+	cmd.Flags().Int("admin-port", options.AdminPort, "serve user management, database management, backup and reporting methods only on this port (0 serves them on the main port)")
+	cmd.Flags().Bool("admin-mtls", options.AdminMTLs, "enable mutual tls on the admin port")
+	cmd.Flags().String("admin-certificate", mtlsOptions.Certificate, "admin port server certificate file path")
+	cmd.Flags().String("admin-pkey", mtlsOptions.Pkey, "admin port server private key path")
+	cmd.Flags().String("admin-clientcas", mtlsOptions.ClientCAs, "admin port clients certificates list. Aka certificate authority")
+	cmd.Flags().String("log-sink-address", options.LogSinkAddress, "address the log sink endpoints listen on; the HTTP endpoint requires --log-sink-token unless it is a loopback address")
+	cmd.Flags().Int("log-sink-port", options.LogSinkPort, "accept JSON log events posted to http://address:port/<stream> and store them in the log sink database (0 disables it)")
+	cmd.Flags().String("log-sink-token", options.LogSinkToken, "bearer token the log sink HTTP endpoint requires in the Authorization header (empty disables the check)")
+	cmd.Flags().Int("log-sink-syslog-port", options.LogSinkSyslogPort, "accept syslog messages on this UDP port and store them in the log sink database (0 disables it)")
+	cmd.Flags().String("log-sink-database", options.LogSinkDatabase, "database the log sink writes to")
+	cmd.Flags().String("log-sink-prefix", options.LogSinkPrefix, "prefix of the keys written by the log sink")
+	cmd.Flags().Int("log-sink-batch-size", options.LogSinkBatchSize, "maximum number of log events written in a single batch")
+	cmd.Flags().Duration("db-restore-window", options.DbRestoreWindow, "how long a deleted database is retained, and can be restored, before it can be purged")
+	cmd.Flags().String("validator-address", options.ValidatorAddress, "address of an external ImmuValidator gRPC service consulted before committing writes, e.g. 127.0.0.1:9001")
+	cmd.Flags().StringSlice("validator-prefixes", options.ValidatorPrefixes, "comma separated key prefixes whose writes are validated, all the writes when empty")
+	cmd.Flags().Bool("hooks-mtls", options.HooksMTLs, "enable mutual tls on the connections to the authorizer and validator services")
+	cmd.Flags().String("hooks-certificate", mtlsOptions.Certificate, "client certificate file path presented to the authorizer and validator services")
+	cmd.Flags().String("hooks-pkey", mtlsOptions.Pkey, "client private key path used with the authorizer and validator services")
+	cmd.Flags().String("hooks-clientcas", mtlsOptions.ClientCAs, "certificate authorities the authorizer and validator services certificates are verified against")
+	cmd.Flags().Int("log-buffer-lines", options.LogBufferLines, "number of recent log lines kept in memory to be read with immuadmin logs (0 disables it)")
+	cmd.Flags().String("store-profile", options.StoreProfile, "preset tuning the stores of all the databases: "+strings.Join(store.Profiles(), ", ")+" (default tuning when empty)")
+	cmd.Flags().String("bulk-load-dir", options.BulkLoadDir, "directory bulk load files are read from, bulk loads are disabled when empty")
 }
 
 func bindFlags(cmd *cobra.Command) error {
@@ -260,6 +385,102 @@ func bindFlags(cmd *cobra.Command) error {
 	if err := viper.BindPFlag("maintenance", cmd.Flags().Lookup("maintenance")); err != nil {
 		return err
 	}
+	if err := viper.BindPFlag("max-result-items", cmd.Flags().Lookup("max-result-items")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("max-result-bytes", cmd.Flags().Lookup("max-result-bytes")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("max-execution-memory", cmd.Flags().Lookup("max-execution-memory")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("max-timestamp-skew", cmd.Flags().Lookup("max-timestamp-skew")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("authorizer-address", cmd.Flags().Lookup("authorizer-address")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("quota-soft-bytes", cmd.Flags().Lookup("quota-soft-bytes")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("quota-hard-bytes", cmd.Flags().Lookup("quota-hard-bytes")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("single-port", cmd.Flags().Lookup("single-port")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("shed-pending-entries", cmd.Flags().Lookup("shed-pending-entries")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("shed-heap-bytes", cmd.Flags().Lookup("shed-heap-bytes")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("admin-port", cmd.Flags().Lookup("admin-port")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("admin-mtls", cmd.Flags().Lookup("admin-mtls")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("admin-certificate", cmd.Flags().Lookup("admin-certificate")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("admin-pkey", cmd.Flags().Lookup("admin-pkey")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("admin-clientcas", cmd.Flags().Lookup("admin-clientcas")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("log-sink-address", cmd.Flags().Lookup("log-sink-address")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("log-sink-port", cmd.Flags().Lookup("log-sink-port")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("log-sink-token", cmd.Flags().Lookup("log-sink-token")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("log-sink-syslog-port", cmd.Flags().Lookup("log-sink-syslog-port")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("log-sink-database", cmd.Flags().Lookup("log-sink-database")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("log-sink-prefix", cmd.Flags().Lookup("log-sink-prefix")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("log-sink-batch-size", cmd.Flags().Lookup("log-sink-batch-size")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("db-restore-window", cmd.Flags().Lookup("db-restore-window")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("validator-address", cmd.Flags().Lookup("validator-address")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("validator-prefixes", cmd.Flags().Lookup("validator-prefixes")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("hooks-mtls", cmd.Flags().Lookup("hooks-mtls")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("hooks-certificate", cmd.Flags().Lookup("hooks-certificate")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("hooks-pkey", cmd.Flags().Lookup("hooks-pkey")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("hooks-clientcas", cmd.Flags().Lookup("hooks-clientcas")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("log-buffer-lines", cmd.Flags().Lookup("log-buffer-lines")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("store-profile", cmd.Flags().Lookup("store-profile")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("bulk-load-dir", cmd.Flags().Lookup("bulk-load-dir")); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -280,6 +501,38 @@ func setupDefaults(options server.Options, mtlsOptions server.MTLsOptions) {
 	viper.SetDefault("devmode", options.DevMode)
 	viper.SetDefault("admin-password", options.AdminPassword)
 	viper.SetDefault("maintenance", options.GetMaintenance())
+	viper.SetDefault("max-result-items", options.MaxResultItems)
+	viper.SetDefault("max-result-bytes", options.MaxResultBytes)
+	viper.SetDefault("max-execution-memory", options.MaxExecutionMemory)
+	viper.SetDefault("max-timestamp-skew", options.MaxTimestampSkew)
+	viper.SetDefault("authorizer-address", options.AuthorizerAddress)
+	viper.SetDefault("quota-soft-bytes", options.QuotaSoftBytes)
+	viper.SetDefault("quota-hard-bytes", options.QuotaHardBytes)
+	viper.SetDefault("single-port", options.SinglePort)
+	viper.SetDefault("shed-pending-entries", options.ShedPendingEntries)
+	viper.SetDefault("shed-heap-bytes", options.ShedHeapBytes)
+	viper.SetDefault("admin-port", options.AdminPort)
+	viper.SetDefault("admin-mtls", options.AdminMTLs)
+	viper.SetDefault("admin-certificate", mtlsOptions.Certificate)
+	viper.SetDefault("admin-pkey", mtlsOptions.Pkey)
+	viper.SetDefault("admin-clientcas", mtlsOptions.ClientCAs)
+	viper.SetDefault("log-sink-address", options.LogSinkAddress)
+	viper.SetDefault("log-sink-port", options.LogSinkPort)
+	viper.SetDefault("log-sink-token", options.LogSinkToken)
+	viper.SetDefault("log-sink-syslog-port", options.LogSinkSyslogPort)
+	viper.SetDefault("log-sink-database", options.LogSinkDatabase)
+	viper.SetDefault("log-sink-prefix", options.LogSinkPrefix)
+	viper.SetDefault("log-sink-batch-size", options.LogSinkBatchSize)
+	viper.SetDefault("db-restore-window", options.DbRestoreWindow)
+	viper.SetDefault("validator-address", options.ValidatorAddress)
+	viper.SetDefault("validator-prefixes", options.ValidatorPrefixes)
+	viper.SetDefault("hooks-mtls", options.HooksMTLs)
+	viper.SetDefault("hooks-certificate", mtlsOptions.Certificate)
+	viper.SetDefault("hooks-pkey", mtlsOptions.Pkey)
+	viper.SetDefault("hooks-clientcas", mtlsOptions.ClientCAs)
+	viper.SetDefault("log-buffer-lines", options.LogBufferLines)
+	viper.SetDefault("store-profile", options.StoreProfile)
+	viper.SetDefault("bulk-load-dir", options.BulkLoadDir)
 }
 
 // InstallManPages installs man pages
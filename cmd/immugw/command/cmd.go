@@ -115,6 +115,10 @@ func parseOptions(cmd *cobra.Command) (options gw.Options, err error) {
 	auditInterval := viper.GetDuration("audit-interval")
 	auditUsername := viper.GetString("audit-username")
 	auditPassword := viper.GetString("audit-password")
+	proofService := viper.GetBool("proof-service")
+	proofServiceInterval := viper.GetDuration("proof-service-interval")
+	proofServiceUsername := viper.GetString("proof-service-username")
+	proofServicePassword := viper.GetString("proof-service-password")
 	pidfile, err := c.ResolvePath(viper.GetString("pidfile"), true)
 	if err != nil {
 		return options, err
@@ -149,6 +153,10 @@ func parseOptions(cmd *cobra.Command) (options gw.Options, err error) {
 		WithAuditInterval(auditInterval).
 		WithAuditUsername(auditUsername).
 		WithAuditPassword(auditPassword).
+		WithProofService(proofService).
+		WithProofServiceInterval(proofServiceInterval).
+		WithProofServiceUsername(proofServiceUsername).
+		WithProofServicePassword(proofServicePassword).
 		WithPidfile(pidfile).
 		WithLogfile(logfile).
 		WithMTLs(mtls).
@@ -175,6 +183,10 @@ func setupFlags(cmd *cobra.Command, options gw.Options, mtlsOptions client.MTLsO
 	cmd.Flags().Duration("audit-interval", options.AuditInterval, "interval at which audit should run")
 	cmd.Flags().String("audit-username", options.AuditUsername, "immudb username used to login during audit")
 	cmd.Flags().String("audit-password", options.AuditPassword, "immudb password used to login during audit; can be plain-text or base64 encoded (must be prefixed with 'enc:' if it is encoded)")
+	cmd.Flags().Bool("proof-service", options.ProofService, "serve under /proof/ the verification of entries against a state continuously checked for consistency, for clients which can't verify the proofs themselves")
+	cmd.Flags().Duration("proof-service-interval", options.ProofServiceInterval, "interval at which the proof service syncs the verified state")
+	cmd.Flags().String("proof-service-username", options.ProofServiceUsername, "immudb username used by the proof service to login, if the server has auth enabled")
+	cmd.Flags().String("proof-service-password", options.ProofServicePassword, "immudb password used by the proof service to login; can be plain-text or base64 encoded (must be prefixed with 'enc:' if it is encoded)")
 	cmd.Flags().String("pidfile", options.Pidfile, "pid path with filename. E.g. /var/run/immugw.pid")
 	cmd.Flags().String("logfile", options.Logfile, "log path with filename. E.g. /tmp/immugw/immugw.log")
 	cmd.Flags().BoolP("mtls", "m", options.MTLs, "enable mutual tls")
@@ -213,6 +225,18 @@ func bindFlags(cmd *cobra.Command) error {
 	if err := viper.BindPFlag("audit-password", cmd.Flags().Lookup("audit-password")); err != nil {
 		return err
 	}
+	if err := viper.BindPFlag("proof-service", cmd.Flags().Lookup("proof-service")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("proof-service-interval", cmd.Flags().Lookup("proof-service-interval")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("proof-service-username", cmd.Flags().Lookup("proof-service-username")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("proof-service-password", cmd.Flags().Lookup("proof-service-password")); err != nil {
+		return err
+	}
 	if err := viper.BindPFlag("pidfile", cmd.Flags().Lookup("pidfile")); err != nil {
 		return err
 	}
@@ -250,6 +274,10 @@ func setupDefaults(options gw.Options, mtlsOptions client.MTLsOptions) {
 	viper.SetDefault("audit-interval", options.AuditInterval)
 	viper.SetDefault("audit-username", options.AuditUsername)
 	viper.SetDefault("audit-password", options.AuditPassword)
+	viper.SetDefault("proof-service", options.ProofService)
+	viper.SetDefault("proof-service-interval", options.ProofServiceInterval)
+	viper.SetDefault("proof-service-username", options.ProofServiceUsername)
+	viper.SetDefault("proof-service-password", options.ProofServicePassword)
 	viper.SetDefault("pidfile", options.Pidfile)
 	viper.SetDefault("logfile", options.Logfile)
 	viper.SetDefault("mtls", options.MTLs)
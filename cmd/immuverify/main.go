@@ -0,0 +1,53 @@
+//go:build js && wasm
+// +build js,wasm
+
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command immuverify is a WASM module letting browsers verify the proofs delivered by the REST gateway.
+// Build it with GOOS=js GOARCH=wasm and load it with wasm_exec.js: it registers
+//
+//	immudbVerifySafeItem(safeItemJSON, prevRootJSON)
+//
+// which returns {verified: true, root: rootJSON} or {verified: false, error: message}.
+// rootJSON must be kept and passed as prevRootJSON to the next call, an empty prevRootJSON skips the consistency check.
+package main
+
+import (
+	"syscall/js"
+
+	"github.com/codenotary/immudb/pkg/verification"
+)
+
+func verifySafeItem(this js.Value, args []js.Value) interface{} {
+	if len(args) < 1 {
+		return map[string]interface{}{"verified": false, "error": "missing safe item"}
+	}
+	var prevRoot []byte
+	if len(args) > 1 && args[1].Type() == js.TypeString {
+		prevRoot = []byte(args[1].String())
+	}
+	root, err := verification.VerifySafeItemJSON([]byte(args[0].String()), prevRoot)
+	if err != nil {
+		return map[string]interface{}{"verified": false, "error": err.Error()}
+	}
+	return map[string]interface{}{"verified": true, "root": string(root)}
+}
+
+func main() {
+	js.Global().Set("immudbVerifySafeItem", js.FuncOf(verifySafeItem))
+	select {}
+}
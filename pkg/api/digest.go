@@ -18,19 +18,11 @@ package api
 
 import (
 	"crypto/sha256"
-	"encoding/binary"
 
-	"github.com/codenotary/merkletree"
+	"github.com/codenotary/immudb/pkg/verification"
 )
 
 // Digest returns the hash computed from the union of item's members.
 func Digest(index uint64, key, value []byte) [sha256.Size]byte {
-	kl, vl := len(key), len(value)
-	c := make([]byte, 1+8+8+kl+vl)
-	c[0] = merkletree.LeafPrefix
-	binary.BigEndian.PutUint64(c[1:1+8], index)
-	binary.BigEndian.PutUint64(c[1+8:1+8+8], uint64(kl))
-	copy(c[1+8+8:], key)
-	copy(c[1+8+8+kl:], value)
-	return sha256.Sum256(c)
+	return verification.Digest(index, key, value)
 }
@@ -0,0 +1,55 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package schema
+
+// APIVersion is the version of the gRPC API, increased when a change breaks the clients of the previous version.
+// Clients and servers predating the handshake send 0, which is handled as version 1.
+const APIVersion uint32 = 1
+
+// APIVersionHeader is the request metadata key carrying the client API version on the calls without a request to
+// hold it, e.g. Health
+const APIVersionHeader = "immudb-api-version"
+
+// Capabilities advertised by the server in the login and health responses
+const (
+	CapabilityStreams          = "streams"
+	CapabilityStructuredValues = "structured-values"
+	CapabilityMultiDatabase    = "multi-database"
+	CapabilityBulkLoad         = "bulk-load"
+	CapabilityImpersonation    = "impersonation"
+	CapabilityUsage            = "usage"
+	CapabilityAuth             = "auth"
+	CapabilityAdminPort        = "admin-port"
+	CapabilityLogSink          = "log-sink"
+	CapabilityCodecs           = "codecs"
+	CapabilityLabels           = "labels"
+	CapabilityDatabaseRestore  = "database-restore"
+	CapabilityStartupProgress  = "startup-progress"
+	CapabilityLogs             = "logs"
+	// CapabilitySessions and CapabilitySQL are not served yet, clients can check for them to detect newer servers
+	CapabilitySessions = "sessions"
+	CapabilitySQL      = "sql"
+)
+
+// HasCapability tells whether the capability is in the list advertised by the server
+func HasCapability(capabilities []string, capability string) bool {
+	for _, c := range capabilities {
+		if c == capability {
+			return true
+		}
+	}
+	return false
+}
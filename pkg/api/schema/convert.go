@@ -39,9 +39,10 @@ func (item *Item) ToSItem() (*StructuredItem, error) {
 	}
 
 	return &StructuredItem{
-		Index: item.Index,
-		Key:   item.Key,
-		Value: &c,
+		Index:  item.Index,
+		Key:    item.Key,
+		Value:  &c,
+		Labels: item.Labels,
 	}, nil
 }
 
@@ -52,9 +53,10 @@ func (item *StructuredItem) ToItem() (*Item, error) {
 		return nil, err
 	}
 	return &Item{
-		Key:   item.Key,
-		Value: m,
-		Index: item.Index,
+		Key:    item.Key,
+		Value:  m,
+		Index:  item.Index,
+		Labels: item.Labels,
 	}, nil
 }
 
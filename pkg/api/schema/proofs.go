@@ -18,9 +18,8 @@ package schema
 
 import (
 	"bytes"
-	"crypto/sha256"
 
-	"github.com/codenotary/merkletree"
+	"github.com/codenotary/immudb/pkg/verification"
 )
 
 // Verify returns true iff the _InclusionProof_ proves that _leaf_ is included into _i.Root_'s history at
@@ -32,13 +31,7 @@ func (i *InclusionProof) Verify(index uint64, leaf []byte) bool {
 		return false
 	}
 
-	var path merkletree.Path
-	path.FromSlice(i.Path)
-
-	var rt, lf [sha256.Size]byte
-	copy(rt[:], i.Root)
-	copy(lf[:], i.Leaf)
-	return path.VerifyInclusion(i.At, i.Index, rt, lf)
+	return verification.VerifyInclusion(i.Path, i.At, i.Index, i.Root, i.Leaf)
 }
 
 // Verify returns true iff the _ConsistencyProof_ proves that _c.SecondRoot_'s history is including the history of
@@ -48,13 +41,7 @@ func (c *ConsistencyProof) Verify(prevRoot Root) bool {
 		return false
 	}
 
-	var path merkletree.Path
-	path.FromSlice(c.Path)
-
-	var firstRoot, secondRoot [sha256.Size]byte
-	copy(firstRoot[:], prevRoot.Root)
-	copy(secondRoot[:], c.SecondRoot)
-	if path.VerifyConsistency(c.Second, c.First, secondRoot, firstRoot) {
+	if verification.VerifyConsistency(c.Path, c.Second, c.First, c.SecondRoot, prevRoot.Root) {
 		c.FirstRoot = prevRoot.Root
 		return true
 	}
@@ -65,32 +52,18 @@ func (c *ConsistencyProof) Verify(prevRoot Root) bool {
 // and that the provided _prevRoot_ is included into _p.Root_'s history.
 // Providing a zerovalue for _prevRoot_ signals that no previous root is available, thus consistency proof will be skipped.
 func (p *Proof) Verify(leaf []byte, prevRoot Root) bool {
-
-	if p == nil || bytes.Compare(leaf, p.Leaf) != 0 {
+	if p == nil {
 		return false
 	}
-
-	var path merkletree.Path
-
-	path.FromSlice(p.InclusionPath)
-	var rt, lf [sha256.Size]byte
-	copy(rt[:], p.Root)
-	copy(lf[:], p.Leaf)
-	if !path.VerifyInclusion(p.At, p.Index, rt, lf) {
-		return false
+	vp := &verification.Proof{
+		Leaf:            p.Leaf,
+		Index:           p.Index,
+		Root:            p.Root,
+		At:              p.At,
+		InclusionPath:   p.InclusionPath,
+		ConsistencyPath: p.ConsistencyPath,
 	}
-
-	// we cannot check consistency when the previous root is not provided
-	if prevRoot.Index == 0 && len(prevRoot.Root) == 0 {
-		return true
-	}
-
-	path.FromSlice(p.ConsistencyPath)
-
-	var firstRoot, secondRoot [sha256.Size]byte
-	copy(firstRoot[:], prevRoot.Root)
-	copy(secondRoot[:], p.Root)
-	return path.VerifyConsistency(p.At, prevRoot.Index, secondRoot, firstRoot)
+	return vp.Verify(leaf, verification.Root{Index: prevRoot.Index, Root: prevRoot.Root})
 }
 
 // NewRoot returns a new _Root_ object which holds values referenced by the proof _p_.
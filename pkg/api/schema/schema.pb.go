@@ -291,6 +291,287 @@ func (m *UserList) GetUsers() []*User {
 	return nil
 }
 
+type UserUsage struct {
+	User                 string   `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Operations           uint64   `protobuf:"varint,2,opt,name=operations,proto3" json:"operations,omitempty"`
+	WriteOperations      uint64   `protobuf:"varint,3,opt,name=writeOperations,proto3" json:"writeOperations,omitempty"`
+	WrittenBytes         uint64   `protobuf:"varint,4,opt,name=writtenBytes,proto3" json:"writtenBytes,omitempty"`
+	RejectedOperations   uint64   `protobuf:"varint,5,opt,name=rejectedOperations,proto3" json:"rejectedOperations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserUsage) Reset()         { *m = UserUsage{} }
+func (m *UserUsage) String() string { return proto.CompactTextString(m) }
+func (*UserUsage) ProtoMessage()    {}
+func (*UserUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{4}
+}
+
+func (m *UserUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserUsage.Unmarshal(m, b)
+}
+func (m *UserUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserUsage.Marshal(b, m, deterministic)
+}
+func (m *UserUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserUsage.Merge(m, src)
+}
+func (m *UserUsage) XXX_Size() int {
+	return xxx_messageInfo_UserUsage.Size(m)
+}
+func (m *UserUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserUsage proto.InternalMessageInfo
+
+func (m *UserUsage) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *UserUsage) GetOperations() uint64 {
+	if m != nil {
+		return m.Operations
+	}
+	return 0
+}
+
+func (m *UserUsage) GetWriteOperations() uint64 {
+	if m != nil {
+		return m.WriteOperations
+	}
+	return 0
+}
+
+func (m *UserUsage) GetWrittenBytes() uint64 {
+	if m != nil {
+		return m.WrittenBytes
+	}
+	return 0
+}
+
+func (m *UserUsage) GetRejectedOperations() uint64 {
+	if m != nil {
+		return m.RejectedOperations
+	}
+	return 0
+}
+
+type UsageList struct {
+	Usages               []*UserUsage `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *UsageList) Reset()         { *m = UsageList{} }
+func (m *UsageList) String() string { return proto.CompactTextString(m) }
+func (*UsageList) ProtoMessage()    {}
+func (*UsageList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{5}
+}
+
+func (m *UsageList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UsageList.Unmarshal(m, b)
+}
+func (m *UsageList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UsageList.Marshal(b, m, deterministic)
+}
+func (m *UsageList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsageList.Merge(m, src)
+}
+func (m *UsageList) XXX_Size() int {
+	return xxx_messageInfo_UsageList.Size(m)
+}
+func (m *UsageList) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsageList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsageList proto.InternalMessageInfo
+
+func (m *UsageList) GetUsages() []*UserUsage {
+	if m != nil {
+		return m.Usages
+	}
+	return nil
+}
+
+// OnBehalfOfRecord is stored in the system database for each write executed on behalf of an end user
+type OnBehalfOfRecord struct {
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Method   string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// index of the last entry written
+	Index uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// user logged in, who executed the write
+	User string `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	// end user the write was executed for
+	OnBehalfOf string `protobuf:"bytes,5,opt,name=onBehalfOf,proto3" json:"onBehalfOf,omitempty"`
+	// unix time of the write
+	Timestamp            int64    `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OnBehalfOfRecord) Reset()         { *m = OnBehalfOfRecord{} }
+func (m *OnBehalfOfRecord) String() string { return proto.CompactTextString(m) }
+func (*OnBehalfOfRecord) ProtoMessage()    {}
+func (*OnBehalfOfRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{6}
+}
+
+func (m *OnBehalfOfRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OnBehalfOfRecord.Unmarshal(m, b)
+}
+func (m *OnBehalfOfRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OnBehalfOfRecord.Marshal(b, m, deterministic)
+}
+func (m *OnBehalfOfRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnBehalfOfRecord.Merge(m, src)
+}
+func (m *OnBehalfOfRecord) XXX_Size() int {
+	return xxx_messageInfo_OnBehalfOfRecord.Size(m)
+}
+func (m *OnBehalfOfRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnBehalfOfRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OnBehalfOfRecord proto.InternalMessageInfo
+
+func (m *OnBehalfOfRecord) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *OnBehalfOfRecord) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *OnBehalfOfRecord) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *OnBehalfOfRecord) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *OnBehalfOfRecord) GetOnBehalfOf() string {
+	if m != nil {
+		return m.OnBehalfOf
+	}
+	return ""
+}
+
+func (m *OnBehalfOfRecord) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type OnBehalfOfRequest struct {
+	// database and onBehalfOf filter the records when set
+	Database             string   `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	OnBehalfOf           string   `protobuf:"bytes,2,opt,name=onBehalfOf,proto3" json:"onBehalfOf,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OnBehalfOfRequest) Reset()         { *m = OnBehalfOfRequest{} }
+func (m *OnBehalfOfRequest) String() string { return proto.CompactTextString(m) }
+func (*OnBehalfOfRequest) ProtoMessage()    {}
+func (*OnBehalfOfRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{7}
+}
+
+func (m *OnBehalfOfRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OnBehalfOfRequest.Unmarshal(m, b)
+}
+func (m *OnBehalfOfRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OnBehalfOfRequest.Marshal(b, m, deterministic)
+}
+func (m *OnBehalfOfRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnBehalfOfRequest.Merge(m, src)
+}
+func (m *OnBehalfOfRequest) XXX_Size() int {
+	return xxx_messageInfo_OnBehalfOfRequest.Size(m)
+}
+func (m *OnBehalfOfRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnBehalfOfRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OnBehalfOfRequest proto.InternalMessageInfo
+
+func (m *OnBehalfOfRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *OnBehalfOfRequest) GetOnBehalfOf() string {
+	if m != nil {
+		return m.OnBehalfOf
+	}
+	return ""
+}
+
+type OnBehalfOfList struct {
+	Records              []*OnBehalfOfRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *OnBehalfOfList) Reset()         { *m = OnBehalfOfList{} }
+func (m *OnBehalfOfList) String() string { return proto.CompactTextString(m) }
+func (*OnBehalfOfList) ProtoMessage()    {}
+func (*OnBehalfOfList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{8}
+}
+
+func (m *OnBehalfOfList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OnBehalfOfList.Unmarshal(m, b)
+}
+func (m *OnBehalfOfList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OnBehalfOfList.Marshal(b, m, deterministic)
+}
+func (m *OnBehalfOfList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnBehalfOfList.Merge(m, src)
+}
+func (m *OnBehalfOfList) XXX_Size() int {
+	return xxx_messageInfo_OnBehalfOfList.Size(m)
+}
+func (m *OnBehalfOfList) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnBehalfOfList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OnBehalfOfList proto.InternalMessageInfo
+
+func (m *OnBehalfOfList) GetRecords() []*OnBehalfOfRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 type CreateUserRequest struct {
 	User                 []byte   `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	Password             []byte   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
@@ -305,7 +586,7 @@ func (m *CreateUserRequest) Reset()         { *m = CreateUserRequest{} }
 func (m *CreateUserRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateUserRequest) ProtoMessage()    {}
 func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{4}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{9}
 }
 
 func (m *CreateUserRequest) XXX_Unmarshal(b []byte) error {
@@ -365,7 +646,7 @@ func (m *UserRequest) Reset()         { *m = UserRequest{} }
 func (m *UserRequest) String() string { return proto.CompactTextString(m) }
 func (*UserRequest) ProtoMessage()    {}
 func (*UserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{5}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{10}
 }
 
 func (m *UserRequest) XXX_Unmarshal(b []byte) error {
@@ -405,7 +686,7 @@ func (m *UserResponse) Reset()         { *m = UserResponse{} }
 func (m *UserResponse) String() string { return proto.CompactTextString(m) }
 func (*UserResponse) ProtoMessage()    {}
 func (*UserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{6}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{11}
 }
 
 func (m *UserResponse) XXX_Unmarshal(b []byte) error {
@@ -453,7 +734,7 @@ func (m *ChangePasswordRequest) Reset()         { *m = ChangePasswordRequest{} }
 func (m *ChangePasswordRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangePasswordRequest) ProtoMessage()    {}
 func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{7}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{12}
 }
 
 func (m *ChangePasswordRequest) XXX_Unmarshal(b []byte) error {
@@ -498,6 +779,7 @@ func (m *ChangePasswordRequest) GetNewPassword() []byte {
 type LoginRequest struct {
 	User                 []byte   `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	Password             []byte   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	ApiVersion           uint32   `protobuf:"varint,3,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -507,7 +789,7 @@ func (m *LoginRequest) Reset()         { *m = LoginRequest{} }
 func (m *LoginRequest) String() string { return proto.CompactTextString(m) }
 func (*LoginRequest) ProtoMessage()    {}
 func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{8}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{13}
 }
 
 func (m *LoginRequest) XXX_Unmarshal(b []byte) error {
@@ -542,9 +824,18 @@ func (m *LoginRequest) GetPassword() []byte {
 	return nil
 }
 
+func (m *LoginRequest) GetApiVersion() uint32 {
+	if m != nil {
+		return m.ApiVersion
+	}
+	return 0
+}
+
 type LoginResponse struct {
 	Token                []byte   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	Warning              []byte   `protobuf:"bytes,2,opt,name=warning,proto3" json:"warning,omitempty"`
+	ApiVersion           uint32   `protobuf:"varint,3,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
+	Capabilities         []string `protobuf:"bytes,4,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -554,7 +845,7 @@ func (m *LoginResponse) Reset()         { *m = LoginResponse{} }
 func (m *LoginResponse) String() string { return proto.CompactTextString(m) }
 func (*LoginResponse) ProtoMessage()    {}
 func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{9}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{14}
 }
 
 func (m *LoginResponse) XXX_Unmarshal(b []byte) error {
@@ -589,80 +880,295 @@ func (m *LoginResponse) GetWarning() []byte {
 	return nil
 }
 
-type AuthConfig struct {
-	Kind                 uint32   `protobuf:"varint,1,opt,name=kind,proto3" json:"kind,omitempty"`
+func (m *LoginResponse) GetApiVersion() uint32 {
+	if m != nil {
+		return m.ApiVersion
+	}
+	return 0
+}
+
+func (m *LoginResponse) GetCapabilities() []string {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
+type StartupProgress struct {
+	Phase                string   `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	Done                 uint32   `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	Total                uint32   `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Percent              uint32   `protobuf:"varint,4,opt,name=percent,proto3" json:"percent,omitempty"`
+	ElapsedMs            int64    `protobuf:"varint,5,opt,name=elapsedMs,proto3" json:"elapsedMs,omitempty"`
+	EtaMs                int64    `protobuf:"varint,6,opt,name=etaMs,proto3" json:"etaMs,omitempty"`
+	Ready                bool     `protobuf:"varint,7,opt,name=ready,proto3" json:"ready,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthConfig) Reset()         { *m = AuthConfig{} }
-func (m *AuthConfig) String() string { return proto.CompactTextString(m) }
-func (*AuthConfig) ProtoMessage()    {}
-func (*AuthConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{10}
+func (m *StartupProgress) Reset()         { *m = StartupProgress{} }
+func (m *StartupProgress) String() string { return proto.CompactTextString(m) }
+func (*StartupProgress) ProtoMessage()    {}
+func (*StartupProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{15}
 }
 
-func (m *AuthConfig) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AuthConfig.Unmarshal(m, b)
+func (m *StartupProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartupProgress.Unmarshal(m, b)
 }
-func (m *AuthConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AuthConfig.Marshal(b, m, deterministic)
+func (m *StartupProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartupProgress.Marshal(b, m, deterministic)
 }
-func (m *AuthConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthConfig.Merge(m, src)
+func (m *StartupProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartupProgress.Merge(m, src)
 }
-func (m *AuthConfig) XXX_Size() int {
-	return xxx_messageInfo_AuthConfig.Size(m)
+func (m *StartupProgress) XXX_Size() int {
+	return xxx_messageInfo_StartupProgress.Size(m)
 }
-func (m *AuthConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthConfig.DiscardUnknown(m)
+func (m *StartupProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartupProgress.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthConfig proto.InternalMessageInfo
+var xxx_messageInfo_StartupProgress proto.InternalMessageInfo
 
-func (m *AuthConfig) GetKind() uint32 {
+func (m *StartupProgress) GetPhase() string {
 	if m != nil {
-		return m.Kind
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *StartupProgress) GetDone() uint32 {
+	if m != nil {
+		return m.Done
 	}
 	return 0
 }
 
-type MTLSConfig struct {
-	Enabled              bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+func (m *StartupProgress) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *StartupProgress) GetPercent() uint32 {
+	if m != nil {
+		return m.Percent
+	}
+	return 0
+}
+
+func (m *StartupProgress) GetElapsedMs() int64 {
+	if m != nil {
+		return m.ElapsedMs
+	}
+	return 0
+}
+
+func (m *StartupProgress) GetEtaMs() int64 {
+	if m != nil {
+		return m.EtaMs
+	}
+	return 0
+}
+
+func (m *StartupProgress) GetReady() bool {
+	if m != nil {
+		return m.Ready
+	}
+	return false
+}
+
+type LogRequest struct {
+	// number of recent lines to return, all the retained ones when 0
+	Lines uint32 `protobuf:"varint,1,opt,name=lines,proto3" json:"lines,omitempty"`
+	// minimum level of the lines: debug, info, warn or error, info when empty
+	Level string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	// keep streaming the new lines until the request is canceled
+	Follow               bool     `protobuf:"varint,3,opt,name=follow,proto3" json:"follow,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MTLSConfig) Reset()         { *m = MTLSConfig{} }
-func (m *MTLSConfig) String() string { return proto.CompactTextString(m) }
-func (*MTLSConfig) ProtoMessage()    {}
-func (*MTLSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{11}
+func (m *LogRequest) Reset()         { *m = LogRequest{} }
+func (m *LogRequest) String() string { return proto.CompactTextString(m) }
+func (*LogRequest) ProtoMessage()    {}
+func (*LogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{16}
 }
 
-func (m *MTLSConfig) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MTLSConfig.Unmarshal(m, b)
+func (m *LogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogRequest.Unmarshal(m, b)
 }
-func (m *MTLSConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MTLSConfig.Marshal(b, m, deterministic)
+func (m *LogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogRequest.Marshal(b, m, deterministic)
 }
-func (m *MTLSConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MTLSConfig.Merge(m, src)
+func (m *LogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogRequest.Merge(m, src)
 }
-func (m *MTLSConfig) XXX_Size() int {
-	return xxx_messageInfo_MTLSConfig.Size(m)
+func (m *LogRequest) XXX_Size() int {
+	return xxx_messageInfo_LogRequest.Size(m)
 }
-func (m *MTLSConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_MTLSConfig.DiscardUnknown(m)
+func (m *LogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MTLSConfig proto.InternalMessageInfo
+var xxx_messageInfo_LogRequest proto.InternalMessageInfo
 
-func (m *MTLSConfig) GetEnabled() bool {
+func (m *LogRequest) GetLines() uint32 {
 	if m != nil {
-		return m.Enabled
+		return m.Lines
+	}
+	return 0
+}
+
+func (m *LogRequest) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *LogRequest) GetFollow() bool {
+	if m != nil {
+		return m.Follow
+	}
+	return false
+}
+
+type LogLine struct {
+	// unix time in nanoseconds
+	LoggedAt             int64    `protobuf:"varint,1,opt,name=loggedAt,proto3" json:"loggedAt,omitempty"`
+	Level                string   `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogLine) Reset()         { *m = LogLine{} }
+func (m *LogLine) String() string { return proto.CompactTextString(m) }
+func (*LogLine) ProtoMessage()    {}
+func (*LogLine) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{17}
+}
+
+func (m *LogLine) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogLine.Unmarshal(m, b)
+}
+func (m *LogLine) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogLine.Marshal(b, m, deterministic)
+}
+func (m *LogLine) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogLine.Merge(m, src)
+}
+func (m *LogLine) XXX_Size() int {
+	return xxx_messageInfo_LogLine.Size(m)
+}
+func (m *LogLine) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogLine.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogLine proto.InternalMessageInfo
+
+func (m *LogLine) GetLoggedAt() int64 {
+	if m != nil {
+		return m.LoggedAt
+	}
+	return 0
+}
+
+func (m *LogLine) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *LogLine) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type AuthConfig struct {
+	Kind                 uint32   `protobuf:"varint,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthConfig) Reset()         { *m = AuthConfig{} }
+func (m *AuthConfig) String() string { return proto.CompactTextString(m) }
+func (*AuthConfig) ProtoMessage()    {}
+func (*AuthConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{18}
+}
+
+func (m *AuthConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthConfig.Unmarshal(m, b)
+}
+func (m *AuthConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthConfig.Marshal(b, m, deterministic)
+}
+func (m *AuthConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthConfig.Merge(m, src)
+}
+func (m *AuthConfig) XXX_Size() int {
+	return xxx_messageInfo_AuthConfig.Size(m)
+}
+func (m *AuthConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthConfig proto.InternalMessageInfo
+
+func (m *AuthConfig) GetKind() uint32 {
+	if m != nil {
+		return m.Kind
+	}
+	return 0
+}
+
+type MTLSConfig struct {
+	Enabled              bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MTLSConfig) Reset()         { *m = MTLSConfig{} }
+func (m *MTLSConfig) String() string { return proto.CompactTextString(m) }
+func (*MTLSConfig) ProtoMessage()    {}
+func (*MTLSConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{19}
+}
+
+func (m *MTLSConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MTLSConfig.Unmarshal(m, b)
+}
+func (m *MTLSConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MTLSConfig.Marshal(b, m, deterministic)
+}
+func (m *MTLSConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MTLSConfig.Merge(m, src)
+}
+func (m *MTLSConfig) XXX_Size() int {
+	return xxx_messageInfo_MTLSConfig.Size(m)
+}
+func (m *MTLSConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MTLSConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MTLSConfig proto.InternalMessageInfo
+
+func (m *MTLSConfig) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
 	return false
 }
@@ -683,7 +1189,7 @@ func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{12}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{20}
 }
 
 func (m *Node) XXX_Unmarshal(b []byte) error {
@@ -757,7 +1263,7 @@ func (m *Layer) Reset()         { *m = Layer{} }
 func (m *Layer) String() string { return proto.CompactTextString(m) }
 func (*Layer) ProtoMessage()    {}
 func (*Layer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{13}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{21}
 }
 
 func (m *Layer) XXX_Unmarshal(b []byte) error {
@@ -796,7 +1302,7 @@ func (m *Tree) Reset()         { *m = Tree{} }
 func (m *Tree) String() string { return proto.CompactTextString(m) }
 func (*Tree) ProtoMessage()    {}
 func (*Tree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{14}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{22}
 }
 
 func (m *Tree) XXX_Unmarshal(b []byte) error {
@@ -825,18 +1331,25 @@ func (m *Tree) GetT() []*Layer {
 }
 
 type KeyValue struct {
-	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// skipUnchanged makes Set return the index of the current entry, without writing, when the value is unchanged
+	SkipUnchanged bool `protobuf:"varint,3,opt,name=skipUnchanged,proto3" json:"skipUnchanged,omitempty"`
+	// codecs are applied in order to the value before it is stored, see GetCodecs
+	Codecs []uint32 `protobuf:"varint,4,rep,packed,name=codecs,proto3" json:"codecs,omitempty"`
+	// labels are stored with the entry and can be used to filter scans. They are not part of the merkle tree: the
+	// safe operations verify the key and the value only, never the labels
+	Labels               map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *KeyValue) Reset()         { *m = KeyValue{} }
 func (m *KeyValue) String() string { return proto.CompactTextString(m) }
 func (*KeyValue) ProtoMessage()    {}
 func (*KeyValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{15}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{23}
 }
 
 func (m *KeyValue) XXX_Unmarshal(b []byte) error {
@@ -871,9 +1384,32 @@ func (m *KeyValue) GetValue() []byte {
 	return nil
 }
 
+func (m *KeyValue) GetSkipUnchanged() bool {
+	if m != nil {
+		return m.SkipUnchanged
+	}
+	return false
+}
+
+func (m *KeyValue) GetCodecs() []uint32 {
+	if m != nil {
+		return m.Codecs
+	}
+	return nil
+}
+
+func (m *KeyValue) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 type StructuredKeyValue struct {
-	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value                *Content `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Key   []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value *Content `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// skipUnchanged makes SetSV return the index of the current entry, without writing, when the payload is unchanged
+	SkipUnchanged        bool     `protobuf:"varint,3,opt,name=skipUnchanged,proto3" json:"skipUnchanged,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -883,7 +1419,7 @@ func (m *StructuredKeyValue) Reset()         { *m = StructuredKeyValue{} }
 func (m *StructuredKeyValue) String() string { return proto.CompactTextString(m) }
 func (*StructuredKeyValue) ProtoMessage()    {}
 func (*StructuredKeyValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{16}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{24}
 }
 
 func (m *StructuredKeyValue) XXX_Unmarshal(b []byte) error {
@@ -918,6 +1454,13 @@ func (m *StructuredKeyValue) GetValue() *Content {
 	return nil
 }
 
+func (m *StructuredKeyValue) GetSkipUnchanged() bool {
+	if m != nil {
+		return m.SkipUnchanged
+	}
+	return false
+}
+
 type Content struct {
 	Timestamp            uint64   `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	Payload              []byte   `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
@@ -930,7 +1473,7 @@ func (m *Content) Reset()         { *m = Content{} }
 func (m *Content) String() string { return proto.CompactTextString(m) }
 func (*Content) ProtoMessage()    {}
 func (*Content) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{17}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{25}
 }
 
 func (m *Content) XXX_Unmarshal(b []byte) error {
@@ -976,7 +1519,7 @@ func (m *Index) Reset()         { *m = Index{} }
 func (m *Index) String() string { return proto.CompactTextString(m) }
 func (*Index) ProtoMessage()    {}
 func (*Index) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{18}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{26}
 }
 
 func (m *Index) XXX_Unmarshal(b []byte) error {
@@ -1005,19 +1548,21 @@ func (m *Index) GetIndex() uint64 {
 }
 
 type Item struct {
-	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	Index                uint64   `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Index uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// labels attached to the entry, not covered by the proofs of the safe operations
+	Labels               map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *Item) Reset()         { *m = Item{} }
 func (m *Item) String() string { return proto.CompactTextString(m) }
 func (*Item) ProtoMessage()    {}
 func (*Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{19}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{27}
 }
 
 func (m *Item) XXX_Unmarshal(b []byte) error {
@@ -1059,20 +1604,29 @@ func (m *Item) GetIndex() uint64 {
 	return 0
 }
 
+func (m *Item) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 type StructuredItem struct {
-	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value                *Content `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	Index                uint64   `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Key   []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value *Content `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Index uint64   `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// labels attached to the entry, not covered by the proofs of the safe operations
+	Labels               map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *StructuredItem) Reset()         { *m = StructuredItem{} }
 func (m *StructuredItem) String() string { return proto.CompactTextString(m) }
 func (*StructuredItem) ProtoMessage()    {}
 func (*StructuredItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{20}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{28}
 }
 
 func (m *StructuredItem) XXX_Unmarshal(b []byte) error {
@@ -1114,6 +1668,13 @@ func (m *StructuredItem) GetIndex() uint64 {
 	return 0
 }
 
+func (m *StructuredItem) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 type KVList struct {
 	KVs                  []*KeyValue `protobuf:"bytes,1,rep,name=KVs,proto3" json:"KVs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
@@ -1125,7 +1686,7 @@ func (m *KVList) Reset()         { *m = KVList{} }
 func (m *KVList) String() string { return proto.CompactTextString(m) }
 func (*KVList) ProtoMessage()    {}
 func (*KVList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{21}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{29}
 }
 
 func (m *KVList) XXX_Unmarshal(b []byte) error {
@@ -1164,7 +1725,7 @@ func (m *SKVList) Reset()         { *m = SKVList{} }
 func (m *SKVList) String() string { return proto.CompactTextString(m) }
 func (*SKVList) ProtoMessage()    {}
 func (*SKVList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{22}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{30}
 }
 
 func (m *SKVList) XXX_Unmarshal(b []byte) error {
@@ -1203,7 +1764,7 @@ func (m *KeyList) Reset()         { *m = KeyList{} }
 func (m *KeyList) String() string { return proto.CompactTextString(m) }
 func (*KeyList) ProtoMessage()    {}
 func (*KeyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{23}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{31}
 }
 
 func (m *KeyList) XXX_Unmarshal(b []byte) error {
@@ -1242,7 +1803,7 @@ func (m *ItemList) Reset()         { *m = ItemList{} }
 func (m *ItemList) String() string { return proto.CompactTextString(m) }
 func (*ItemList) ProtoMessage()    {}
 func (*ItemList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{24}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{32}
 }
 
 func (m *ItemList) XXX_Unmarshal(b []byte) error {
@@ -1281,7 +1842,7 @@ func (m *StructuredItemList) Reset()         { *m = StructuredItemList{} }
 func (m *StructuredItemList) String() string { return proto.CompactTextString(m) }
 func (*StructuredItemList) ProtoMessage()    {}
 func (*StructuredItemList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{25}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{33}
 }
 
 func (m *StructuredItemList) XXX_Unmarshal(b []byte) error {
@@ -1321,7 +1882,7 @@ func (m *Root) Reset()         { *m = Root{} }
 func (m *Root) String() string { return proto.CompactTextString(m) }
 func (*Root) ProtoMessage()    {}
 func (*Root) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{26}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{34}
 }
 
 func (m *Root) XXX_Unmarshal(b []byte) error {
@@ -1357,21 +1918,24 @@ func (m *Root) GetRoot() []byte {
 }
 
 type ScanOptions struct {
-	Prefix               []byte   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	Offset               []byte   `protobuf:"bytes,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit                uint64   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Reverse              bool     `protobuf:"varint,4,opt,name=reverse,proto3" json:"reverse,omitempty"`
-	Deep                 bool     `protobuf:"varint,5,opt,name=deep,proto3" json:"deep,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Prefix  []byte `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Offset  []byte `protobuf:"bytes,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit   uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Reverse bool   `protobuf:"varint,4,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	Deep    bool   `protobuf:"varint,5,opt,name=deep,proto3" json:"deep,omitempty"`
+	// labels restricts the scan to the entries having all of them. Labels are not verifiable, a server can't prove
+	// that the scan returned every matching entry
+	Labels               map[string]string `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *ScanOptions) Reset()         { *m = ScanOptions{} }
 func (m *ScanOptions) String() string { return proto.CompactTextString(m) }
 func (*ScanOptions) ProtoMessage()    {}
 func (*ScanOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{27}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{35}
 }
 
 func (m *ScanOptions) XXX_Unmarshal(b []byte) error {
@@ -1427,6 +1991,13 @@ func (m *ScanOptions) GetDeep() bool {
 	return false
 }
 
+func (m *ScanOptions) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 type KeyPrefix struct {
 	Prefix               []byte   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1438,7 +2009,7 @@ func (m *KeyPrefix) Reset()         { *m = KeyPrefix{} }
 func (m *KeyPrefix) String() string { return proto.CompactTextString(m) }
 func (*KeyPrefix) ProtoMessage()    {}
 func (*KeyPrefix) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{28}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{36}
 }
 
 func (m *KeyPrefix) XXX_Unmarshal(b []byte) error {
@@ -1477,7 +2048,7 @@ func (m *ItemsCount) Reset()         { *m = ItemsCount{} }
 func (m *ItemsCount) String() string { return proto.CompactTextString(m) }
 func (*ItemsCount) ProtoMessage()    {}
 func (*ItemsCount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{29}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{37}
 }
 
 func (m *ItemsCount) XXX_Unmarshal(b []byte) error {
@@ -1520,7 +2091,7 @@ func (m *InclusionProof) Reset()         { *m = InclusionProof{} }
 func (m *InclusionProof) String() string { return proto.CompactTextString(m) }
 func (*InclusionProof) ProtoMessage()    {}
 func (*InclusionProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{30}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{38}
 }
 
 func (m *InclusionProof) XXX_Unmarshal(b []byte) error {
@@ -1591,7 +2162,7 @@ func (m *ConsistencyProof) Reset()         { *m = ConsistencyProof{} }
 func (m *ConsistencyProof) String() string { return proto.CompactTextString(m) }
 func (*ConsistencyProof) ProtoMessage()    {}
 func (*ConsistencyProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{31}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{39}
 }
 
 func (m *ConsistencyProof) XXX_Unmarshal(b []byte) error {
@@ -1663,7 +2234,7 @@ func (m *Proof) Reset()         { *m = Proof{} }
 func (m *Proof) String() string { return proto.CompactTextString(m) }
 func (*Proof) ProtoMessage()    {}
 func (*Proof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{32}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{40}
 }
 
 func (m *Proof) XXX_Unmarshal(b []byte) error {
@@ -1738,7 +2309,7 @@ func (m *SafeItem) Reset()         { *m = SafeItem{} }
 func (m *SafeItem) String() string { return proto.CompactTextString(m) }
 func (*SafeItem) ProtoMessage()    {}
 func (*SafeItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{33}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{41}
 }
 
 func (m *SafeItem) XXX_Unmarshal(b []byte) error {
@@ -1785,7 +2356,7 @@ func (m *SafeStructuredItem) Reset()         { *m = SafeStructuredItem{} }
 func (m *SafeStructuredItem) String() string { return proto.CompactTextString(m) }
 func (*SafeStructuredItem) ProtoMessage()    {}
 func (*SafeStructuredItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{34}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{42}
 }
 
 func (m *SafeStructuredItem) XXX_Unmarshal(b []byte) error {
@@ -1832,7 +2403,7 @@ func (m *SafeSetOptions) Reset()         { *m = SafeSetOptions{} }
 func (m *SafeSetOptions) String() string { return proto.CompactTextString(m) }
 func (*SafeSetOptions) ProtoMessage()    {}
 func (*SafeSetOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{35}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{43}
 }
 
 func (m *SafeSetOptions) XXX_Unmarshal(b []byte) error {
@@ -1879,7 +2450,7 @@ func (m *SafeSetSVOptions) Reset()         { *m = SafeSetSVOptions{} }
 func (m *SafeSetSVOptions) String() string { return proto.CompactTextString(m) }
 func (*SafeSetSVOptions) ProtoMessage()    {}
 func (*SafeSetSVOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{36}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{44}
 }
 
 func (m *SafeSetSVOptions) XXX_Unmarshal(b []byte) error {
@@ -1926,7 +2497,7 @@ func (m *SafeGetOptions) Reset()         { *m = SafeGetOptions{} }
 func (m *SafeGetOptions) String() string { return proto.CompactTextString(m) }
 func (*SafeGetOptions) ProtoMessage()    {}
 func (*SafeGetOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{37}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{45}
 }
 
 func (m *SafeGetOptions) XXX_Unmarshal(b []byte) error {
@@ -1973,7 +2544,7 @@ func (m *SafeReferenceOptions) Reset()         { *m = SafeReferenceOptions{} }
 func (m *SafeReferenceOptions) String() string { return proto.CompactTextString(m) }
 func (*SafeReferenceOptions) ProtoMessage()    {}
 func (*SafeReferenceOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{38}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{46}
 }
 
 func (m *SafeReferenceOptions) XXX_Unmarshal(b []byte) error {
@@ -2009,18 +2580,21 @@ func (m *SafeReferenceOptions) GetRootIndex() *Index {
 }
 
 type HealthResponse struct {
-	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Version              string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Status               bool             `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Version              string           `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	ApiVersion           uint32           `protobuf:"varint,3,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
+	Capabilities         []string         `protobuf:"bytes,4,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	Startup              *StartupProgress `protobuf:"bytes,5,opt,name=startup,proto3" json:"startup,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *HealthResponse) Reset()         { *m = HealthResponse{} }
 func (m *HealthResponse) String() string { return proto.CompactTextString(m) }
 func (*HealthResponse) ProtoMessage()    {}
 func (*HealthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{39}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{47}
 }
 
 func (m *HealthResponse) XXX_Unmarshal(b []byte) error {
@@ -2055,19 +2629,40 @@ func (m *HealthResponse) GetVersion() string {
 	return ""
 }
 
-type ReferenceOptions struct {
-	Reference            []byte   `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
-	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *HealthResponse) GetApiVersion() uint32 {
+	if m != nil {
+		return m.ApiVersion
+	}
+	return 0
+}
+
+func (m *HealthResponse) GetCapabilities() []string {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
+func (m *HealthResponse) GetStartup() *StartupProgress {
+	if m != nil {
+		return m.Startup
+	}
+	return nil
+}
+
+type ReferenceOptions struct {
+	Reference            []byte   `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ReferenceOptions) Reset()         { *m = ReferenceOptions{} }
 func (m *ReferenceOptions) String() string { return proto.CompactTextString(m) }
 func (*ReferenceOptions) ProtoMessage()    {}
 func (*ReferenceOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{40}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{48}
 }
 
 func (m *ReferenceOptions) XXX_Unmarshal(b []byte) error {
@@ -2119,7 +2714,7 @@ func (m *ZAddOptions) Reset()         { *m = ZAddOptions{} }
 func (m *ZAddOptions) String() string { return proto.CompactTextString(m) }
 func (*ZAddOptions) ProtoMessage()    {}
 func (*ZAddOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{41}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{49}
 }
 
 func (m *ZAddOptions) XXX_Unmarshal(b []byte) error {
@@ -2175,7 +2770,7 @@ func (m *ZScanOptions) Reset()         { *m = ZScanOptions{} }
 func (m *ZScanOptions) String() string { return proto.CompactTextString(m) }
 func (*ZScanOptions) ProtoMessage()    {}
 func (*ZScanOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{42}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{50}
 }
 
 func (m *ZScanOptions) XXX_Unmarshal(b []byte) error {
@@ -2236,7 +2831,7 @@ func (m *IScanOptions) Reset()         { *m = IScanOptions{} }
 func (m *IScanOptions) String() string { return proto.CompactTextString(m) }
 func (*IScanOptions) ProtoMessage()    {}
 func (*IScanOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{43}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{51}
 }
 
 func (m *IScanOptions) XXX_Unmarshal(b []byte) error {
@@ -2283,7 +2878,7 @@ func (m *Page) Reset()         { *m = Page{} }
 func (m *Page) String() string { return proto.CompactTextString(m) }
 func (*Page) ProtoMessage()    {}
 func (*Page) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{44}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{52}
 }
 
 func (m *Page) XXX_Unmarshal(b []byte) error {
@@ -2331,7 +2926,7 @@ func (m *SPage) Reset()         { *m = SPage{} }
 func (m *SPage) String() string { return proto.CompactTextString(m) }
 func (*SPage) ProtoMessage()    {}
 func (*SPage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{45}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{53}
 }
 
 func (m *SPage) XXX_Unmarshal(b []byte) error {
@@ -2385,7 +2980,7 @@ func (m *SafeZAddOptions) Reset()         { *m = SafeZAddOptions{} }
 func (m *SafeZAddOptions) String() string { return proto.CompactTextString(m) }
 func (*SafeZAddOptions) ProtoMessage()    {}
 func (*SafeZAddOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{46}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{54}
 }
 
 func (m *SafeZAddOptions) XXX_Unmarshal(b []byte) error {
@@ -2432,7 +3027,7 @@ func (m *SafeIndexOptions) Reset()         { *m = SafeIndexOptions{} }
 func (m *SafeIndexOptions) String() string { return proto.CompactTextString(m) }
 func (*SafeIndexOptions) ProtoMessage()    {}
 func (*SafeIndexOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{47}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{55}
 }
 
 func (m *SafeIndexOptions) XXX_Unmarshal(b []byte) error {
@@ -2479,7 +3074,7 @@ func (m *Error) Reset()         { *m = Error{} }
 func (m *Error) String() string { return proto.CompactTextString(m) }
 func (*Error) ProtoMessage()    {}
 func (*Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{48}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{56}
 }
 
 func (m *Error) XXX_Unmarshal(b []byte) error {
@@ -2516,6 +3111,7 @@ func (m *Error) GetErrormessage() string {
 
 type Database struct {
 	Databasename         string   `protobuf:"bytes,1,opt,name=databasename,proto3" json:"databasename,omitempty"`
+	Collation            string   `protobuf:"bytes,2,opt,name=collation,proto3" json:"collation,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2525,7 +3121,7 @@ func (m *Database) Reset()         { *m = Database{} }
 func (m *Database) String() string { return proto.CompactTextString(m) }
 func (*Database) ProtoMessage()    {}
 func (*Database) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{49}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{57}
 }
 
 func (m *Database) XXX_Unmarshal(b []byte) error {
@@ -2553,6 +3149,13 @@ func (m *Database) GetDatabasename() string {
 	return ""
 }
 
+func (m *Database) GetCollation() string {
+	if m != nil {
+		return m.Collation
+	}
+	return ""
+}
+
 type UseDatabaseReply struct {
 	Error                *Error   `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	Token                string   `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
@@ -2565,7 +3168,7 @@ func (m *UseDatabaseReply) Reset()         { *m = UseDatabaseReply{} }
 func (m *UseDatabaseReply) String() string { return proto.CompactTextString(m) }
 func (*UseDatabaseReply) ProtoMessage()    {}
 func (*UseDatabaseReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{50}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{58}
 }
 
 func (m *UseDatabaseReply) XXX_Unmarshal(b []byte) error {
@@ -2611,7 +3214,7 @@ func (m *CreateDatabaseReply) Reset()         { *m = CreateDatabaseReply{} }
 func (m *CreateDatabaseReply) String() string { return proto.CompactTextString(m) }
 func (*CreateDatabaseReply) ProtoMessage()    {}
 func (*CreateDatabaseReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{51}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{59}
 }
 
 func (m *CreateDatabaseReply) XXX_Unmarshal(b []byte) error {
@@ -2639,6 +3242,395 @@ func (m *CreateDatabaseReply) GetError() *Error {
 	return nil
 }
 
+type DatabaseTemplateRequest struct {
+	Databasename         string   `protobuf:"bytes,1,opt,name=databasename,proto3" json:"databasename,omitempty"`
+	Template             string   `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	WithData             bool     `protobuf:"varint,3,opt,name=withData,proto3" json:"withData,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DatabaseTemplateRequest) Reset()         { *m = DatabaseTemplateRequest{} }
+func (m *DatabaseTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*DatabaseTemplateRequest) ProtoMessage()    {}
+func (*DatabaseTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{60}
+}
+
+func (m *DatabaseTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseTemplateRequest.Unmarshal(m, b)
+}
+func (m *DatabaseTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseTemplateRequest.Marshal(b, m, deterministic)
+}
+func (m *DatabaseTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseTemplateRequest.Merge(m, src)
+}
+func (m *DatabaseTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_DatabaseTemplateRequest.Size(m)
+}
+func (m *DatabaseTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatabaseTemplateRequest proto.InternalMessageInfo
+
+func (m *DatabaseTemplateRequest) GetDatabasename() string {
+	if m != nil {
+		return m.Databasename
+	}
+	return ""
+}
+
+func (m *DatabaseTemplateRequest) GetTemplate() string {
+	if m != nil {
+		return m.Template
+	}
+	return ""
+}
+
+func (m *DatabaseTemplateRequest) GetWithData() bool {
+	if m != nil {
+		return m.WithData
+	}
+	return false
+}
+
+type BulkLoadRequest struct {
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	// number of batches already committed by a previous interrupted load of the same file, to be skipped
+	ResumeAfterBatches   uint64   `protobuf:"varint,2,opt,name=resumeAfterBatches,proto3" json:"resumeAfterBatches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BulkLoadRequest) Reset()         { *m = BulkLoadRequest{} }
+func (m *BulkLoadRequest) String() string { return proto.CompactTextString(m) }
+func (*BulkLoadRequest) ProtoMessage()    {}
+func (*BulkLoadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{61}
+}
+
+func (m *BulkLoadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkLoadRequest.Unmarshal(m, b)
+}
+func (m *BulkLoadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkLoadRequest.Marshal(b, m, deterministic)
+}
+func (m *BulkLoadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkLoadRequest.Merge(m, src)
+}
+func (m *BulkLoadRequest) XXX_Size() int {
+	return xxx_messageInfo_BulkLoadRequest.Size(m)
+}
+func (m *BulkLoadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkLoadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkLoadRequest proto.InternalMessageInfo
+
+func (m *BulkLoadRequest) GetFilename() string {
+	if m != nil {
+		return m.Filename
+	}
+	return ""
+}
+
+func (m *BulkLoadRequest) GetResumeAfterBatches() uint64 {
+	if m != nil {
+		return m.ResumeAfterBatches
+	}
+	return 0
+}
+
+type BulkLoadReply struct {
+	Entries uint64 `protobuf:"varint,1,opt,name=entries,proto3" json:"entries,omitempty"`
+	Batches uint64 `protobuf:"varint,2,opt,name=batches,proto3" json:"batches,omitempty"`
+	Index   uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Digest  []byte `protobuf:"bytes,4,opt,name=digest,proto3" json:"digest,omitempty"`
+	// key and value bytes written by this load, skipped batches excluded
+	Bytes                uint64   `protobuf:"varint,5,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BulkLoadReply) Reset()         { *m = BulkLoadReply{} }
+func (m *BulkLoadReply) String() string { return proto.CompactTextString(m) }
+func (*BulkLoadReply) ProtoMessage()    {}
+func (*BulkLoadReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{62}
+}
+
+func (m *BulkLoadReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkLoadReply.Unmarshal(m, b)
+}
+func (m *BulkLoadReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkLoadReply.Marshal(b, m, deterministic)
+}
+func (m *BulkLoadReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkLoadReply.Merge(m, src)
+}
+func (m *BulkLoadReply) XXX_Size() int {
+	return xxx_messageInfo_BulkLoadReply.Size(m)
+}
+func (m *BulkLoadReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkLoadReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkLoadReply proto.InternalMessageInfo
+
+func (m *BulkLoadReply) GetEntries() uint64 {
+	if m != nil {
+		return m.Entries
+	}
+	return 0
+}
+
+func (m *BulkLoadReply) GetBatches() uint64 {
+	if m != nil {
+		return m.Batches
+	}
+	return 0
+}
+
+func (m *BulkLoadReply) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *BulkLoadReply) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+func (m *BulkLoadReply) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+type AuthorizationRequest struct {
+	User       string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Method     string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Database   string `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
+	OnBehalfOf string `protobuf:"bytes,4,opt,name=onBehalfOf,proto3" json:"onBehalfOf,omitempty"`
+	// keys read or written by the operation, the prefix or the set for scans. Empty when the method doesn't
+	// address specific keys, e.g. CurrentRoot, or for streaming methods whose requests aren't known upfront
+	Keys                 [][]byte `protobuf:"bytes,5,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthorizationRequest) Reset()         { *m = AuthorizationRequest{} }
+func (m *AuthorizationRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthorizationRequest) ProtoMessage()    {}
+func (*AuthorizationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{63}
+}
+
+func (m *AuthorizationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthorizationRequest.Unmarshal(m, b)
+}
+func (m *AuthorizationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthorizationRequest.Marshal(b, m, deterministic)
+}
+func (m *AuthorizationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizationRequest.Merge(m, src)
+}
+func (m *AuthorizationRequest) XXX_Size() int {
+	return xxx_messageInfo_AuthorizationRequest.Size(m)
+}
+func (m *AuthorizationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorizationRequest proto.InternalMessageInfo
+
+func (m *AuthorizationRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *AuthorizationRequest) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *AuthorizationRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *AuthorizationRequest) GetOnBehalfOf() string {
+	if m != nil {
+		return m.OnBehalfOf
+	}
+	return ""
+}
+
+func (m *AuthorizationRequest) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type AuthorizationResponse struct {
+	Allowed              bool     `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthorizationResponse) Reset()         { *m = AuthorizationResponse{} }
+func (m *AuthorizationResponse) String() string { return proto.CompactTextString(m) }
+func (*AuthorizationResponse) ProtoMessage()    {}
+func (*AuthorizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{64}
+}
+
+func (m *AuthorizationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthorizationResponse.Unmarshal(m, b)
+}
+func (m *AuthorizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthorizationResponse.Marshal(b, m, deterministic)
+}
+func (m *AuthorizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizationResponse.Merge(m, src)
+}
+func (m *AuthorizationResponse) XXX_Size() int {
+	return xxx_messageInfo_AuthorizationResponse.Size(m)
+}
+func (m *AuthorizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorizationResponse proto.InternalMessageInfo
+
+func (m *AuthorizationResponse) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *AuthorizationResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type ValidationRequest struct {
+	Database             string      `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Entries              []*KeyValue `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ValidationRequest) Reset()         { *m = ValidationRequest{} }
+func (m *ValidationRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidationRequest) ProtoMessage()    {}
+func (*ValidationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{65}
+}
+
+func (m *ValidationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidationRequest.Unmarshal(m, b)
+}
+func (m *ValidationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidationRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidationRequest.Merge(m, src)
+}
+func (m *ValidationRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidationRequest.Size(m)
+}
+func (m *ValidationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidationRequest proto.InternalMessageInfo
+
+func (m *ValidationRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *ValidationRequest) GetEntries() []*KeyValue {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type ValidationResponse struct {
+	Allowed              bool     `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidationResponse) Reset()         { *m = ValidationResponse{} }
+func (m *ValidationResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidationResponse) ProtoMessage()    {}
+func (*ValidationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{66}
+}
+
+func (m *ValidationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidationResponse.Unmarshal(m, b)
+}
+func (m *ValidationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidationResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidationResponse.Merge(m, src)
+}
+func (m *ValidationResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidationResponse.Size(m)
+}
+func (m *ValidationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidationResponse proto.InternalMessageInfo
+
+func (m *ValidationResponse) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *ValidationResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type ChangePermissionRequest struct {
 	Action               PermissionAction `protobuf:"varint,1,opt,name=action,proto3,enum=immudb.schema.PermissionAction" json:"action,omitempty"`
 	Username             string           `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
@@ -2653,7 +3645,7 @@ func (m *ChangePermissionRequest) Reset()         { *m = ChangePermissionRequest
 func (m *ChangePermissionRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangePermissionRequest) ProtoMessage()    {}
 func (*ChangePermissionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{52}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{67}
 }
 
 func (m *ChangePermissionRequest) XXX_Unmarshal(b []byte) error {
@@ -2714,7 +3706,7 @@ func (m *SetActiveUserRequest) Reset()         { *m = SetActiveUserRequest{} }
 func (m *SetActiveUserRequest) String() string { return proto.CompactTextString(m) }
 func (*SetActiveUserRequest) ProtoMessage()    {}
 func (*SetActiveUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{53}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{68}
 }
 
 func (m *SetActiveUserRequest) XXX_Unmarshal(b []byte) error {
@@ -2760,30 +3752,505 @@ func (m *DatabaseListResponse) Reset()         { *m = DatabaseListResponse{} }
 func (m *DatabaseListResponse) String() string { return proto.CompactTextString(m) }
 func (*DatabaseListResponse) ProtoMessage()    {}
 func (*DatabaseListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{54}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{69}
+}
+
+func (m *DatabaseListResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseListResponse.Unmarshal(m, b)
+}
+func (m *DatabaseListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseListResponse.Marshal(b, m, deterministic)
+}
+func (m *DatabaseListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseListResponse.Merge(m, src)
+}
+func (m *DatabaseListResponse) XXX_Size() int {
+	return xxx_messageInfo_DatabaseListResponse.Size(m)
+}
+func (m *DatabaseListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatabaseListResponse proto.InternalMessageInfo
+
+func (m *DatabaseListResponse) GetDatabases() []*Database {
+	if m != nil {
+		return m.Databases
+	}
+	return nil
+}
+
+type DeletedDatabase struct {
+	Databasename         string   `protobuf:"bytes,1,opt,name=databasename,proto3" json:"databasename,omitempty"`
+	DeletedAt            int64    `protobuf:"varint,2,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
+	PurgeableAt          int64    `protobuf:"varint,3,opt,name=purgeableAt,proto3" json:"purgeableAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeletedDatabase) Reset()         { *m = DeletedDatabase{} }
+func (m *DeletedDatabase) String() string { return proto.CompactTextString(m) }
+func (*DeletedDatabase) ProtoMessage()    {}
+func (*DeletedDatabase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{70}
+}
+
+func (m *DeletedDatabase) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeletedDatabase.Unmarshal(m, b)
+}
+func (m *DeletedDatabase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeletedDatabase.Marshal(b, m, deterministic)
+}
+func (m *DeletedDatabase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletedDatabase.Merge(m, src)
+}
+func (m *DeletedDatabase) XXX_Size() int {
+	return xxx_messageInfo_DeletedDatabase.Size(m)
+}
+func (m *DeletedDatabase) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletedDatabase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletedDatabase proto.InternalMessageInfo
+
+func (m *DeletedDatabase) GetDatabasename() string {
+	if m != nil {
+		return m.Databasename
+	}
+	return ""
+}
+
+func (m *DeletedDatabase) GetDeletedAt() int64 {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return 0
+}
+
+func (m *DeletedDatabase) GetPurgeableAt() int64 {
+	if m != nil {
+		return m.PurgeableAt
+	}
+	return 0
+}
+
+type DeletedDatabaseList struct {
+	Databases            []*DeletedDatabase `protobuf:"bytes,1,rep,name=databases,proto3" json:"databases,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DeletedDatabaseList) Reset()         { *m = DeletedDatabaseList{} }
+func (m *DeletedDatabaseList) String() string { return proto.CompactTextString(m) }
+func (*DeletedDatabaseList) ProtoMessage()    {}
+func (*DeletedDatabaseList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{71}
+}
+
+func (m *DeletedDatabaseList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeletedDatabaseList.Unmarshal(m, b)
+}
+func (m *DeletedDatabaseList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeletedDatabaseList.Marshal(b, m, deterministic)
+}
+func (m *DeletedDatabaseList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletedDatabaseList.Merge(m, src)
+}
+func (m *DeletedDatabaseList) XXX_Size() int {
+	return xxx_messageInfo_DeletedDatabaseList.Size(m)
+}
+func (m *DeletedDatabaseList) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletedDatabaseList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletedDatabaseList proto.InternalMessageInfo
+
+func (m *DeletedDatabaseList) GetDatabases() []*DeletedDatabase {
+	if m != nil {
+		return m.Databases
+	}
+	return nil
+}
+
+type Codec struct {
+	Id   uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// kind is one of compression, encryption or serialization
+	Kind                 string   `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Codec) Reset()         { *m = Codec{} }
+func (m *Codec) String() string { return proto.CompactTextString(m) }
+func (*Codec) ProtoMessage()    {}
+func (*Codec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{72}
+}
+
+func (m *Codec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Codec.Unmarshal(m, b)
+}
+func (m *Codec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Codec.Marshal(b, m, deterministic)
+}
+func (m *Codec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Codec.Merge(m, src)
+}
+func (m *Codec) XXX_Size() int {
+	return xxx_messageInfo_Codec.Size(m)
+}
+func (m *Codec) XXX_DiscardUnknown() {
+	xxx_messageInfo_Codec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Codec proto.InternalMessageInfo
+
+func (m *Codec) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Codec) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Codec) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+type Codecs struct {
+	Codecs               []*Codec `protobuf:"bytes,1,rep,name=codecs,proto3" json:"codecs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Codecs) Reset()         { *m = Codecs{} }
+func (m *Codecs) String() string { return proto.CompactTextString(m) }
+func (*Codecs) ProtoMessage()    {}
+func (*Codecs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{73}
+}
+
+func (m *Codecs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Codecs.Unmarshal(m, b)
+}
+func (m *Codecs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Codecs.Marshal(b, m, deterministic)
+}
+func (m *Codecs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Codecs.Merge(m, src)
+}
+func (m *Codecs) XXX_Size() int {
+	return xxx_messageInfo_Codecs.Size(m)
+}
+func (m *Codecs) XXX_DiscardUnknown() {
+	xxx_messageInfo_Codecs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Codecs proto.InternalMessageInfo
+
+func (m *Codecs) GetCodecs() []*Codec {
+	if m != nil {
+		return m.Codecs
+	}
+	return nil
+}
+
+type ReportOptions struct {
+	PrefixLength         uint32   `protobuf:"varint,1,opt,name=prefixLength,proto3" json:"prefixLength,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReportOptions) Reset()         { *m = ReportOptions{} }
+func (m *ReportOptions) String() string { return proto.CompactTextString(m) }
+func (*ReportOptions) ProtoMessage()    {}
+func (*ReportOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{74}
+}
+
+func (m *ReportOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportOptions.Unmarshal(m, b)
+}
+func (m *ReportOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportOptions.Marshal(b, m, deterministic)
+}
+func (m *ReportOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportOptions.Merge(m, src)
+}
+func (m *ReportOptions) XXX_Size() int {
+	return xxx_messageInfo_ReportOptions.Size(m)
+}
+func (m *ReportOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportOptions proto.InternalMessageInfo
+
+func (m *ReportOptions) GetPrefixLength() uint32 {
+	if m != nil {
+		return m.PrefixLength
+	}
+	return 0
+}
+
+type PrefixReport struct {
+	Prefix               []byte   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Keys                 uint64   `protobuf:"varint,2,opt,name=keys,proto3" json:"keys,omitempty"`
+	Entries              uint64   `protobuf:"varint,3,opt,name=entries,proto3" json:"entries,omitempty"`
+	ValueBytes           uint64   `protobuf:"varint,4,opt,name=valueBytes,proto3" json:"valueBytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrefixReport) Reset()         { *m = PrefixReport{} }
+func (m *PrefixReport) String() string { return proto.CompactTextString(m) }
+func (*PrefixReport) ProtoMessage()    {}
+func (*PrefixReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{75}
+}
+
+func (m *PrefixReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrefixReport.Unmarshal(m, b)
+}
+func (m *PrefixReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrefixReport.Marshal(b, m, deterministic)
+}
+func (m *PrefixReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrefixReport.Merge(m, src)
+}
+func (m *PrefixReport) XXX_Size() int {
+	return xxx_messageInfo_PrefixReport.Size(m)
+}
+func (m *PrefixReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrefixReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrefixReport proto.InternalMessageInfo
+
+func (m *PrefixReport) GetPrefix() []byte {
+	if m != nil {
+		return m.Prefix
+	}
+	return nil
+}
+
+func (m *PrefixReport) GetKeys() uint64 {
+	if m != nil {
+		return m.Keys
+	}
+	return 0
+}
+
+func (m *PrefixReport) GetEntries() uint64 {
+	if m != nil {
+		return m.Entries
+	}
+	return 0
+}
+
+func (m *PrefixReport) GetValueBytes() uint64 {
+	if m != nil {
+		return m.ValueBytes
+	}
+	return 0
+}
+
+type ValueSizeBucket struct {
+	// upper bound (inclusive) of the bucket, 0 for the last, unbounded one
+	MaxSize              uint64   `protobuf:"varint,1,opt,name=maxSize,proto3" json:"maxSize,omitempty"`
+	Entries              uint64   `protobuf:"varint,2,opt,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValueSizeBucket) Reset()         { *m = ValueSizeBucket{} }
+func (m *ValueSizeBucket) String() string { return proto.CompactTextString(m) }
+func (*ValueSizeBucket) ProtoMessage()    {}
+func (*ValueSizeBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{76}
+}
+
+func (m *ValueSizeBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValueSizeBucket.Unmarshal(m, b)
+}
+func (m *ValueSizeBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValueSizeBucket.Marshal(b, m, deterministic)
+}
+func (m *ValueSizeBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValueSizeBucket.Merge(m, src)
+}
+func (m *ValueSizeBucket) XXX_Size() int {
+	return xxx_messageInfo_ValueSizeBucket.Size(m)
+}
+func (m *ValueSizeBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValueSizeBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValueSizeBucket proto.InternalMessageInfo
+
+func (m *ValueSizeBucket) GetMaxSize() uint64 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+func (m *ValueSizeBucket) GetEntries() uint64 {
+	if m != nil {
+		return m.Entries
+	}
+	return 0
+}
+
+// entries written per day, only for the values recognized as structured ones by their encoding
+type GrowthReport struct {
+	// day in YYYY-MM-DD format
+	Day                  string   `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	Entries              uint64   `protobuf:"varint,2,opt,name=entries,proto3" json:"entries,omitempty"`
+	ValueBytes           uint64   `protobuf:"varint,3,opt,name=valueBytes,proto3" json:"valueBytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GrowthReport) Reset()         { *m = GrowthReport{} }
+func (m *GrowthReport) String() string { return proto.CompactTextString(m) }
+func (*GrowthReport) ProtoMessage()    {}
+func (*GrowthReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{77}
+}
+
+func (m *GrowthReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrowthReport.Unmarshal(m, b)
+}
+func (m *GrowthReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrowthReport.Marshal(b, m, deterministic)
+}
+func (m *GrowthReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrowthReport.Merge(m, src)
+}
+func (m *GrowthReport) XXX_Size() int {
+	return xxx_messageInfo_GrowthReport.Size(m)
+}
+func (m *GrowthReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrowthReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrowthReport proto.InternalMessageInfo
+
+func (m *GrowthReport) GetDay() string {
+	if m != nil {
+		return m.Day
+	}
+	return ""
+}
+
+func (m *GrowthReport) GetEntries() uint64 {
+	if m != nil {
+		return m.Entries
+	}
+	return 0
+}
+
+func (m *GrowthReport) GetValueBytes() uint64 {
+	if m != nil {
+		return m.ValueBytes
+	}
+	return 0
+}
+
+type StoreReport struct {
+	Keys                 uint64             `protobuf:"varint,1,opt,name=keys,proto3" json:"keys,omitempty"`
+	Entries              uint64             `protobuf:"varint,2,opt,name=entries,proto3" json:"entries,omitempty"`
+	ValueBytes           uint64             `protobuf:"varint,3,opt,name=valueBytes,proto3" json:"valueBytes,omitempty"`
+	Prefixes             []*PrefixReport    `protobuf:"bytes,4,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+	ValueSizes           []*ValueSizeBucket `protobuf:"bytes,5,rep,name=valueSizes,proto3" json:"valueSizes,omitempty"`
+	Growth               []*GrowthReport    `protobuf:"bytes,6,rep,name=growth,proto3" json:"growth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *StoreReport) Reset()         { *m = StoreReport{} }
+func (m *StoreReport) String() string { return proto.CompactTextString(m) }
+func (*StoreReport) ProtoMessage()    {}
+func (*StoreReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{78}
+}
+
+func (m *StoreReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoreReport.Unmarshal(m, b)
+}
+func (m *StoreReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoreReport.Marshal(b, m, deterministic)
 }
-
-func (m *DatabaseListResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DatabaseListResponse.Unmarshal(m, b)
+func (m *StoreReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreReport.Merge(m, src)
 }
-func (m *DatabaseListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DatabaseListResponse.Marshal(b, m, deterministic)
+func (m *StoreReport) XXX_Size() int {
+	return xxx_messageInfo_StoreReport.Size(m)
 }
-func (m *DatabaseListResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DatabaseListResponse.Merge(m, src)
+func (m *StoreReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreReport.DiscardUnknown(m)
 }
-func (m *DatabaseListResponse) XXX_Size() int {
-	return xxx_messageInfo_DatabaseListResponse.Size(m)
+
+var xxx_messageInfo_StoreReport proto.InternalMessageInfo
+
+func (m *StoreReport) GetKeys() uint64 {
+	if m != nil {
+		return m.Keys
+	}
+	return 0
 }
-func (m *DatabaseListResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DatabaseListResponse.DiscardUnknown(m)
+
+func (m *StoreReport) GetEntries() uint64 {
+	if m != nil {
+		return m.Entries
+	}
+	return 0
 }
 
-var xxx_messageInfo_DatabaseListResponse proto.InternalMessageInfo
+func (m *StoreReport) GetValueBytes() uint64 {
+	if m != nil {
+		return m.ValueBytes
+	}
+	return 0
+}
 
-func (m *DatabaseListResponse) GetDatabases() []*Database {
+func (m *StoreReport) GetPrefixes() []*PrefixReport {
 	if m != nil {
-		return m.Databases
+		return m.Prefixes
+	}
+	return nil
+}
+
+func (m *StoreReport) GetValueSizes() []*ValueSizeBucket {
+	if m != nil {
+		return m.ValueSizes
+	}
+	return nil
+}
+
+func (m *StoreReport) GetGrowth() []*GrowthReport {
+	if m != nil {
+		return m.Growth
 	}
 	return nil
 }
@@ -2795,23 +4262,34 @@ func init() {
 	proto.RegisterType((*Permission)(nil), "immudb.schema.Permission")
 	proto.RegisterType((*User)(nil), "immudb.schema.User")
 	proto.RegisterType((*UserList)(nil), "immudb.schema.UserList")
+	proto.RegisterType((*UserUsage)(nil), "immudb.schema.UserUsage")
+	proto.RegisterType((*UsageList)(nil), "immudb.schema.UsageList")
+	proto.RegisterType((*OnBehalfOfRecord)(nil), "immudb.schema.OnBehalfOfRecord")
+	proto.RegisterType((*OnBehalfOfRequest)(nil), "immudb.schema.OnBehalfOfRequest")
+	proto.RegisterType((*OnBehalfOfList)(nil), "immudb.schema.OnBehalfOfList")
 	proto.RegisterType((*CreateUserRequest)(nil), "immudb.schema.CreateUserRequest")
 	proto.RegisterType((*UserRequest)(nil), "immudb.schema.UserRequest")
 	proto.RegisterType((*UserResponse)(nil), "immudb.schema.UserResponse")
 	proto.RegisterType((*ChangePasswordRequest)(nil), "immudb.schema.ChangePasswordRequest")
 	proto.RegisterType((*LoginRequest)(nil), "immudb.schema.LoginRequest")
 	proto.RegisterType((*LoginResponse)(nil), "immudb.schema.LoginResponse")
+	proto.RegisterType((*StartupProgress)(nil), "immudb.schema.StartupProgress")
+	proto.RegisterType((*LogRequest)(nil), "immudb.schema.LogRequest")
+	proto.RegisterType((*LogLine)(nil), "immudb.schema.LogLine")
 	proto.RegisterType((*AuthConfig)(nil), "immudb.schema.AuthConfig")
 	proto.RegisterType((*MTLSConfig)(nil), "immudb.schema.MTLSConfig")
 	proto.RegisterType((*Node)(nil), "immudb.schema.Node")
 	proto.RegisterType((*Layer)(nil), "immudb.schema.Layer")
 	proto.RegisterType((*Tree)(nil), "immudb.schema.Tree")
 	proto.RegisterType((*KeyValue)(nil), "immudb.schema.KeyValue")
+	proto.RegisterMapType((map[string]string)(nil), "immudb.schema.KeyValue.LabelsEntry")
 	proto.RegisterType((*StructuredKeyValue)(nil), "immudb.schema.StructuredKeyValue")
 	proto.RegisterType((*Content)(nil), "immudb.schema.Content")
 	proto.RegisterType((*Index)(nil), "immudb.schema.Index")
 	proto.RegisterType((*Item)(nil), "immudb.schema.Item")
+	proto.RegisterMapType((map[string]string)(nil), "immudb.schema.Item.LabelsEntry")
 	proto.RegisterType((*StructuredItem)(nil), "immudb.schema.StructuredItem")
+	proto.RegisterMapType((map[string]string)(nil), "immudb.schema.StructuredItem.LabelsEntry")
 	proto.RegisterType((*KVList)(nil), "immudb.schema.KVList")
 	proto.RegisterType((*SKVList)(nil), "immudb.schema.SKVList")
 	proto.RegisterType((*KeyList)(nil), "immudb.schema.KeyList")
@@ -2819,6 +4297,7 @@ func init() {
 	proto.RegisterType((*StructuredItemList)(nil), "immudb.schema.StructuredItemList")
 	proto.RegisterType((*Root)(nil), "immudb.schema.Root")
 	proto.RegisterType((*ScanOptions)(nil), "immudb.schema.ScanOptions")
+	proto.RegisterMapType((map[string]string)(nil), "immudb.schema.ScanOptions.LabelsEntry")
 	proto.RegisterType((*KeyPrefix)(nil), "immudb.schema.KeyPrefix")
 	proto.RegisterType((*ItemsCount)(nil), "immudb.schema.ItemsCount")
 	proto.RegisterType((*InclusionProof)(nil), "immudb.schema.InclusionProof")
@@ -2843,216 +4322,312 @@ func init() {
 	proto.RegisterType((*Database)(nil), "immudb.schema.Database")
 	proto.RegisterType((*UseDatabaseReply)(nil), "immudb.schema.UseDatabaseReply")
 	proto.RegisterType((*CreateDatabaseReply)(nil), "immudb.schema.CreateDatabaseReply")
+	proto.RegisterType((*DatabaseTemplateRequest)(nil), "immudb.schema.DatabaseTemplateRequest")
+	proto.RegisterType((*BulkLoadRequest)(nil), "immudb.schema.BulkLoadRequest")
+	proto.RegisterType((*BulkLoadReply)(nil), "immudb.schema.BulkLoadReply")
+	proto.RegisterType((*AuthorizationRequest)(nil), "immudb.schema.AuthorizationRequest")
+	proto.RegisterType((*AuthorizationResponse)(nil), "immudb.schema.AuthorizationResponse")
+	proto.RegisterType((*ValidationRequest)(nil), "immudb.schema.ValidationRequest")
+	proto.RegisterType((*ValidationResponse)(nil), "immudb.schema.ValidationResponse")
 	proto.RegisterType((*ChangePermissionRequest)(nil), "immudb.schema.ChangePermissionRequest")
 	proto.RegisterType((*SetActiveUserRequest)(nil), "immudb.schema.SetActiveUserRequest")
 	proto.RegisterType((*DatabaseListResponse)(nil), "immudb.schema.DatabaseListResponse")
+	proto.RegisterType((*DeletedDatabase)(nil), "immudb.schema.DeletedDatabase")
+	proto.RegisterType((*DeletedDatabaseList)(nil), "immudb.schema.DeletedDatabaseList")
+	proto.RegisterType((*Codec)(nil), "immudb.schema.Codec")
+	proto.RegisterType((*Codecs)(nil), "immudb.schema.Codecs")
+	proto.RegisterType((*ReportOptions)(nil), "immudb.schema.ReportOptions")
+	proto.RegisterType((*PrefixReport)(nil), "immudb.schema.PrefixReport")
+	proto.RegisterType((*ValueSizeBucket)(nil), "immudb.schema.ValueSizeBucket")
+	proto.RegisterType((*GrowthReport)(nil), "immudb.schema.GrowthReport")
+	proto.RegisterType((*StoreReport)(nil), "immudb.schema.StoreReport")
 }
 
 func init() { proto.RegisterFile("schema.proto", fileDescriptor_1c5fb4d8cc22d66a) }
 
 var fileDescriptor_1c5fb4d8cc22d66a = []byte{
-	// 3066 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0x4b, 0x53, 0x23, 0xc9,
-	0xb5, 0xa6, 0xf4, 0x00, 0x74, 0x78, 0x8c, 0x6e, 0x4e, 0x4f, 0xa3, 0x51, 0xd3, 0x83, 0xc8, 0x7e,
-	0xd1, 0x0c, 0x8d, 0xfa, 0x31, 0x73, 0xe7, 0x46, 0x5f, 0x82, 0x7b, 0x05, 0xc8, 0xb4, 0x06, 0x1a,
-	0x88, 0x92, 0x9a, 0xb1, 0xb1, 0x27, 0x88, 0x92, 0x2a, 0x25, 0xd5, 0x20, 0x55, 0xc9, 0x55, 0x29,
-	0x68, 0x41, 0x74, 0x4c, 0x8c, 0xb7, 0xde, 0x8d, 0xb7, 0xde, 0xda, 0x0b, 0xff, 0x15, 0x2f, 0xbd,
-	0x9b, 0xb5, 0xd7, 0xfe, 0x0d, 0x8e, 0x7c, 0xd4, 0x43, 0xa5, 0x2a, 0x41, 0x13, 0xde, 0x34, 0x95,
-	0x99, 0x27, 0xcf, 0x77, 0xce, 0xc9, 0xcc, 0x93, 0x79, 0xbe, 0x16, 0xcc, 0x3a, 0x8d, 0x36, 0xe9,
-	0x6a, 0xeb, 0x3d, 0xdb, 0xa2, 0x16, 0x9a, 0x33, 0xba, 0xdd, 0xbe, 0x5e, 0x5f, 0x17, 0x9d, 0xf9,
-	0xc5, 0x96, 0x65, 0xb5, 0x3a, 0xa4, 0xa8, 0xf5, 0x8c, 0xa2, 0x66, 0x9a, 0x16, 0xd5, 0xa8, 0x61,
-	0x99, 0x8e, 0x10, 0xce, 0xdf, 0x93, 0xa3, 0xbc, 0x55, 0xef, 0x37, 0x8b, 0xa4, 0xdb, 0xa3, 0x03,
-	0x39, 0xb8, 0xc6, 0xff, 0x34, 0x9e, 0xb5, 0x88, 0xf9, 0xcc, 0xb9, 0xd0, 0x5a, 0x2d, 0x62, 0x17,
-	0xad, 0x1e, 0x9f, 0x1e, 0xa1, 0x6a, 0xa6, 0x57, 0x2f, 0xf6, 0xea, 0xa2, 0x81, 0x17, 0x20, 0xb9,
-	0x47, 0x06, 0x28, 0x0b, 0xc9, 0x33, 0x32, 0xc8, 0x29, 0x05, 0x65, 0x65, 0x56, 0x65, 0x9f, 0xf8,
-	0x0d, 0xc0, 0x11, 0xb1, 0xbb, 0x86, 0xe3, 0x18, 0x96, 0x89, 0xf2, 0x30, 0xad, 0x6b, 0x54, 0xab,
-	0x6b, 0x0e, 0xe1, 0x42, 0x19, 0xd5, 0x6b, 0xa3, 0x2f, 0x00, 0x7a, 0x9e, 0x64, 0x2e, 0x51, 0x50,
-	0x56, 0xe6, 0xd4, 0x40, 0x0f, 0xfe, 0xbb, 0x02, 0xa9, 0x77, 0x0e, 0xb1, 0x11, 0x82, 0x54, 0xdf,
-	0x21, 0xb6, 0x44, 0xe1, 0xdf, 0xd7, 0x4d, 0x46, 0xff, 0x0b, 0x33, 0x7e, 0xcb, 0xc9, 0x25, 0x0b,
-	0xc9, 0x95, 0x99, 0x97, 0x9f, 0xaf, 0x0f, 0x85, 0x6e, 0xdd, 0x37, 0x54, 0x0d, 0x4a, 0xa3, 0x45,
-	0xc8, 0x34, 0x6c, 0xa2, 0x51, 0xa2, 0xd7, 0x07, 0xb9, 0x14, 0x37, 0xdb, 0xef, 0x08, 0x8c, 0x6a,
-	0x34, 0x97, 0x1e, 0x1a, 0xd5, 0x28, 0xba, 0x0b, 0x93, 0x5a, 0x83, 0x1a, 0xe7, 0x24, 0x37, 0x59,
-	0x50, 0x56, 0xa6, 0x55, 0xd9, 0xc2, 0x5f, 0xc3, 0x34, 0x73, 0x66, 0xdf, 0x70, 0x28, 0x7a, 0x0a,
-	0x69, 0xe6, 0x84, 0x93, 0x53, 0xb8, 0x59, 0x9f, 0x86, 0xcc, 0x62, 0x72, 0xaa, 0x90, 0xc0, 0x3f,
-	0xc2, 0x7f, 0x6d, 0x73, 0xdd, 0xbc, 0x93, 0xfc, 0xbe, 0x4f, 0x1c, 0x1a, 0x19, 0x90, 0x3c, 0x4c,
-	0xf7, 0x34, 0xc7, 0xb9, 0xb0, 0x6c, 0x9d, 0x87, 0x63, 0x56, 0xf5, 0xda, 0xa1, 0x60, 0x25, 0x47,
-	0x82, 0x15, 0x5c, 0xa5, 0xd4, 0xf0, 0x2a, 0xe1, 0x65, 0x98, 0xb9, 0x06, 0x1a, 0x6f, 0xc1, 0xac,
-	0x10, 0x71, 0x7a, 0x96, 0xe9, 0x90, 0xdb, 0xac, 0x17, 0xb6, 0xe0, 0xb3, 0xed, 0xb6, 0x66, 0xb6,
-	0xc8, 0x91, 0x34, 0x7a, 0x9c, 0xaf, 0x05, 0x98, 0xb1, 0x3a, 0xfa, 0xd1, 0xb0, 0xbb, 0xc1, 0x2e,
-	0x26, 0x61, 0x92, 0x0b, 0x4f, 0x22, 0x29, 0x24, 0x02, 0x5d, 0x78, 0x13, 0x66, 0xf7, 0xad, 0x96,
-	0x61, 0xde, 0x32, 0xa6, 0xf8, 0xff, 0x60, 0x4e, 0xce, 0x97, 0x5e, 0xdf, 0x81, 0x34, 0xb5, 0xce,
-	0x88, 0x29, 0x35, 0x88, 0x06, 0xca, 0xc1, 0xd4, 0x85, 0x66, 0x9b, 0x86, 0xd9, 0x92, 0x1a, 0xdc,
-	0x26, 0x2e, 0x00, 0x94, 0xfa, 0xb4, 0xbd, 0x6d, 0x99, 0x4d, 0xa3, 0xc5, 0xe0, 0xcf, 0x0c, 0x53,
-	0xe7, 0x93, 0xe7, 0x54, 0xfe, 0x8d, 0x1f, 0x03, 0xbc, 0xad, 0xed, 0x57, 0xa5, 0x44, 0x0e, 0xa6,
-	0x88, 0xa9, 0xd5, 0x3b, 0x44, 0x08, 0x4d, 0xab, 0x6e, 0x13, 0xdb, 0x90, 0x3a, 0xb0, 0x74, 0x82,
-	0x66, 0x41, 0x31, 0x24, 0xba, 0x62, 0xb0, 0x56, 0x5b, 0x62, 0x2a, 0x6d, 0xa6, 0xdf, 0x26, 0xcd,
-	0x33, 0x19, 0x09, 0xfe, 0xcd, 0x0e, 0xaf, 0x4d, 0x9a, 0x7c, 0xc5, 0xa7, 0x55, 0xf6, 0xc9, 0x7c,
-	0x68, 0x68, 0x8d, 0x36, 0xe1, 0xdb, 0x7a, 0x5a, 0x15, 0x0d, 0x3e, 0xd7, 0xb2, 0xa8, 0xdc, 0xd0,
-	0xfc, 0x1b, 0xaf, 0x42, 0x7a, 0x5f, 0x1b, 0x10, 0x1b, 0x2d, 0x83, 0xd2, 0x89, 0xd9, 0xc7, 0xcc,
-	0x28, 0x55, 0xe9, 0xe0, 0x55, 0x48, 0xd5, 0x6c, 0x42, 0x10, 0x06, 0x85, 0x4a, 0xd1, 0x3b, 0x21,
-	0x51, 0xae, 0x4b, 0x55, 0x28, 0x7e, 0x09, 0xd3, 0x7b, 0x64, 0x70, 0xac, 0x75, 0xfa, 0x64, 0x34,
-	0xb9, 0x30, 0xfb, 0xce, 0xd9, 0x90, 0xf4, 0x4b, 0x34, 0x70, 0x0d, 0x50, 0x95, 0xda, 0xfd, 0x06,
-	0xed, 0xdb, 0x44, 0x1f, 0x33, 0x7b, 0x2d, 0x38, 0x7b, 0xe6, 0xe5, 0xdd, 0x90, 0x0d, 0xdb, 0x96,
-	0x49, 0x89, 0x49, 0x5d, 0xad, 0x25, 0x98, 0x92, 0x3d, 0xec, 0xc4, 0x53, 0xa3, 0x4b, 0x1c, 0xaa,
-	0x75, 0x7b, 0x5c, 0x61, 0x4a, 0xf5, 0x3b, 0xd8, 0xc2, 0xf4, 0xb4, 0x41, 0xc7, 0xd2, 0xdc, 0x4d,
-	0xe2, 0x36, 0xf1, 0x7d, 0x48, 0x57, 0x4c, 0x9d, 0xbc, 0x67, 0x76, 0x1b, 0xec, 0x43, 0x4e, 0x16,
-	0x0d, 0xbc, 0x03, 0xa9, 0x0a, 0x25, 0xdd, 0x9b, 0xfa, 0xe9, 0x6b, 0x49, 0x06, 0xb5, 0x34, 0x61,
-	0xde, 0xf7, 0x3e, 0x46, 0xdf, 0x47, 0x79, 0x1e, 0x83, 0xf3, 0x0a, 0x26, 0xf7, 0x8e, 0x65, 0xfa,
-	0x4a, 0xee, 0x1d, 0xbb, 0xc9, 0x6b, 0x21, 0xa4, 0xcb, 0x8d, 0xbf, 0xca, 0x64, 0xf0, 0xff, 0xc3,
-	0x54, 0x55, 0xce, 0xfa, 0x1a, 0x52, 0x55, 0x7f, 0xda, 0x72, 0x68, 0xda, 0xe8, 0x02, 0xaa, 0x5c,
-	0x1c, 0xbf, 0x80, 0xa9, 0x3d, 0x32, 0xe0, 0x1a, 0x1e, 0x43, 0xea, 0x8c, 0x0c, 0x5c, 0x0d, 0x68,
-	0x14, 0x58, 0xe5, 0xe3, 0x2c, 0xd5, 0xb2, 0x38, 0xb8, 0xa9, 0xd6, 0xa0, 0xa4, 0x1b, 0x97, 0x6a,
-	0x99, 0x9c, 0x2a, 0x24, 0x70, 0x25, 0xb8, 0x8d, 0x3c, 0x05, 0xaf, 0x86, 0x15, 0xdc, 0x8f, 0xb5,
-	0x3b, 0xa8, 0xea, 0x39, 0xa4, 0x54, 0xcb, 0xa2, 0xd1, 0xeb, 0xee, 0x9d, 0xa7, 0x84, 0x3c, 0x8b,
-	0xec, 0x3c, 0xfd, 0xa4, 0xc0, 0x4c, 0xb5, 0xa1, 0x99, 0x87, 0xe2, 0xfa, 0x65, 0xd7, 0x48, 0xcf,
-	0x26, 0x4d, 0xe3, 0xbd, 0x5c, 0x46, 0xd9, 0x62, 0xfd, 0x56, 0xb3, 0xe9, 0x10, 0x77, 0xb6, 0x6c,
-	0x31, 0xa4, 0x8e, 0xd1, 0x35, 0xa8, 0xbb, 0x66, 0xbc, 0xc1, 0xb6, 0xa6, 0x4d, 0xce, 0x89, 0x2d,
-	0xf3, 0xfa, 0xb4, 0xea, 0x36, 0x99, 0x0d, 0x3a, 0x21, 0x3d, 0x79, 0xd0, 0xf9, 0x37, 0x7e, 0x00,
-	0x99, 0x3d, 0x32, 0x38, 0xf2, 0x80, 0xa2, 0x0c, 0xc0, 0x18, 0x80, 0x79, 0xea, 0x6c, 0x5b, 0x7d,
-	0x93, 0xc3, 0x36, 0xd8, 0x87, 0xeb, 0x20, 0x6f, 0x60, 0x1b, 0xe6, 0x2b, 0x66, 0xa3, 0xd3, 0x67,
-	0x99, 0xfd, 0xc8, 0xb6, 0xac, 0x26, 0x9a, 0x87, 0x84, 0xe6, 0x0a, 0x25, 0xb4, 0x40, 0x60, 0x12,
-	0x51, 0x81, 0x49, 0xfa, 0x81, 0x61, 0x7d, 0x1d, 0xa2, 0x89, 0x2c, 0x35, 0xab, 0xf2, 0x6f, 0xd6,
-	0xd7, 0xd3, 0x68, 0x3b, 0x97, 0x2e, 0x24, 0x59, 0x1f, 0xfb, 0xc6, 0x3f, 0x2b, 0x90, 0xdd, 0xb6,
-	0x4c, 0xc7, 0x70, 0x28, 0x31, 0x1b, 0x03, 0x01, 0x7b, 0x07, 0xd2, 0x4d, 0xc3, 0x76, 0x3c, 0xf3,
-	0x78, 0x83, 0xb9, 0xe6, 0x90, 0x86, 0x65, 0xea, 0x12, 0x5d, 0xb6, 0xd8, 0x31, 0xe7, 0x02, 0xaa,
-	0x6f, 0x83, 0xdf, 0xc1, 0x6e, 0x30, 0x21, 0xc7, 0x87, 0x85, 0x39, 0x81, 0x9e, 0x48, 0xa3, 0xfe,
-	0xa2, 0x40, 0x5a, 0x58, 0xe2, 0xba, 0xa1, 0x04, 0xdc, 0xb8, 0x79, 0x10, 0x44, 0xf8, 0x52, 0x5e,
-	0xf8, 0x1e, 0xc2, 0x9c, 0xe1, 0x05, 0xd8, 0x07, 0x1d, 0xee, 0x44, 0x2b, 0xf0, 0x49, 0x23, 0x10,
-	0x11, 0x26, 0x37, 0xc9, 0xe5, 0xc2, 0xdd, 0xf8, 0x14, 0xa6, 0xab, 0x5a, 0x93, 0xf0, 0xec, 0xf1,
-	0x04, 0x52, 0x6c, 0x13, 0x73, 0x4b, 0x63, 0x0e, 0x0c, 0x17, 0x40, 0xab, 0x90, 0xee, 0x31, 0xdf,
-	0x64, 0x52, 0x09, 0xa7, 0x74, 0xee, 0xb7, 0x2a, 0x44, 0xb0, 0x03, 0x88, 0x01, 0x84, 0x12, 0xd5,
-	0x8b, 0x21, 0xa8, 0x6b, 0x8e, 0xd6, 0xc7, 0x83, 0x76, 0x61, 0x9e, 0x83, 0x12, 0xea, 0x9e, 0xaa,
-	0x27, 0x90, 0x38, 0x3b, 0x97, 0x70, 0xb1, 0x89, 0x2b, 0x71, 0x76, 0x8e, 0x5e, 0x42, 0x86, 0x05,
-	0xbe, 0xe2, 0x2d, 0xcf, 0x28, 0x14, 0x1f, 0x53, 0x7d, 0x31, 0x7c, 0x05, 0x59, 0x09, 0x57, 0x3d,
-	0x76, 0x01, 0x5f, 0x41, 0xd2, 0xf1, 0x10, 0x6f, 0x90, 0xf3, 0x98, 0xf4, 0xad, 0xc0, 0x8f, 0x85,
-	0xaf, 0xbb, 0xbe, 0xaf, 0xa3, 0xb7, 0xc0, 0xed, 0x9c, 0xba, 0xc3, 0xf4, 0xaa, 0xa4, 0x49, 0x6c,
-	0x62, 0x36, 0x88, 0xab, 0xbd, 0x08, 0x09, 0xdb, 0x92, 0x7e, 0x2d, 0x85, 0x94, 0x84, 0x85, 0xd5,
-	0x84, 0x6d, 0xdd, 0x0a, 0x7c, 0x0b, 0xe6, 0xdf, 0x10, 0xad, 0x43, 0xdb, 0xde, 0x23, 0x8b, 0x1d,
-	0x5d, 0xaa, 0xd1, 0xbe, 0x23, 0xdf, 0x40, 0xb2, 0xc5, 0x12, 0x1d, 0xcb, 0x6b, 0xee, 0xdb, 0x32,
-	0xa3, 0xba, 0x4d, 0xbc, 0x05, 0xd9, 0x11, 0xe3, 0x17, 0x21, 0x63, 0xbb, 0x7d, 0x32, 0x40, 0x7e,
-	0x87, 0x1b, 0xb8, 0x84, 0x5f, 0xd3, 0xec, 0xc2, 0xcc, 0x49, 0x49, 0xd7, 0x03, 0x91, 0x65, 0x09,
-	0x58, 0x46, 0x56, 0x66, 0x5f, 0xa7, 0x61, 0xd9, 0xe2, 0x7e, 0x55, 0x54, 0xd1, 0x70, 0x15, 0x25,
-	0x7d, 0x45, 0x6d, 0x98, 0x3d, 0x09, 0x66, 0xf9, 0x51, 0x4d, 0xff, 0xa1, 0xfc, 0x8e, 0xbf, 0x85,
-	0xd9, 0x4a, 0x10, 0x89, 0x3f, 0x65, 0x5b, 0xa4, 0x6a, 0x5c, 0x12, 0x99, 0x0c, 0xbd, 0x36, 0x7f,
-	0x9b, 0x6b, 0x2d, 0x72, 0xd0, 0xef, 0xd6, 0x89, 0x2d, 0x93, 0x51, 0xa0, 0x07, 0x97, 0x21, 0x75,
-	0xa4, 0xb5, 0xc8, 0x47, 0xdc, 0xa5, 0x2c, 0x89, 0x75, 0x59, 0x3c, 0x92, 0xe2, 0x7a, 0x61, 0xdf,
-	0xf8, 0x07, 0x48, 0x57, 0xb9, 0x9e, 0xdb, 0x5c, 0xa9, 0xe2, 0x95, 0xc5, 0x4d, 0x92, 0x16, 0xba,
-	0xcd, 0x48, 0xac, 0x0b, 0xf8, 0x84, 0x6d, 0xdb, 0xe0, 0xaa, 0x3d, 0x87, 0xf4, 0xa5, 0xd5, 0xa3,
-	0x8e, 0xdc, 0xb4, 0xf9, 0x10, 0x6a, 0x40, 0x54, 0x15, 0x82, 0xb7, 0xda, 0xb2, 0xbf, 0x13, 0x49,
-	0x80, 0x37, 0x5c, 0xe4, 0xe8, 0x57, 0xc0, 0x6d, 0xb4, 0xeb, 0x90, 0x2e, 0xdb, 0xb6, 0x65, 0xa3,
-	0x6f, 0x20, 0x43, 0xd8, 0x47, 0xc3, 0xd2, 0xc5, 0x7a, 0xce, 0x8f, 0x14, 0xb7, 0x5c, 0x70, 0xdb,
-	0xd2, 0x89, 0xa3, 0xfa, 0xb2, 0x08, 0xc3, 0x2c, 0x6f, 0x74, 0x89, 0xe3, 0x68, 0x2d, 0x22, 0x4f,
-	0xcb, 0x50, 0x1f, 0x5e, 0x87, 0xe9, 0x1d, 0xb7, 0x48, 0xc7, 0x30, 0xeb, 0x96, 0x82, 0xa6, 0xd6,
-	0x75, 0x8b, 0xf8, 0xa1, 0x3e, 0x5c, 0x83, 0xec, 0x3b, 0x87, 0xb8, 0x53, 0x54, 0xd2, 0xeb, 0x0c,
-	0x58, 0x9e, 0xe6, 0x3a, 0x65, 0xb4, 0xef, 0x44, 0x19, 0xa7, 0x0a, 0x11, 0xbf, 0x72, 0x12, 0xc6,
-	0x88, 0x06, 0x2e, 0xc1, 0xa7, 0xa2, 0xf2, 0xbd, 0xb5, 0x62, 0xfc, 0x37, 0x05, 0x16, 0x64, 0x55,
-	0xe9, 0x57, 0xfa, 0xb2, 0xde, 0xfb, 0x46, 0xd4, 0xe9, 0x96, 0x29, 0xc3, 0xb7, 0x14, 0xcb, 0x0d,
-	0x94, 0xb8, 0x98, 0x2a, 0xc5, 0xd9, 0x49, 0x62, 0xc5, 0x21, 0x8f, 0x86, 0x30, 0xd8, 0x6b, 0x0f,
-	0x15, 0xd2, 0xc9, 0xb1, 0x74, 0x47, 0x6a, 0xa4, 0x02, 0xfe, 0x16, 0xee, 0x54, 0x09, 0x2d, 0x71,
-	0xb6, 0x20, 0x58, 0x71, 0xfb, 0x84, 0x82, 0x12, 0x24, 0x14, 0xc6, 0xd9, 0x81, 0xdf, 0xc2, 0x1d,
-	0x37, 0x6a, 0xec, 0x11, 0xeb, 0xa5, 0xcf, 0xaf, 0x21, 0xe3, 0xda, 0x13, 0xf7, 0x7e, 0xf7, 0xa2,
-	0xed, 0x4b, 0xae, 0xfe, 0x59, 0x01, 0xf0, 0xb7, 0x13, 0x9a, 0x84, 0xc4, 0xe1, 0x59, 0x76, 0x02,
-	0x2d, 0x42, 0xae, 0xac, 0xaa, 0x87, 0xea, 0x69, 0xb5, 0xbc, 0x5f, 0xde, 0xae, 0x55, 0x0e, 0x76,
-	0x4f, 0x77, 0x4a, 0xb5, 0xd2, 0x56, 0xa9, 0x5a, 0xce, 0x2a, 0xe8, 0x29, 0x3c, 0x12, 0xa3, 0x07,
-	0x87, 0xa7, 0x47, 0x65, 0xf5, 0x6d, 0xa5, 0x5a, 0xad, 0x1c, 0x1e, 0x9c, 0xfe, 0xea, 0x50, 0x3d,
-	0xad, 0xbd, 0xa9, 0x54, 0x7d, 0xd1, 0x04, 0x2a, 0xc0, 0xa2, 0x10, 0x7d, 0x57, 0x2d, 0xab, 0xa7,
-	0x6f, 0x4a, 0xd5, 0xd3, 0x83, 0xc3, 0xda, 0xe9, 0xfe, 0xe1, 0xee, 0x6e, 0x79, 0xe7, 0xb4, 0x72,
-	0x90, 0x4d, 0xa2, 0x7b, 0xb0, 0x20, 0x24, 0x76, 0xb6, 0x4e, 0x77, 0x0e, 0xcb, 0x42, 0xa0, 0xfc,
-	0xeb, 0x4a, 0xb5, 0x96, 0x4d, 0xad, 0x3e, 0x85, 0x6c, 0x78, 0xb5, 0x50, 0x06, 0xd2, 0xbb, 0x6a,
-	0xe9, 0xa0, 0x96, 0x9d, 0x40, 0x00, 0x93, 0x6a, 0xf9, 0xf8, 0x70, 0xaf, 0x9c, 0x55, 0x5e, 0xfe,
-	0xf5, 0x11, 0xcc, 0x54, 0xba, 0xdd, 0x7e, 0x95, 0xd8, 0xe7, 0x46, 0x83, 0x20, 0x0d, 0x32, 0x2c,
-	0x40, 0x2c, 0xde, 0x0e, 0xba, 0xbb, 0x2e, 0xc8, 0xb2, 0x75, 0x97, 0x2c, 0x5b, 0x2f, 0x77, 0x7b,
-	0x74, 0x90, 0x5f, 0x88, 0xe0, 0x67, 0xd8, 0x2c, 0xfc, 0xe0, 0x0f, 0xff, 0xf8, 0xe7, 0x9f, 0x12,
-	0xf7, 0xd1, 0xbd, 0xe2, 0xf9, 0x8b, 0x22, 0x93, 0xb1, 0x89, 0x43, 0x7b, 0xb6, 0xf5, 0x7e, 0x50,
-	0x64, 0x4b, 0x51, 0xec, 0xb0, 0x02, 0xc2, 0x80, 0xa9, 0x5d, 0xc2, 0x11, 0x50, 0x3e, 0x8a, 0xe8,
-	0x11, 0xcb, 0x9c, 0xbf, 0x17, 0x39, 0x26, 0xd6, 0x0d, 0x3f, 0xe2, 0x40, 0x4b, 0xe8, 0x7e, 0x0c,
-	0xd0, 0x15, 0xfb, 0xf7, 0x03, 0x32, 0x01, 0x7c, 0xb2, 0x08, 0x15, 0xc2, 0x55, 0x5e, 0x98, 0x47,
-	0x1a, 0x8f, 0xb9, 0xcc, 0x31, 0xef, 0xe1, 0xbb, 0xd1, 0x98, 0xaf, 0x95, 0x55, 0xf4, 0x93, 0x02,
-	0xf3, 0xc3, 0xac, 0x0d, 0x7a, 0x18, 0x06, 0x8d, 0x22, 0x75, 0xf2, 0x31, 0x91, 0xc6, 0x2f, 0x38,
-	0xe6, 0x97, 0xf8, 0x71, 0x8c, 0x9f, 0x2e, 0xfb, 0x52, 0x6c, 0x70, 0xb5, 0xcc, 0x06, 0x13, 0xe6,
-	0xaa, 0x84, 0x06, 0x28, 0xc7, 0xa8, 0x6b, 0x29, 0x16, 0xf0, 0x39, 0x07, 0x5c, 0xc5, 0x8f, 0xe2,
-	0x00, 0x3d, 0xbd, 0x45, 0x87, 0x50, 0x86, 0x67, 0xc3, 0xfc, 0x0e, 0xe1, 0x47, 0xd0, 0x8d, 0xf3,
-	0xb8, 0x55, 0x8d, 0xc3, 0x5d, 0xe3, 0xb8, 0x8f, 0xf1, 0x72, 0x0c, 0xae, 0xee, 0x41, 0x30, 0xcc,
-	0x5d, 0xc8, 0xbe, 0xeb, 0xe9, 0x1a, 0x25, 0x01, 0xc2, 0x28, 0x9c, 0xee, 0xfd, 0xa1, 0x58, 0xd0,
-	0x09, 0x5f, 0x51, 0x80, 0x57, 0x0a, 0x2b, 0xf2, 0x87, 0xc6, 0x28, 0x7a, 0x0d, 0x99, 0x23, 0xdb,
-	0x30, 0x29, 0xe7, 0x75, 0xe2, 0xce, 0x4d, 0x78, 0x25, 0x98, 0x30, 0x9e, 0x40, 0x67, 0x90, 0xe6,
-	0xcc, 0x19, 0x0a, 0x6f, 0xbf, 0x20, 0x1f, 0x97, 0x5f, 0x8c, 0x1e, 0x94, 0x9b, 0xf3, 0xc9, 0xcf,
-	0xa5, 0x44, 0x7d, 0x82, 0x07, 0x71, 0x11, 0x2f, 0x8c, 0x06, 0xb1, 0xc3, 0xa4, 0x59, 0xe8, 0xbe,
-	0x87, 0xc9, 0x7d, 0xab, 0x65, 0xf5, 0x69, 0xac, 0x95, 0x71, 0x4e, 0xca, 0xc3, 0x8d, 0x73, 0x91,
-	0xda, 0xad, 0x3e, 0xdf, 0x0d, 0xdf, 0x41, 0xb2, 0x4a, 0x28, 0x8a, 0xab, 0x25, 0xf2, 0x91, 0x37,
-	0xfa, 0xb8, 0xa3, 0xc5, 0x1e, 0x3b, 0x4c, 0xf1, 0x16, 0xa4, 0x79, 0x21, 0x81, 0xae, 0x2f, 0x1a,
-	0x62, 0x40, 0x26, 0x50, 0x13, 0xa6, 0x64, 0x41, 0x82, 0x46, 0xde, 0x58, 0x43, 0x75, 0x51, 0x3e,
-	0xb2, 0x8c, 0xc2, 0x8f, 0xb9, 0x99, 0x05, 0x7c, 0x2f, 0xda, 0xcc, 0xa2, 0xa3, 0x35, 0xf9, 0xf6,
-	0xdc, 0x81, 0x8c, 0x57, 0xf8, 0xa0, 0xa5, 0x68, 0x24, 0xaf, 0x24, 0x8a, 0xc1, 0x9a, 0x40, 0x35,
-	0x48, 0xee, 0x12, 0x8a, 0x22, 0x68, 0x9d, 0x7c, 0xd4, 0x91, 0xc6, 0x0f, 0xb9, 0x75, 0x5f, 0xa0,
-	0xc5, 0x18, 0xeb, 0xae, 0xce, 0xc8, 0xe0, 0x03, 0xda, 0x80, 0xf4, 0x2e, 0xb7, 0x2b, 0x4a, 0xef,
-	0xf8, 0x97, 0x27, 0x9e, 0x40, 0x5d, 0x11, 0xc1, 0xdd, 0x98, 0x08, 0xfa, 0xd5, 0xd6, 0xc8, 0x1d,
-	0xe1, 0x96, 0xd3, 0x78, 0x95, 0x9b, 0xf9, 0x10, 0x2f, 0x8d, 0x09, 0x62, 0xb1, 0x25, 0x72, 0xcb,
-	0xa1, 0x08, 0xa4, 0x30, 0xf8, 0x1a, 0xc0, 0xe5, 0xa8, 0x38, 0x87, 0xed, 0x67, 0x75, 0x3d, 0xa1,
-	0x5b, 0x1a, 0x6d, 0xb4, 0xd1, 0x67, 0xe1, 0x00, 0x70, 0x5a, 0x2e, 0x66, 0xf3, 0x8c, 0x59, 0xfa,
-	0x3a, 0xd3, 0xe6, 0x66, 0xc3, 0x0d, 0x00, 0x17, 0xa0, 0x7a, 0x8c, 0xc2, 0xbc, 0x62, 0x75, 0x2c,
-	0xc6, 0x04, 0x6a, 0xc0, 0xf4, 0xae, 0x6b, 0xde, 0xdd, 0xd1, 0xf5, 0xe1, 0x73, 0x17, 0x22, 0xd6,
-	0x9e, 0x5f, 0xbe, 0xd7, 0x9a, 0x28, 0x83, 0x5a, 0x01, 0xd8, 0x8d, 0x37, 0xd1, 0x85, 0x59, 0x1e,
-	0xbb, 0x15, 0x38, 0x20, 0xb3, 0x37, 0xc5, 0x6a, 0xaa, 0x91, 0x8c, 0x1f, 0x28, 0xb4, 0x6e, 0x65,
-	0xaf, 0xd8, 0x08, 0x0d, 0xcd, 0x14, 0xf6, 0x4e, 0x32, 0x7d, 0xd5, 0xe3, 0xb1, 0x30, 0x37, 0xb2,
-	0xf7, 0x0c, 0xd2, 0x82, 0xa6, 0xcb, 0x8d, 0x7a, 0x2d, 0x68, 0xbe, 0xfc, 0xe7, 0x11, 0xe6, 0x0a,
-	0x6e, 0x0f, 0x3f, 0xe3, 0x06, 0x3f, 0x41, 0x8f, 0x62, 0x0c, 0xe6, 0x5c, 0x5f, 0xf1, 0x4a, 0xf0,
-	0x82, 0x1f, 0xd0, 0x29, 0xcc, 0x6c, 0xf7, 0x6d, 0x9b, 0x98, 0x82, 0x2e, 0xbb, 0xe9, 0xa5, 0xc0,
-	0x84, 0xf1, 0x03, 0x3f, 0x9d, 0xe7, 0x50, 0x44, 0x56, 0xe4, 0x24, 0x98, 0x0d, 0x19, 0x8f, 0x55,
-	0x44, 0x91, 0x5b, 0x6a, 0xe4, 0x40, 0x0f, 0xb3, 0x90, 0xee, 0x6d, 0x8f, 0x56, 0x22, 0x3c, 0x72,
-	0x25, 0x39, 0x75, 0x54, 0xbc, 0xe2, 0x35, 0xda, 0x07, 0xf4, 0x1e, 0x66, 0x02, 0xa4, 0x62, 0x0c,
-	0xea, 0xd2, 0x28, 0x9d, 0x3e, 0x44, 0x43, 0xe2, 0x97, 0x1c, 0x77, 0x0d, 0xad, 0x8e, 0xe2, 0x06,
-	0x98, 0xb8, 0x61, 0xe4, 0x3a, 0x4c, 0x6d, 0x0d, 0xe4, 0xff, 0x1e, 0x44, 0xa2, 0x46, 0x26, 0x45,
-	0xf9, 0xae, 0x40, 0x0f, 0x63, 0xd6, 0x8c, 0x2b, 0xf7, 0x30, 0x2e, 0x61, 0x66, 0x6b, 0xe0, 0x95,
-	0xab, 0x91, 0xa9, 0x3b, 0x58, 0xc8, 0xc6, 0x27, 0x39, 0xf9, 0x6e, 0x43, 0x4f, 0xc7, 0x25, 0xb9,
-	0x61, 0xec, 0x2d, 0xc8, 0x48, 0xff, 0xaa, 0xc7, 0x37, 0x5c, 0xcd, 0x88, 0xf4, 0x36, 0xf5, 0xc6,
-	0x70, 0xa8, 0x65, 0x0f, 0x22, 0xd3, 0x7b, 0xec, 0x51, 0x7c, 0xc2, 0xcd, 0x5d, 0x46, 0x11, 0x39,
-	0xb9, 0x2d, 0xf4, 0xc9, 0xdb, 0x63, 0x07, 0x32, 0x12, 0x20, 0xe6, 0x06, 0xb9, 0xd1, 0x31, 0x34,
-	0x61, 0x52, 0xd0, 0x58, 0xb1, 0x87, 0x22, 0xec, 0xe9, 0x30, 0xeb, 0x85, 0x9f, 0xf9, 0xc7, 0x03,
-	0xa3, 0x42, 0x84, 0xd1, 0x5c, 0xdc, 0x76, 0xab, 0xbc, 0x1f, 0x20, 0xe3, 0x51, 0x5e, 0xe8, 0x3a,
-	0x72, 0xee, 0xe3, 0x2f, 0x00, 0x8f, 0x29, 0x63, 0xd9, 0xea, 0x02, 0xe6, 0x86, 0xf8, 0x41, 0xf4,
-	0x20, 0x62, 0x8f, 0x5c, 0x8b, 0x29, 0x8e, 0xc9, 0x97, 0x1c, 0xf3, 0x11, 0x8e, 0xf0, 0x90, 0x6f,
-	0xa0, 0x21, 0xe0, 0xdf, 0x42, 0xea, 0xa4, 0xa4, 0xeb, 0x68, 0x0c, 0x8f, 0xf3, 0xf1, 0xaf, 0xaf,
-	0x4b, 0x4d, 0xd7, 0x99, 0x72, 0x0d, 0xd2, 0x9c, 0xa7, 0x1b, 0x79, 0xa2, 0x9e, 0xdc, 0x28, 0xd5,
-	0xe3, 0xf8, 0x87, 0xe9, 0xa5, 0x9b, 0xe6, 0xf7, 0x60, 0xea, 0x44, 0xe6, 0xf9, 0xb1, 0x20, 0x37,
-	0xda, 0x61, 0x6d, 0xc1, 0xdf, 0xf3, 0x80, 0x7c, 0x11, 0xb1, 0x00, 0xe3, 0x82, 0x72, 0xed, 0x5b,
-	0x8f, 0xc7, 0xde, 0x8d, 0xcc, 0xf7, 0x90, 0xae, 0x44, 0x46, 0x26, 0xc8, 0x36, 0x8e, 0xe4, 0xa6,
-	0x23, 0xad, 0x45, 0xc6, 0x45, 0xc5, 0x70, 0xa3, 0xb2, 0x09, 0x53, 0x95, 0x98, 0xa8, 0x0c, 0x01,
-	0x84, 0x9d, 0xe0, 0xc4, 0x22, 0x9e, 0x40, 0x87, 0x90, 0xda, 0xe9, 0x77, 0x7b, 0xb1, 0x07, 0x0d,
-	0xd6, 0x7b, 0x75, 0xf9, 0xf2, 0x19, 0xb7, 0x0f, 0xf4, 0x7e, 0xb7, 0xf7, 0x5a, 0x59, 0x7d, 0xae,
-	0xa0, 0x4b, 0x98, 0x1f, 0x66, 0xa1, 0x50, 0x1c, 0x61, 0x92, 0xc7, 0x91, 0xf5, 0xf6, 0x10, 0x7b,
-	0x35, 0x6e, 0x8b, 0x7b, 0x3f, 0x21, 0xe1, 0xe2, 0x2c, 0x18, 0x1f, 0xf8, 0x4f, 0x2f, 0xae, 0x07,
-	0x5e, 0x1a, 0x2d, 0x40, 0x87, 0x51, 0xbf, 0xe2, 0xa8, 0xeb, 0x68, 0x2d, 0xb2, 0xda, 0x74, 0x21,
-	0x8b, 0x57, 0x41, 0x56, 0xef, 0x03, 0xfa, 0x11, 0xb2, 0x61, 0xf2, 0x0c, 0x3d, 0x8e, 0x2e, 0xef,
-	0xc3, 0xec, 0x5a, 0x3e, 0x92, 0x96, 0x73, 0x5f, 0x14, 0x18, 0x47, 0x78, 0xcf, 0x15, 0xf9, 0xe5,
-	0xb6, 0xf0, 0x7f, 0x6e, 0x88, 0x11, 0x1b, 0xcd, 0x2d, 0x11, 0x7c, 0x59, 0x6c, 0x3d, 0x57, 0xe4,
-	0xe0, 0x4f, 0xf1, 0xc3, 0x98, 0x92, 0xdb, 0x21, 0x54, 0xf3, 0x94, 0x31, 0xf8, 0x2b, 0x98, 0x0d,
-	0x92, 0x68, 0xb1, 0x7b, 0xea, 0x41, 0xcc, 0xba, 0x04, 0x99, 0x37, 0xbc, 0xce, 0xd1, 0x57, 0xf0,
-	0x83, 0xb8, 0x82, 0x5f, 0x4e, 0xea, 0x18, 0x0e, 0x7b, 0xb5, 0x6e, 0xfd, 0x31, 0xf9, 0x73, 0xe9,
-	0x97, 0x04, 0xfa, 0x97, 0x02, 0x9f, 0x08, 0xed, 0x05, 0xb5, 0x5c, 0xad, 0x15, 0x4a, 0x47, 0x15,
-	0xf4, 0x8b, 0xb2, 0x51, 0xdf, 0xac, 0xbc, 0x3d, 0x3a, 0x54, 0x6b, 0xa5, 0x83, 0xda, 0x46, 0xb1,
-	0xbe, 0xf9, 0xba, 0x50, 0xea, 0x74, 0x0a, 0x1b, 0x0d, 0x4b, 0x27, 0x9b, 0x2d, 0x42, 0x37, 0x8a,
-	0xfc, 0xab, 0xa0, 0x99, 0xba, 0xec, 0x64, 0x47, 0x38, 0x30, 0xd0, 0xec, 0x9b, 0x9c, 0x25, 0x73,
-	0x0a, 0x36, 0xa1, 0x7d, 0xdb, 0x2c, 0x6c, 0xf4, 0x37, 0x19, 0xf8, 0x7f, 0x7f, 0xf5, 0x8c, 0x98,
-	0x4c, 0x44, 0xdf, 0x28, 0xf6, 0x37, 0x0b, 0x67, 0x64, 0xe0, 0x70, 0x25, 0xfc, 0x67, 0x01, 0xce,
-	0x5a, 0xe1, 0xa2, 0x6d, 0x74, 0x48, 0x41, 0xf3, 0xb0, 0x9c, 0x38, 0x2c, 0x27, 0x0a, 0x8b, 0xbc,
-	0xef, 0x91, 0x06, 0x8d, 0xc1, 0x32, 0xcc, 0x5e, 0x9f, 0x3a, 0xeb, 0x27, 0xbf, 0x81, 0xef, 0x60,
-	0xb2, 0x4e, 0x34, 0x9b, 0xd8, 0xe8, 0xed, 0x74, 0x02, 0xfd, 0x4f, 0xa9, 0x4f, 0xdb, 0xc4, 0xa4,
-	0x46, 0x83, 0xff, 0x16, 0xad, 0xc0, 0xb9, 0xe1, 0xb5, 0x82, 0x78, 0x74, 0x12, 0xbd, 0x50, 0x1f,
-	0x14, 0xb6, 0xb8, 0xf4, 0x6b, 0xf9, 0xb7, 0xb0, 0xc1, 0x45, 0x36, 0xf3, 0x73, 0x6c, 0xa6, 0x65,
-	0x1b, 0x97, 0x62, 0x62, 0xa2, 0x3e, 0x0b, 0xe0, 0xa9, 0x9e, 0x38, 0xf9, 0xb2, 0x65, 0xd0, 0x76,
-	0xbf, 0xbe, 0xde, 0xb0, 0xba, 0xdc, 0x52, 0xd3, 0xa2, 0x9a, 0x3d, 0x28, 0x8a, 0x60, 0x17, 0x7b,
-	0x67, 0x2d, 0xfe, 0x83, 0x3a, 0xb1, 0xa4, 0xf5, 0x49, 0xbe, 0xe4, 0xaf, 0xfe, 0x1d, 0x00, 0x00,
-	0xff, 0xff, 0xe9, 0xc2, 0xcb, 0x57, 0x89, 0x27, 0x00, 0x00,
+	// 4338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5b, 0x4b, 0x73, 0x1b, 0x49,
+	0x72, 0x66, 0xe3, 0x45, 0x22, 0xf9, 0x10, 0xa7, 0x46, 0x23, 0x71, 0x21, 0x8d, 0x04, 0x95, 0xde,
+	0x1c, 0x89, 0xd0, 0x63, 0x67, 0x67, 0x56, 0xc3, 0x95, 0x0d, 0x3e, 0x86, 0xe2, 0x90, 0x12, 0xe9,
+	0x06, 0xc5, 0x5d, 0xcb, 0x1e, 0x33, 0x1a, 0x40, 0x11, 0x68, 0x11, 0xe8, 0x86, 0xbb, 0x0b, 0xa2,
+	0x20, 0x85, 0x62, 0xc3, 0xf6, 0xc1, 0xe1, 0xf0, 0x6d, 0x7c, 0xf5, 0xd5, 0x17, 0x1f, 0x7c, 0x73,
+	0x84, 0x2f, 0x8e, 0xf0, 0xdd, 0x47, 0xdf, 0xf6, 0xbc, 0x07, 0x9f, 0xf6, 0x17, 0xf8, 0xe0, 0xa8,
+	0xac, 0xea, 0x77, 0x37, 0x48, 0x71, 0x75, 0x91, 0x3a, 0xab, 0xb3, 0xf3, 0xcb, 0x47, 0x55, 0x56,
+	0x55, 0x26, 0x08, 0x33, 0x6e, 0xab, 0xcb, 0xfa, 0xc6, 0xd2, 0xc0, 0xb1, 0xb9, 0x4d, 0x66, 0xcd,
+	0x7e, 0x7f, 0xd8, 0x6e, 0x2e, 0xc9, 0xc1, 0xca, 0xe5, 0x8e, 0x6d, 0x77, 0x7a, 0xac, 0x66, 0x0c,
+	0xcc, 0x9a, 0x61, 0x59, 0x36, 0x37, 0xb8, 0x69, 0x5b, 0xae, 0x64, 0xae, 0x5c, 0x52, 0x6f, 0x91,
+	0x6a, 0x0e, 0x0f, 0x6b, 0xac, 0x3f, 0xe0, 0x23, 0xf5, 0xf2, 0x1e, 0xfe, 0xd7, 0xba, 0xdf, 0x61,
+	0xd6, 0x7d, 0xf7, 0xd8, 0xe8, 0x74, 0x98, 0x53, 0xb3, 0x07, 0xf8, 0x79, 0x8a, 0xa8, 0xe9, 0x41,
+	0xb3, 0x36, 0x68, 0x4a, 0x82, 0x5e, 0x84, 0xfc, 0x16, 0x1b, 0x91, 0x79, 0xc8, 0x1f, 0xb1, 0xd1,
+	0x82, 0x56, 0xd5, 0xee, 0xcc, 0xe8, 0xe2, 0x91, 0x3e, 0x03, 0xd8, 0x65, 0x4e, 0xdf, 0x74, 0x5d,
+	0xd3, 0xb6, 0x48, 0x05, 0xa6, 0xda, 0x06, 0x37, 0x9a, 0x86, 0xcb, 0x90, 0xa9, 0xac, 0xfb, 0x34,
+	0xb9, 0x02, 0x30, 0xf0, 0x39, 0x17, 0x72, 0x55, 0xed, 0xce, 0xac, 0x1e, 0x1a, 0xa1, 0xff, 0xad,
+	0x41, 0xe1, 0xa5, 0xcb, 0x1c, 0x42, 0xa0, 0x30, 0x74, 0x99, 0xa3, 0x50, 0xf0, 0xf9, 0xa4, 0x8f,
+	0xc9, 0x77, 0x30, 0x1d, 0x50, 0xee, 0x42, 0xbe, 0x9a, 0xbf, 0x33, 0xfd, 0xe8, 0x67, 0x4b, 0x11,
+	0xd7, 0x2d, 0x05, 0x8a, 0xea, 0x61, 0x6e, 0x72, 0x19, 0xca, 0x2d, 0x87, 0x19, 0x9c, 0xb5, 0x9b,
+	0xa3, 0x85, 0x02, 0xaa, 0x1d, 0x0c, 0x84, 0xde, 0x1a, 0x7c, 0xa1, 0x18, 0x79, 0x6b, 0x70, 0x72,
+	0x01, 0x4a, 0x46, 0x8b, 0x9b, 0x6f, 0xd8, 0x42, 0xa9, 0xaa, 0xdd, 0x99, 0xd2, 0x15, 0x45, 0xbf,
+	0x86, 0x29, 0x61, 0xcc, 0xb6, 0xe9, 0x72, 0x72, 0x17, 0x8a, 0xc2, 0x08, 0x77, 0x41, 0x43, 0xb5,
+	0x3e, 0x8f, 0xa9, 0x25, 0xf8, 0x74, 0xc9, 0x41, 0xff, 0x53, 0x83, 0xb2, 0xa0, 0x5f, 0xba, 0x46,
+	0x87, 0x45, 0x3c, 0x51, 0x0e, 0x3c, 0x61, 0x0f, 0x98, 0x23, 0x43, 0x85, 0x9e, 0x28, 0xe8, 0xa1,
+	0x11, 0x72, 0x07, 0xce, 0x1d, 0x3b, 0x26, 0x67, 0x3b, 0x01, 0x53, 0x1e, 0x99, 0xe2, 0xc3, 0x84,
+	0xc2, 0x8c, 0x18, 0xe2, 0xcc, 0x5a, 0x19, 0x71, 0xe6, 0xa2, 0xe5, 0x05, 0x3d, 0x32, 0x46, 0x96,
+	0x80, 0x38, 0xec, 0x35, 0x6b, 0x71, 0xd6, 0x0e, 0x09, 0x2c, 0x22, 0x67, 0xca, 0x1b, 0xfa, 0x2b,
+	0xa1, 0xbe, 0xd1, 0x61, 0x68, 0xf7, 0x03, 0x28, 0x0d, 0x05, 0xe1, 0x19, 0xbe, 0x90, 0x62, 0x38,
+	0x72, 0xeb, 0x8a, 0x8f, 0xfe, 0x9b, 0x06, 0xf3, 0x3b, 0xd6, 0x0a, 0xeb, 0x1a, 0xbd, 0xc3, 0x9d,
+	0x43, 0x9d, 0xb5, 0x6c, 0xa7, 0x3d, 0x76, 0x52, 0x5d, 0x80, 0x52, 0x9f, 0xf1, 0xae, 0xdd, 0x46,
+	0x4f, 0x94, 0x75, 0x45, 0x91, 0xf3, 0x50, 0x34, 0xad, 0x36, 0x7b, 0xab, 0x6c, 0x97, 0x84, 0xef,
+	0xcf, 0x42, 0xcc, 0x9f, 0x3e, 0xa2, 0x8a, 0x6f, 0x68, 0x44, 0x84, 0x9f, 0x9b, 0x7d, 0xe6, 0x72,
+	0xa3, 0x3f, 0xc0, 0x18, 0xe7, 0xf5, 0x60, 0x80, 0xee, 0xc0, 0x67, 0x61, 0x7d, 0xff, 0x7a, 0xc8,
+	0x5c, 0x7e, 0xd2, 0x2a, 0x08, 0xc1, 0xe5, 0xe2, 0x70, 0x74, 0x0b, 0xe6, 0x02, 0x81, 0xe8, 0xc5,
+	0x5f, 0xc2, 0xa4, 0x83, 0x8e, 0xf0, 0xdc, 0x78, 0x35, 0xe6, 0xc6, 0xb8, 0xc3, 0x74, 0x8f, 0x9f,
+	0xfe, 0x16, 0x3e, 0x5b, 0xc5, 0x99, 0x8a, 0x53, 0x4c, 0x69, 0x97, 0xb6, 0xbc, 0x2a, 0x30, 0x35,
+	0x30, 0x5c, 0xf7, 0xd8, 0x76, 0xa4, 0x23, 0x67, 0x74, 0x9f, 0x8e, 0x2d, 0xbd, 0x7c, 0x62, 0xe9,
+	0x85, 0xad, 0x2d, 0x44, 0xad, 0xa5, 0xd7, 0x60, 0xfa, 0x04, 0x68, 0xba, 0x02, 0x33, 0x92, 0xc5,
+	0x1d, 0xd8, 0x96, 0xcb, 0xce, 0xb2, 0xfa, 0xa9, 0x0d, 0x5f, 0xac, 0x76, 0x0d, 0xab, 0xc3, 0x76,
+	0x95, 0xd2, 0xe3, 0x6c, 0xad, 0xc2, 0xb4, 0xdd, 0x6b, 0xef, 0x46, 0xcd, 0x0d, 0x0f, 0x09, 0x0e,
+	0x8b, 0x1d, 0xfb, 0x1c, 0x79, 0xc9, 0x11, 0x1a, 0xa2, 0x7f, 0x05, 0x33, 0xdb, 0x76, 0xc7, 0xb4,
+	0xfe, 0x08, 0x9f, 0x1a, 0x03, 0x73, 0x9f, 0x39, 0x61, 0x9f, 0x06, 0x23, 0xf4, 0xef, 0x34, 0x98,
+	0x55, 0x00, 0xca, 0x2d, 0xe7, 0xa1, 0xc8, 0xed, 0x23, 0x66, 0x29, 0x08, 0x49, 0x90, 0x05, 0x98,
+	0x3c, 0x36, 0x1c, 0xcb, 0xb4, 0x3a, 0x0a, 0xc2, 0x23, 0x4f, 0x42, 0x10, 0x8b, 0xbf, 0x65, 0x0c,
+	0x8c, 0xa6, 0xd9, 0x33, 0xb9, 0x89, 0x8b, 0x3f, 0x7f, 0xa7, 0xac, 0x47, 0xc6, 0xe8, 0xbf, 0x6b,
+	0x70, 0xae, 0xc1, 0x0d, 0x87, 0x0f, 0x07, 0xbb, 0x8e, 0xdd, 0x71, 0x98, 0xeb, 0x0a, 0x3d, 0x06,
+	0xdd, 0x60, 0x62, 0x4b, 0x42, 0xd8, 0xdf, 0xb6, 0x2d, 0xa6, 0x42, 0x83, 0xcf, 0x52, 0x63, 0x6e,
+	0xf4, 0x14, 0xb8, 0x24, 0x84, 0xc6, 0x03, 0xe6, 0xb4, 0x98, 0xc5, 0x71, 0xb2, 0xcc, 0xea, 0x1e,
+	0x29, 0x16, 0x1a, 0xeb, 0x19, 0x03, 0x97, 0xb5, 0x9f, 0xcb, 0x0c, 0x93, 0xd7, 0x83, 0x01, 0x21,
+	0x8d, 0x71, 0xe3, 0xb9, 0xab, 0x96, 0xa0, 0x24, 0xc4, 0xa8, 0xc3, 0x8c, 0xf6, 0x68, 0x61, 0x12,
+	0x93, 0xaf, 0x24, 0xe8, 0x2e, 0xc0, 0xb6, 0xdd, 0xf1, 0x62, 0x73, 0x1e, 0x8a, 0x3d, 0xd3, 0xc2,
+	0x24, 0x84, 0x7a, 0x20, 0x81, 0xa3, 0xec, 0x0d, 0xeb, 0xa9, 0x25, 0x28, 0x09, 0x91, 0x4e, 0x0e,
+	0xed, 0x5e, 0xcf, 0x3e, 0x46, 0xa5, 0xa7, 0x74, 0x45, 0xd1, 0x97, 0x30, 0xb9, 0x6d, 0x77, 0xb6,
+	0x4d, 0x8b, 0x89, 0xb0, 0xf6, 0xec, 0x4e, 0x87, 0xb5, 0xeb, 0x1c, 0x25, 0xe6, 0x75, 0x9f, 0xce,
+	0x10, 0xba, 0x00, 0x93, 0x7d, 0xe6, 0x8a, 0x04, 0x87, 0x52, 0xcb, 0xba, 0x47, 0xd2, 0x2a, 0x40,
+	0x7d, 0xc8, 0xbb, 0xab, 0xb6, 0x75, 0x68, 0x76, 0x84, 0x13, 0x8f, 0x4c, 0xab, 0xad, 0xf4, 0xc4,
+	0x67, 0x7a, 0x0b, 0xe0, 0xf9, 0xde, 0x76, 0x43, 0x71, 0x2c, 0xc0, 0x24, 0xb3, 0x8c, 0x66, 0x8f,
+	0x49, 0xa6, 0x29, 0xdd, 0x23, 0xa9, 0x03, 0x85, 0x17, 0x76, 0x9b, 0x91, 0x19, 0xd0, 0x4c, 0x35,
+	0x45, 0x34, 0x53, 0x50, 0x5d, 0x35, 0x31, 0xb4, 0xae, 0x90, 0xef, 0xb0, 0xc3, 0x23, 0x35, 0x9f,
+	0xf1, 0x59, 0x6c, 0xe8, 0x0e, 0x3b, 0xc4, 0x50, 0x4c, 0xe9, 0xe2, 0x51, 0xd8, 0xd0, 0x32, 0x5a,
+	0x5d, 0x86, 0x21, 0x98, 0xd2, 0x25, 0x81, 0xdf, 0xda, 0x36, 0x57, 0x9b, 0x1c, 0x3e, 0xd3, 0x45,
+	0x28, 0x6e, 0x1b, 0x23, 0xe6, 0x90, 0x6b, 0xa0, 0xf5, 0x32, 0xf6, 0x36, 0xa1, 0x94, 0xae, 0xf5,
+	0xe8, 0x22, 0x14, 0xf6, 0x1c, 0xc6, 0x08, 0x05, 0x8d, 0x2b, 0xd6, 0xf3, 0x31, 0x56, 0x94, 0xa5,
+	0x6b, 0x9c, 0xfe, 0xaf, 0x06, 0x53, 0x5b, 0x6c, 0xb4, 0x6f, 0xf4, 0x86, 0x2c, 0x79, 0xe2, 0x10,
+	0x0a, 0xbe, 0x11, 0xaf, 0x94, 0x61, 0x92, 0x20, 0x37, 0x60, 0xd6, 0x3d, 0x32, 0x07, 0x2f, 0xad,
+	0x16, 0x26, 0x82, 0xb6, 0x0a, 0x60, 0x74, 0x50, 0xc4, 0xb7, 0x65, 0xb7, 0x59, 0x4b, 0xce, 0xf7,
+	0x59, 0x5d, 0x51, 0xe4, 0x3b, 0x28, 0xf5, 0x8c, 0x26, 0xeb, 0x89, 0x89, 0x27, 0x74, 0xbb, 0x1e,
+	0xd3, 0xcd, 0x53, 0x67, 0x69, 0x1b, 0xb9, 0xd6, 0x2d, 0xee, 0x8c, 0x74, 0xf5, 0x49, 0xe5, 0x97,
+	0x30, 0x1d, 0x1a, 0x0e, 0x6b, 0x5c, 0x4e, 0xd1, 0xb8, 0xac, 0x34, 0x7e, 0x92, 0xfb, 0x56, 0xa3,
+	0xef, 0x80, 0x34, 0xb8, 0x33, 0x6c, 0xf1, 0xa1, 0xc3, 0xda, 0x63, 0x6c, 0xbe, 0x17, 0x96, 0x30,
+	0xfd, 0xe8, 0x42, 0x4c, 0xbd, 0x55, 0xdb, 0xe2, 0xcc, 0xe2, 0x1f, 0xe5, 0x0b, 0x5a, 0x87, 0x49,
+	0xf5, 0x5d, 0x74, 0x8f, 0xd3, 0x70, 0xc7, 0x0c, 0x06, 0x70, 0xc9, 0x1a, 0xa3, 0x9e, 0x6d, 0x78,
+	0x79, 0xcc, 0x23, 0xe9, 0x97, 0x50, 0xdc, 0xc4, 0x8d, 0xd5, 0xdf, 0x6e, 0xb5, 0xd0, 0x76, 0x4b,
+	0xff, 0x43, 0x83, 0xc2, 0x26, 0x67, 0xfd, 0x53, 0x07, 0x31, 0x7d, 0xd7, 0xfe, 0xc6, 0x0f, 0x4e,
+	0x21, 0x75, 0xff, 0x13, 0x10, 0x9f, 0x3a, 0x30, 0xbf, 0xd7, 0x60, 0x2e, 0x88, 0x4c, 0x86, 0x11,
+	0x1f, 0x17, 0x95, 0x74, 0xe3, 0xea, 0x31, 0xe3, 0xee, 0xc6, 0x84, 0x44, 0x95, 0xf8, 0xd4, 0x66,
+	0x3e, 0x86, 0xd2, 0xd6, 0xbe, 0x3a, 0xa3, 0xe6, 0xb7, 0xf6, 0xbd, 0x13, 0xc6, 0xc5, 0x8c, 0xe9,
+	0xaf, 0x0b, 0x1e, 0xfa, 0xa7, 0x30, 0xd9, 0x50, 0x5f, 0x7d, 0x0d, 0x85, 0x46, 0xf0, 0xd9, 0xb5,
+	0x4c, 0xdd, 0x7d, 0x01, 0xc8, 0x4e, 0x1f, 0xc2, 0xe4, 0x16, 0x1b, 0xa1, 0x84, 0x5b, 0x50, 0x38,
+	0x62, 0x23, 0x4f, 0x02, 0x49, 0x02, 0xeb, 0xf8, 0x5e, 0x9c, 0xa7, 0x85, 0x03, 0xbc, 0xf3, 0xb4,
+	0xc9, 0x59, 0x3f, 0xeb, 0x3c, 0x2d, 0xf8, 0x74, 0xc9, 0x41, 0x37, 0xc3, 0x0b, 0xcc, 0x17, 0xf0,
+	0x38, 0x2a, 0xe0, 0xcb, 0xb1, 0x3e, 0xf7, 0x44, 0x3d, 0x80, 0x82, 0x6e, 0xdb, 0x3c, 0x7d, 0xae,
+	0xfb, 0x09, 0x32, 0xa7, 0x92, 0xab, 0x48, 0x90, 0xff, 0xa7, 0xc1, 0x74, 0xa3, 0x65, 0x58, 0x3b,
+	0xf2, 0x8e, 0x25, 0xb2, 0xcf, 0xc0, 0x61, 0x87, 0xe6, 0x5b, 0x35, 0x89, 0x14, 0x25, 0xc6, 0xed,
+	0xc3, 0x43, 0x97, 0x79, 0x5f, 0x2b, 0x4a, 0xee, 0x5c, 0x7d, 0x93, 0x7b, 0x33, 0x06, 0x09, 0xb1,
+	0x1c, 0x1d, 0xf6, 0x86, 0x39, 0xea, 0xb8, 0x35, 0xa5, 0x7b, 0x24, 0xee, 0xc2, 0x8c, 0x0d, 0x54,
+	0xe6, 0xc6, 0x67, 0xf2, 0xd4, 0x9f, 0x5f, 0x25, 0xb4, 0xf5, 0x56, 0xdc, 0xd6, 0x40, 0xbf, 0x4f,
+	0x3d, 0xb9, 0xae, 0x43, 0x79, 0x8b, 0x8d, 0x76, 0x7d, 0x1b, 0xd3, 0x6c, 0xa7, 0x14, 0x40, 0x38,
+	0xd9, 0x5d, 0xb5, 0x87, 0x16, 0x5a, 0xdc, 0x12, 0x0f, 0x9e, 0x6f, 0x91, 0xa0, 0x0e, 0xcc, 0x6d,
+	0x5a, 0xad, 0xde, 0x50, 0x1c, 0x5c, 0x76, 0x1d, 0xdb, 0x3e, 0x24, 0x73, 0x90, 0x33, 0x3c, 0xa6,
+	0x9c, 0x11, 0x8a, 0x49, 0x2e, 0x2d, 0x26, 0xf9, 0x20, 0x26, 0x62, 0xac, 0xc7, 0x0c, 0xb9, 0xe3,
+	0xcd, 0xe8, 0xf8, 0x2c, 0xc6, 0x06, 0x06, 0xef, 0x62, 0xee, 0x9f, 0xd1, 0xf1, 0x99, 0xfe, 0xa4,
+	0xc1, 0xfc, 0xaa, 0x6d, 0xb9, 0xa6, 0xcb, 0x99, 0xd5, 0x1a, 0x49, 0xd8, 0xf3, 0x50, 0x3c, 0x34,
+	0x1d, 0xd7, 0x57, 0x0f, 0x09, 0x61, 0x9a, 0xcb, 0x5a, 0xb6, 0xd5, 0x56, 0xe8, 0x8a, 0x12, 0x59,
+	0x15, 0x19, 0xf4, 0x40, 0x87, 0x60, 0x40, 0x1c, 0xd0, 0x24, 0x1f, 0xbe, 0x96, 0xea, 0x84, 0x46,
+	0x52, 0x95, 0xfa, 0x17, 0x0d, 0x8a, 0x52, 0x13, 0xcf, 0x0c, 0x2d, 0x64, 0xc6, 0xe9, 0x9d, 0x20,
+	0xdd, 0x57, 0xf0, 0xdd, 0x77, 0x03, 0x66, 0x4d, 0xdf, 0xc1, 0x01, 0x68, 0x74, 0x50, 0xdc, 0x2c,
+	0x5b, 0x21, 0x8f, 0x08, 0xbe, 0x12, 0xf2, 0xc5, 0x87, 0xe9, 0x01, 0x4c, 0x35, 0x8c, 0x43, 0x86,
+	0x69, 0xf3, 0x36, 0x14, 0xc4, 0xfa, 0x41, 0x4d, 0x33, 0xd6, 0x2a, 0x32, 0x90, 0x45, 0x28, 0x0e,
+	0x84, 0x6d, 0x2a, 0x9b, 0xc6, 0x8f, 0x07, 0x68, 0xb7, 0x2e, 0x59, 0xa8, 0x0b, 0x44, 0x00, 0xc4,
+	0x32, 0xf4, 0xc3, 0x08, 0xd4, 0x09, 0xab, 0xfa, 0xe3, 0x41, 0xfb, 0x30, 0x87, 0xa0, 0x8c, 0x7b,
+	0x0b, 0xfa, 0x36, 0xe4, 0x8e, 0xde, 0x28, 0xb8, 0xcc, 0x9c, 0x99, 0x3b, 0x7a, 0x43, 0x1e, 0x41,
+	0x59, 0x38, 0x7e, 0xd3, 0x0f, 0x4f, 0x12, 0x0a, 0xdf, 0xe9, 0x01, 0x1b, 0x7d, 0x0f, 0xf3, 0x0a,
+	0xae, 0xb1, 0xef, 0x01, 0x3e, 0x86, 0xbc, 0xeb, 0x23, 0x9e, 0x22, 0xdd, 0xe6, 0xdd, 0x33, 0x82,
+	0xef, 0x4b, 0x5b, 0x37, 0x02, 0x5b, 0x93, 0xdb, 0xdf, 0xd9, 0x8c, 0x3a, 0x2f, 0xe4, 0xea, 0xec,
+	0x90, 0x39, 0xcc, 0x6a, 0x31, 0x4f, 0x7a, 0x0d, 0x72, 0x8e, 0xad, 0xec, 0x8a, 0xef, 0xef, 0x71,
+	0x66, 0x3d, 0xe7, 0xd8, 0x67, 0x02, 0xff, 0x2f, 0x0d, 0xe6, 0x9e, 0x31, 0xa3, 0xc7, 0xbb, 0xfe,
+	0xb5, 0x4a, 0xac, 0x5d, 0x6e, 0xf0, 0xa1, 0xab, 0x0e, 0xd4, 0x8a, 0x12, 0x49, 0xf6, 0x8d, 0xba,
+	0x3b, 0xc9, 0xbc, 0xe6, 0x91, 0x9f, 0xe2, 0x62, 0x45, 0xbe, 0x85, 0x49, 0x57, 0xde, 0xab, 0x30,
+	0x57, 0x4f, 0x3f, 0xba, 0x92, 0x08, 0x65, 0xe4, 0xd6, 0xa5, 0x7b, 0xec, 0x74, 0x05, 0xe6, 0x13,
+	0xbe, 0xbb, 0x0c, 0x65, 0xc7, 0x1b, 0x53, 0xf1, 0x09, 0x06, 0xbc, 0xb8, 0xe5, 0x82, 0x92, 0xdd,
+	0x06, 0x4c, 0xbf, 0xaa, 0xb7, 0xdb, 0xa1, 0xc0, 0x8a, 0xad, 0x47, 0x05, 0x56, 0xed, 0x3b, 0x6e,
+	0xcb, 0x76, 0x64, 0x4a, 0xd7, 0x74, 0x49, 0x78, 0x82, 0xf2, 0x81, 0xa0, 0x2e, 0xcc, 0xbc, 0x0a,
+	0xef, 0x6f, 0x49, 0x49, 0x9f, 0x68, 0x67, 0xa3, 0x3f, 0xc0, 0xcc, 0x66, 0x18, 0x09, 0xef, 0xd6,
+	0x1d, 0xd6, 0x30, 0xdf, 0x31, 0x95, 0x8b, 0x7d, 0x1a, 0x8b, 0x05, 0x46, 0x87, 0xbd, 0x18, 0xf6,
+	0x9b, 0xcc, 0xf1, 0x0a, 0x64, 0xc1, 0x08, 0x5d, 0x87, 0xc2, 0xae, 0x28, 0xae, 0x9d, 0xfe, 0x14,
+	0x21, 0x72, 0x68, 0x5f, 0xf8, 0x43, 0x9e, 0xa3, 0xf1, 0x99, 0xbe, 0x86, 0x62, 0x03, 0xe5, 0x9c,
+	0xe5, 0x30, 0x21, 0xcf, 0xd4, 0xa8, 0x92, 0xd2, 0xd0, 0x23, 0x53, 0xb1, 0x8e, 0xe1, 0x9c, 0x58,
+	0x35, 0xe1, 0xa8, 0x3d, 0x80, 0xe2, 0x3b, 0x7b, 0xc0, 0x5d, 0xb5, 0x66, 0x2a, 0x31, 0xd4, 0x10,
+	0xab, 0x2e, 0x19, 0xcf, 0xb4, 0x62, 0xfe, 0x52, 0xe6, 0x20, 0x24, 0x3c, 0xe4, 0xf4, 0xf3, 0xcf,
+	0x59, 0xa4, 0xb7, 0xa1, 0xb8, 0xee, 0x38, 0xb6, 0x43, 0xbe, 0x81, 0x32, 0x13, 0x0f, 0xe2, 0x36,
+	0x86, 0x62, 0xe7, 0x12, 0xb5, 0x5b, 0x64, 0x5c, 0xb5, 0xdb, 0xcc, 0xd5, 0x03, 0x5e, 0xb1, 0xd8,
+	0x90, 0xf0, 0xee, 0xd7, 0x72, 0xad, 0x46, 0xc6, 0xe8, 0x36, 0x4c, 0xad, 0x79, 0xd5, 0x37, 0x0a,
+	0x33, 0x5e, 0x6d, 0xca, 0x32, 0xfa, 0x5e, 0x11, 0x23, 0x32, 0x86, 0xf5, 0x5e, 0xbb, 0xd7, 0xc3,
+	0x82, 0xa6, 0x12, 0x18, 0x0c, 0xd0, 0x3d, 0x98, 0x7f, 0xe9, 0x32, 0x4f, 0xa0, 0xce, 0x06, 0xbd,
+	0x91, 0xd8, 0x44, 0x10, 0x71, 0x41, 0x4b, 0xb5, 0x1b, 0x55, 0xd7, 0x25, 0x4b, 0x50, 0xc7, 0x51,
+	0xc7, 0x25, 0x24, 0x68, 0x1d, 0x3e, 0x97, 0x85, 0xba, 0x33, 0x0b, 0xa6, 0x43, 0xb8, 0xe8, 0x7d,
+	0xbc, 0xc7, 0xfa, 0x83, 0x9e, 0xc1, 0x99, 0x57, 0x01, 0x39, 0x8d, 0xd5, 0x15, 0x98, 0xe2, 0xea,
+	0x33, 0xa5, 0x9a, 0x4f, 0x8b, 0x77, 0xc7, 0x26, 0xef, 0x0a, 0xf1, 0x6a, 0x5a, 0xfa, 0x34, 0xfd,
+	0x11, 0xce, 0xad, 0x0c, 0x7b, 0x47, 0xdb, 0xb6, 0xd1, 0x0e, 0x95, 0x3f, 0x0f, 0xcd, 0x5e, 0x18,
+	0xca, 0xa7, 0x65, 0x3d, 0xd9, 0x1d, 0xf6, 0x59, 0xfd, 0x90, 0x33, 0x67, 0xc5, 0xe0, 0xad, 0x2e,
+	0xf3, 0xaa, 0xd8, 0x29, 0x6f, 0xe8, 0xdf, 0x6b, 0x30, 0x1b, 0xc8, 0x17, 0x3e, 0xc1, 0x1a, 0x08,
+	0x77, 0x4c, 0x55, 0xd0, 0x29, 0xe8, 0x1e, 0x29, 0xde, 0x34, 0x23, 0x02, 0x3d, 0x32, 0xe3, 0xea,
+	0x75, 0x01, 0x4a, 0x6d, 0xb3, 0xc3, 0x5c, 0xef, 0xf4, 0xa5, 0x28, 0xc1, 0xdd, 0xc4, 0x82, 0xb8,
+	0x2c, 0x73, 0x4b, 0x42, 0x1c, 0x08, 0xcf, 0x8b, 0x62, 0x8d, 0xed, 0x98, 0xef, 0x70, 0x2a, 0xa4,
+	0xd5, 0xfe, 0xbc, 0xa2, 0x72, 0x56, 0x59, 0x3a, 0x5c, 0x2b, 0xcd, 0x8f, 0xad, 0x0c, 0x17, 0x12,
+	0x85, 0x68, 0xa2, 0x6e, 0x4a, 0xea, 0x40, 0x28, 0x9e, 0xe9, 0x26, 0x7c, 0x11, 0xd3, 0x49, 0xed,
+	0x6b, 0x0b, 0x30, 0x69, 0x88, 0xca, 0x55, 0x50, 0x29, 0x52, 0xa4, 0x50, 0xcd, 0x61, 0x86, 0xeb,
+	0xcf, 0x6d, 0x45, 0xd1, 0x26, 0x7c, 0xb6, 0x6f, 0xf4, 0xcc, 0x76, 0xc4, 0xb6, 0x71, 0x95, 0xec,
+	0x87, 0x41, 0x20, 0x72, 0xe3, 0x6f, 0x8d, 0x1e, 0x1f, 0xfd, 0x1e, 0x48, 0x18, 0xe3, 0xcc, 0xba,
+	0xfe, 0xab, 0x06, 0x17, 0x55, 0xc1, 0x37, 0x68, 0xe9, 0x28, 0x95, 0xbf, 0x91, 0x0d, 0x19, 0xdb,
+	0x52, 0x89, 0xe4, 0x6a, 0x66, 0x13, 0xa8, 0x8e, 0x6c, 0xba, 0x62, 0x17, 0xb6, 0x8a, 0xd8, 0xe1,
+	0xb4, 0x55, 0x2b, 0xc0, 0xa3, 0x4f, 0x8a, 0x5b, 0xa8, 0x38, 0x5d, 0x48, 0x14, 0xa7, 0x7f, 0x80,
+	0xf3, 0x0d, 0xc6, 0xeb, 0xd8, 0x16, 0x0a, 0x17, 0xc3, 0x83, 0xce, 0x91, 0x16, 0xee, 0x1c, 0x8d,
+	0xd3, 0x83, 0x3e, 0x87, 0xf3, 0xde, 0x22, 0x17, 0x17, 0x59, 0xdf, 0x85, 0x5f, 0x43, 0xd9, 0xd3,
+	0x27, 0xeb, 0x0e, 0xef, 0x67, 0x96, 0x80, 0x93, 0x0e, 0xe1, 0xdc, 0x1a, 0xeb, 0x31, 0xce, 0xda,
+	0x1f, 0x9b, 0x21, 0xdb, 0xf2, 0xb3, 0xba, 0xdc, 0xd8, 0xf3, 0x7a, 0x30, 0x20, 0xaa, 0xe7, 0x83,
+	0xa1, 0xd3, 0x61, 0xa2, 0x30, 0x59, 0x97, 0x3b, 0x7c, 0x5e, 0x0f, 0x0f, 0xd1, 0x06, 0x7c, 0x1e,
+	0x83, 0xc5, 0x5b, 0xf9, 0x72, 0xd2, 0x88, 0xf8, 0xb9, 0x28, 0xf6, 0x59, 0xd8, 0x96, 0x3f, 0x81,
+	0xa2, 0xd8, 0x1e, 0x5a, 0xe2, 0x72, 0x63, 0x7a, 0x45, 0xd4, 0x9c, 0xd9, 0x16, 0xeb, 0x26, 0xe4,
+	0x4b, 0x7c, 0xf6, 0x4b, 0xad, 0x32, 0x96, 0xf8, 0x4c, 0x7f, 0x01, 0xa5, 0x55, 0x59, 0x0d, 0xbc,
+	0xe7, 0x57, 0x09, 0xd3, 0x2b, 0x95, 0xc8, 0xe6, 0xd5, 0x0e, 0xe9, 0x63, 0x98, 0xd5, 0xd9, 0xc0,
+	0x76, 0xfc, 0x93, 0x32, 0x85, 0x19, 0x79, 0xb9, 0xdd, 0x66, 0x56, 0x87, 0x77, 0x95, 0x2a, 0x91,
+	0x31, 0xca, 0x61, 0x46, 0x5e, 0x8c, 0xe5, 0xa7, 0x99, 0xa5, 0x01, 0x6f, 0xd1, 0xcb, 0x84, 0x86,
+	0xcf, 0xe1, 0x0c, 0x98, 0x8f, 0x66, 0xc0, 0x2b, 0x00, 0x78, 0xfd, 0x0e, 0xf7, 0xf3, 0x42, 0x23,
+	0x74, 0x1d, 0xce, 0xe1, 0x8a, 0x14, 0xe7, 0xa4, 0x95, 0x61, 0xeb, 0x88, 0xe1, 0x99, 0xab, 0x6f,
+	0xbc, 0x0d, 0x1d, 0xa4, 0x3c, 0x32, 0x0c, 0x93, 0x8b, 0xc0, 0xd0, 0x57, 0x30, 0xb3, 0xe1, 0xd8,
+	0xc7, 0xbc, 0xab, 0x94, 0x9f, 0x87, 0x7c, 0xdb, 0xf0, 0x8b, 0x02, 0x6d, 0x63, 0x94, 0xfd, 0x6d,
+	0x4c, 0xc5, 0x7c, 0x42, 0xc5, 0x7f, 0xc8, 0xc1, 0x74, 0x83, 0xdb, 0x0e, 0x53, 0xb2, 0x89, 0x5f,
+	0x1f, 0x4a, 0x75, 0xc0, 0xc7, 0x49, 0x27, 0xdf, 0xc0, 0x94, 0x74, 0x2c, 0xf3, 0xea, 0x6d, 0x97,
+	0x12, 0x37, 0xbe, 0x20, 0x2a, 0xba, 0xcf, 0x4c, 0x9e, 0x2a, 0xc1, 0xc2, 0x33, 0x5e, 0x91, 0x38,
+	0x3e, 0x39, 0x63, 0xae, 0xd5, 0x43, 0x5f, 0x90, 0xc7, 0x50, 0xea, 0xa0, 0xcb, 0x16, 0x4a, 0xa9,
+	0xb0, 0x61, 0x7f, 0xea, 0x8a, 0x75, 0xf1, 0x9f, 0x35, 0x80, 0xe0, 0xdc, 0x43, 0x4a, 0x90, 0xdb,
+	0x39, 0x9a, 0x9f, 0x20, 0x97, 0x61, 0x61, 0x5d, 0xd7, 0x77, 0xf4, 0x83, 0xc6, 0xfa, 0xf6, 0xfa,
+	0xea, 0xde, 0xe6, 0x8b, 0x8d, 0x83, 0xb5, 0xfa, 0x5e, 0x7d, 0xa5, 0xde, 0x58, 0x9f, 0xd7, 0xc8,
+	0x5d, 0xb8, 0x29, 0xdf, 0xbe, 0xd8, 0x39, 0xd8, 0x5d, 0xd7, 0x9f, 0x6f, 0x36, 0x1a, 0x9b, 0x3b,
+	0x2f, 0x0e, 0xbe, 0xdf, 0xd1, 0x0f, 0xf6, 0x9e, 0x6d, 0x36, 0x02, 0xd6, 0x1c, 0xa9, 0xc2, 0x65,
+	0xc9, 0xfa, 0xb2, 0xb1, 0xae, 0x1f, 0x3c, 0xab, 0x37, 0x0e, 0x5e, 0xec, 0xec, 0x1d, 0x6c, 0xef,
+	0x6c, 0x6c, 0xac, 0xaf, 0x1d, 0x6c, 0xbe, 0x98, 0xcf, 0x93, 0x4b, 0x70, 0x51, 0x72, 0xac, 0xad,
+	0x1c, 0xac, 0xed, 0xac, 0x4b, 0x86, 0xf5, 0xdf, 0x6c, 0x36, 0xf6, 0xe6, 0x0b, 0x8b, 0x77, 0x61,
+	0x3e, 0x9e, 0x4c, 0x49, 0x19, 0x8a, 0x1b, 0x7a, 0xfd, 0xc5, 0xde, 0xfc, 0x04, 0x01, 0x28, 0xe9,
+	0xeb, 0xfb, 0x3b, 0x5b, 0xeb, 0xf3, 0xda, 0xa3, 0x3f, 0xdc, 0x83, 0xe9, 0xcd, 0x7e, 0x7f, 0xd8,
+	0x60, 0xce, 0x1b, 0xb3, 0xc5, 0x88, 0x01, 0x65, 0xb1, 0xe4, 0x45, 0x3a, 0x74, 0xc9, 0x85, 0x25,
+	0xf9, 0xa3, 0x85, 0x25, 0xef, 0x47, 0x0b, 0x4b, 0xeb, 0xe2, 0x47, 0x0b, 0x95, 0x8b, 0x29, 0xed,
+	0x62, 0xf1, 0x15, 0xbd, 0xfe, 0xb7, 0xff, 0xf3, 0xfb, 0x7f, 0xca, 0x7d, 0x49, 0x2e, 0xd5, 0xde,
+	0x3c, 0xac, 0x09, 0x1e, 0x87, 0xb9, 0x7c, 0xe0, 0xd8, 0x6f, 0x47, 0x35, 0x91, 0x29, 0x6b, 0x3d,
+	0x91, 0x4d, 0x4c, 0x98, 0xdc, 0x60, 0x88, 0x40, 0x2a, 0x29, 0x82, 0x54, 0x16, 0xae, 0x5c, 0x4a,
+	0x7d, 0x27, 0xd3, 0x2a, 0xbd, 0x89, 0x40, 0x57, 0xc9, 0x97, 0x19, 0x40, 0xef, 0xc5, 0xbf, 0x1f,
+	0x88, 0x05, 0x10, 0xb4, 0x59, 0x49, 0x35, 0x9e, 0x2d, 0xe2, 0x1d, 0xd8, 0xf1, 0x98, 0xd7, 0x10,
+	0xf3, 0x12, 0xbd, 0x90, 0x8e, 0xf9, 0x44, 0x5b, 0x24, 0x7f, 0xa3, 0xc1, 0x5c, 0xb4, 0xdf, 0x49,
+	0x6e, 0xc4, 0x41, 0xd3, 0xda, 0xa1, 0x95, 0x0c, 0x4f, 0xd3, 0x87, 0x88, 0xf9, 0x15, 0xbd, 0x95,
+	0x61, 0xa7, 0xd7, 0xb7, 0xac, 0xc9, 0xde, 0x81, 0xd0, 0xc1, 0x82, 0xd9, 0x06, 0xe3, 0x41, 0xfc,
+	0x49, 0xda, 0xfd, 0x29, 0x13, 0xf0, 0x01, 0x02, 0x2e, 0xd2, 0x9b, 0x59, 0x80, 0xbe, 0xdc, 0x9a,
+	0xcb, 0xb8, 0xc0, 0x73, 0x60, 0x6e, 0x8d, 0xe1, 0x0e, 0xe9, 0xf9, 0x79, 0x5c, 0x54, 0xb3, 0x70,
+	0xef, 0x21, 0xee, 0x2d, 0x7a, 0x2d, 0x03, 0xb7, 0xed, 0x43, 0x08, 0xcc, 0x0d, 0x98, 0x7f, 0x39,
+	0x68, 0x1b, 0x9c, 0x85, 0x9a, 0x74, 0xf1, 0x7b, 0x49, 0xf0, 0x2a, 0x13, 0x74, 0x22, 0x10, 0x14,
+	0xea, 0xe5, 0xc5, 0x05, 0x05, 0xaf, 0xc6, 0x08, 0x7a, 0x02, 0xe5, 0x5d, 0xc7, 0xb4, 0x38, 0xf6,
+	0xd2, 0xb2, 0xd6, 0x4d, 0x3c, 0x12, 0x82, 0x99, 0x4e, 0x90, 0x35, 0x28, 0xa9, 0x9c, 0x7a, 0x39,
+	0x51, 0x60, 0x09, 0x6d, 0x5f, 0x95, 0x4a, 0xe2, 0x02, 0xeb, 0x67, 0x63, 0x3a, 0x41, 0xbe, 0x83,
+	0xa2, 0xfc, 0x6d, 0x4a, 0x16, 0x7a, 0xf2, 0x47, 0x1e, 0xea, 0xe7, 0x20, 0x74, 0x82, 0xec, 0x47,
+	0x7f, 0x2d, 0x81, 0x3f, 0x52, 0x48, 0xac, 0x97, 0xc4, 0xef, 0x29, 0x2a, 0x5f, 0x66, 0x72, 0x28,
+	0xb9, 0x5b, 0xc9, 0x3e, 0x75, 0x96, 0x7a, 0x27, 0x54, 0x5a, 0xe8, 0x04, 0xf9, 0x15, 0x14, 0xb6,
+	0xed, 0x8e, 0x9b, 0x08, 0x50, 0xd0, 0x52, 0xae, 0x5c, 0x48, 0xbe, 0x12, 0xbd, 0x61, 0x3a, 0xf1,
+	0x40, 0x23, 0x3f, 0xc0, 0x94, 0x77, 0x61, 0x21, 0x71, 0xb0, 0xd8, 0x4d, 0xa9, 0x72, 0x39, 0xf3,
+	0xfd, 0xa0, 0x27, 0xc2, 0x7d, 0x04, 0x45, 0xfc, 0x15, 0x00, 0xb9, 0x94, 0x04, 0x34, 0xad, 0x2c,
+	0x29, 0x91, 0x1f, 0x0e, 0xd0, 0xdb, 0x3f, 0xd5, 0x73, 0xcd, 0x09, 0x9c, 0xf7, 0x97, 0xe9, 0xc5,
+	0xe4, 0xbc, 0xef, 0x09, 0x6e, 0x31, 0xdb, 0x7f, 0x84, 0xd2, 0xb6, 0xdd, 0xb1, 0x87, 0x3c, 0xd3,
+	0x77, 0x59, 0xf3, 0x52, 0xe5, 0x63, 0xba, 0x90, 0x2a, 0xdd, 0x1e, 0xe2, 0x02, 0xfe, 0x35, 0xe4,
+	0x1b, 0x8c, 0x93, 0xac, 0x4b, 0x42, 0x25, 0xb5, 0x5a, 0x30, 0x2e, 0x1b, 0x9a, 0x9c, 0xf5, 0x85,
+	0xe0, 0x15, 0x28, 0x62, 0x8d, 0x94, 0x9c, 0x5c, 0x0f, 0xcd, 0x00, 0x99, 0x20, 0x87, 0x30, 0xa9,
+	0x6a, 0xad, 0x24, 0x51, 0xbf, 0x89, 0x94, 0x7c, 0x2b, 0xa9, 0x15, 0x62, 0x7a, 0x0b, 0xd5, 0xac,
+	0xd2, 0x4b, 0xe9, 0x6a, 0xd6, 0x5c, 0xe3, 0x10, 0x33, 0xca, 0x1a, 0x94, 0xfd, 0x9a, 0x2e, 0xb9,
+	0x9a, 0x8e, 0xd4, 0xd8, 0x1f, 0x8f, 0x35, 0x41, 0xf6, 0x20, 0xbf, 0xc1, 0x38, 0x49, 0x69, 0x96,
+	0x55, 0xd2, 0xb2, 0x30, 0xbd, 0x81, 0xda, 0x5d, 0x21, 0x97, 0x33, 0xb4, 0x7b, 0x7f, 0xc4, 0x46,
+	0x1f, 0xc8, 0x32, 0x14, 0x37, 0x50, 0xaf, 0x34, 0xb9, 0xe3, 0xab, 0x5a, 0x74, 0x82, 0x7c, 0x0b,
+	0xe5, 0x0d, 0xc6, 0xd5, 0x01, 0x3a, 0x4d, 0xc2, 0x17, 0x69, 0x87, 0x68, 0xb1, 0xde, 0xfa, 0xd2,
+	0xf7, 0x1b, 0x19, 0xbe, 0x0f, 0x4a, 0xd0, 0x95, 0x8b, 0x29, 0xaf, 0x11, 0x7e, 0x11, 0x0d, 0xbc,
+	0x41, 0xaf, 0x8e, 0x71, 0x7f, 0xad, 0x23, 0x37, 0x92, 0x1d, 0x19, 0x02, 0x69, 0xea, 0x09, 0x80,
+	0xd7, 0xd2, 0x22, 0x14, 0xb7, 0x5c, 0x34, 0x3b, 0x18, 0xc7, 0x82, 0x05, 0x89, 0x1b, 0x29, 0xdb,
+	0xa4, 0x19, 0xd3, 0x6e, 0xcc, 0xa4, 0xc1, 0xc2, 0x85, 0xb7, 0xf5, 0x2d, 0x03, 0x78, 0x00, 0x8d,
+	0x7d, 0x12, 0xcf, 0x3d, 0x8d, 0xb1, 0x18, 0x13, 0xa4, 0x0e, 0x73, 0xfe, 0xd7, 0xdc, 0x61, 0x46,
+	0xff, 0xe3, 0x94, 0x9c, 0xb8, 0xa3, 0x91, 0x16, 0x4c, 0x6d, 0x78, 0x16, 0x5e, 0x48, 0x86, 0x16,
+	0xbf, 0xbe, 0x98, 0x32, 0xf1, 0xc4, 0x8b, 0x93, 0xad, 0x54, 0x71, 0xd9, 0x04, 0xd8, 0xc8, 0xb6,
+	0xd2, 0x83, 0xb9, 0x36, 0x76, 0x1e, 0xaa, 0xed, 0xa0, 0x05, 0x05, 0x51, 0x2c, 0x4e, 0x9c, 0x10,
+	0x42, 0x15, 0xe4, 0x33, 0xe9, 0x2b, 0xe7, 0x52, 0xcb, 0xb0, 0xa4, 0xbe, 0x25, 0x21, 0xaf, 0xb1,
+	0x3f, 0x16, 0xe6, 0x54, 0xfa, 0x1e, 0x89, 0xab, 0xab, 0x68, 0x7f, 0x2e, 0x24, 0xad, 0x96, 0xf7,
+	0x91, 0xca, 0xcf, 0x52, 0xd4, 0x95, 0x3d, 0x53, 0x7a, 0x1f, 0x15, 0xbe, 0x4d, 0x6e, 0x66, 0x28,
+	0x8c, 0x3d, 0xd4, 0xda, 0x7b, 0x79, 0x95, 0xf9, 0x40, 0x0e, 0x60, 0x7a, 0x75, 0xe8, 0x38, 0xe2,
+	0x87, 0x09, 0xa2, 0x15, 0x78, 0xda, 0x43, 0x84, 0x60, 0xa6, 0xd7, 0x83, 0xbd, 0x64, 0x81, 0xa4,
+	0xa4, 0x64, 0x6c, 0x2e, 0x3a, 0x50, 0xf6, 0xbb, 0xb5, 0x24, 0x75, 0x52, 0x25, 0xb2, 0x49, 0xb4,
+	0xbb, 0xeb, 0x9d, 0x0e, 0xc9, 0x9d, 0x14, 0x8b, 0x3c, 0x4e, 0x6c, 0xc9, 0xd5, 0xde, 0x63, 0x25,
+	0xef, 0x03, 0x79, 0x0b, 0xd3, 0xa1, 0x66, 0x6d, 0x06, 0xea, 0xd5, 0xe4, 0xef, 0x33, 0x22, 0xed,
+	0x5d, 0xfa, 0x08, 0x71, 0xef, 0x91, 0xc5, 0x24, 0x6e, 0xa8, 0xc3, 0x19, 0x45, 0x6e, 0xc2, 0xe4,
+	0xca, 0x48, 0xfd, 0x08, 0x26, 0x15, 0x35, 0x35, 0x23, 0xab, 0x73, 0x28, 0xb9, 0x91, 0x11, 0x33,
+	0x14, 0xee, 0x63, 0xbc, 0x83, 0xe9, 0x95, 0x91, 0x5f, 0x87, 0x4f, 0xdd, 0x37, 0xc2, 0x15, 0xfa,
+	0xec, 0x3c, 0xa9, 0xce, 0xf9, 0xe4, 0xee, 0xb8, 0x3c, 0x19, 0xc5, 0x5e, 0x81, 0xb2, 0xb2, 0xaf,
+	0xb1, 0x7f, 0xca, 0x68, 0xa6, 0x64, 0xc8, 0xc9, 0x67, 0xa6, 0xcb, 0x6d, 0x67, 0x94, 0xba, 0x33,
+	0x64, 0x2e, 0xc5, 0xdb, 0xa8, 0xee, 0x35, 0x92, 0x92, 0xd6, 0xbb, 0x52, 0x9e, 0xda, 0xba, 0xd6,
+	0xa0, 0xac, 0x00, 0x32, 0xb6, 0xaf, 0x53, 0x2d, 0x43, 0x0b, 0x4a, 0xb2, 0x3b, 0x98, 0xb9, 0x28,
+	0xe2, 0x96, 0x46, 0x9b, 0x89, 0xf4, 0x7e, 0xb0, 0x3c, 0x28, 0xa9, 0xa6, 0x28, 0x8d, 0xec, 0x8e,
+	0x62, 0x27, 0xaf, 0xa1, 0xec, 0xf7, 0xf2, 0xc8, 0x49, 0x4d, 0xcf, 0x8f, 0xdf, 0x43, 0xfc, 0x16,
+	0xa0, 0xc8, 0x56, 0xc7, 0x30, 0x1b, 0xe9, 0xbb, 0x92, 0xeb, 0x29, 0x73, 0xe4, 0x44, 0x4c, 0xb9,
+	0x4c, 0xbe, 0x42, 0xcc, 0x9b, 0x34, 0xc5, 0x42, 0x9c, 0x40, 0x11, 0xe0, 0xbf, 0x80, 0x82, 0xe8,
+	0x45, 0x91, 0x31, 0x0d, 0xaa, 0x8f, 0x3f, 0xfa, 0xbd, 0x33, 0xda, 0x6d, 0x21, 0xdc, 0x80, 0x22,
+	0x36, 0x20, 0x13, 0xe7, 0xe3, 0x57, 0xa7, 0x4a, 0xf5, 0x34, 0xfb, 0x54, 0xfc, 0xce, 0x4b, 0xf3,
+	0x5b, 0x30, 0xf9, 0x4a, 0xe5, 0xf9, 0xb1, 0x20, 0xa7, 0x9a, 0x61, 0x5d, 0xf9, 0xbb, 0x08, 0x74,
+	0xc8, 0x95, 0x94, 0x00, 0x8c, 0x73, 0xca, 0x89, 0x07, 0x4d, 0xf4, 0xbd, 0xe7, 0x99, 0x1f, 0xa1,
+	0xb8, 0x99, 0xea, 0x99, 0x70, 0x1b, 0x35, 0x91, 0x9b, 0x44, 0x3f, 0x73, 0x9c, 0x57, 0x4c, 0xcf,
+	0x2b, 0x4f, 0x61, 0x72, 0x33, 0xc3, 0x2b, 0x11, 0x80, 0xb8, 0x11, 0xd8, 0x31, 0xa5, 0x13, 0x64,
+	0x07, 0x0a, 0x6b, 0xc3, 0xfe, 0x20, 0x73, 0xa1, 0xc1, 0xd2, 0xa0, 0xa9, 0xce, 0x25, 0xe3, 0xe6,
+	0x41, 0x7b, 0xd8, 0x1f, 0x3c, 0xd1, 0x16, 0x1f, 0x68, 0xe4, 0x1d, 0xcc, 0x45, 0x1b, 0x68, 0x24,
+	0xab, 0xfe, 0x5d, 0xa1, 0xa9, 0xf5, 0x99, 0x48, 0xe3, 0x6d, 0xdc, 0x14, 0xf7, 0xff, 0xf4, 0x03,
+	0xd9, 0x85, 0x33, 0x5e, 0x43, 0x25, 0x2a, 0xe3, 0x7b, 0xc7, 0xee, 0x7b, 0x3d, 0x38, 0x72, 0x2b,
+	0x43, 0x8f, 0x58, 0x93, 0xee, 0x54, 0x6a, 0x89, 0x9b, 0xee, 0x9c, 0xac, 0x81, 0x9f, 0x6c, 0xe7,
+	0x09, 0xb5, 0x73, 0xac, 0x08, 0x9c, 0xd3, 0x99, 0x48, 0x9b, 0xa7, 0x90, 0x96, 0x5d, 0x93, 0x58,
+	0x81, 0xd9, 0x5d, 0x51, 0xdc, 0xff, 0x63, 0x64, 0x64, 0x74, 0x04, 0xb2, 0xa6, 0x07, 0x1d, 0x6f,
+	0x9a, 0x5a, 0x6d, 0x1f, 0xf0, 0x8f, 0x0f, 0x4e, 0x56, 0xeb, 0x6a, 0xb2, 0x90, 0x14, 0x75, 0xfb,
+	0xcf, 0x71, 0x36, 0x2c, 0x91, 0x7b, 0xa9, 0x55, 0x23, 0x6f, 0x2a, 0xd4, 0xde, 0x87, 0x9b, 0x24,
+	0x1f, 0xc8, 0x6f, 0x61, 0x3e, 0xde, 0xa3, 0x4a, 0x4c, 0x86, 0x8c, 0x26, 0x56, 0x25, 0xb5, 0xd3,
+	0xeb, 0x9d, 0xf4, 0x28, 0x4d, 0x99, 0x95, 0x28, 0x28, 0x28, 0x9b, 0x89, 0x79, 0xf9, 0x01, 0x4b,
+	0x74, 0x41, 0xe3, 0x29, 0x99, 0xf3, 0x53, 0xda, 0x52, 0x99, 0x41, 0xaa, 0x21, 0xf8, 0x5d, 0x7a,
+	0x23, 0xa3, 0x74, 0xe6, 0x32, 0x6e, 0xf8, 0xc2, 0x04, 0xfc, 0x7b, 0x98, 0x39, 0x55, 0x30, 0xaf,
+	0x67, 0xc4, 0x25, 0xdc, 0xe0, 0xa2, 0x4b, 0x88, 0x7e, 0x87, 0x5e, 0xcf, 0x40, 0xf7, 0x5c, 0x2f,
+	0x4a, 0xbf, 0x4f, 0xb4, 0xc5, 0x47, 0xaf, 0x61, 0x4e, 0xd4, 0x9b, 0xbd, 0xe6, 0x28, 0x73, 0xc8,
+	0x6f, 0xa0, 0xec, 0x53, 0x09, 0x4f, 0xa4, 0x35, 0x76, 0x2b, 0x37, 0xc6, 0x33, 0x29, 0xcd, 0x26,
+	0x1e, 0x35, 0x61, 0x56, 0x60, 0xa9, 0xce, 0xa6, 0xed, 0x90, 0x3f, 0x83, 0x29, 0x45, 0xb0, 0x44,
+	0x75, 0x2b, 0xd1, 0x63, 0xad, 0x5c, 0x1b, 0xc3, 0xe1, 0x61, 0xac, 0xfc, 0x63, 0xfe, 0xa7, 0xfa,
+	0xef, 0x72, 0xe4, 0x0f, 0x1a, 0x9c, 0x93, 0xdc, 0x55, 0x7d, 0xbd, 0xb1, 0x57, 0xad, 0xef, 0x6e,
+	0x92, 0xdf, 0x69, 0xcb, 0xcd, 0xa7, 0x9b, 0xcf, 0x77, 0x77, 0xf4, 0xbd, 0xfa, 0x8b, 0xbd, 0xe5,
+	0x5a, 0xf3, 0xe9, 0x93, 0x6a, 0xbd, 0xd7, 0xab, 0x2e, 0x8b, 0xfe, 0xd4, 0xd3, 0x0e, 0xe3, 0xcb,
+	0x35, 0x7c, 0xaa, 0x1a, 0x56, 0x5b, 0x0d, 0x8a, 0xad, 0x22, 0xf4, 0xe2, 0x70, 0x68, 0x61, 0xf5,
+	0xde, 0xad, 0x3a, 0x8c, 0x0f, 0x1d, 0xab, 0xba, 0x3c, 0x7c, 0x2a, 0x9c, 0xf9, 0x8b, 0x9f, 0xdf,
+	0x67, 0x96, 0x60, 0x69, 0x2f, 0xd7, 0x86, 0x4f, 0xab, 0xa2, 0xf7, 0x82, 0x42, 0xb0, 0xaf, 0xe1,
+	0xde, 0xab, 0x1e, 0x77, 0xcd, 0x1e, 0xab, 0x1a, 0x3e, 0x96, 0x9b, 0x85, 0xe5, 0xa6, 0x61, 0xb1,
+	0xb7, 0x03, 0xd6, 0xe2, 0x19, 0x58, 0xa6, 0x35, 0x18, 0x72, 0x77, 0xe9, 0xd5, 0x9f, 0xc3, 0xaf,
+	0xa1, 0xd4, 0x64, 0x86, 0xc3, 0x1c, 0xf2, 0x7c, 0x2a, 0x47, 0xbe, 0x15, 0x41, 0x60, 0x16, 0x37,
+	0x5b, 0xe8, 0xa1, 0x2a, 0xfe, 0x7c, 0xe2, 0x5e, 0x55, 0xf5, 0x69, 0xda, 0xd5, 0xe6, 0xa8, 0xba,
+	0x82, 0xdc, 0x4f, 0xd4, 0xff, 0xd5, 0x65, 0x64, 0x79, 0x5a, 0x99, 0x8d, 0x84, 0xaf, 0x9a, 0x6b,
+	0xce, 0x00, 0xf8, 0xa2, 0x27, 0x5e, 0x7d, 0xd5, 0x31, 0x79, 0x77, 0xd8, 0x5c, 0x6a, 0xd9, 0x7d,
+	0xd4, 0xd4, 0xb2, 0xb9, 0xe1, 0x8c, 0x6a, 0xd2, 0xd9, 0xb5, 0xc1, 0x51, 0x07, 0xff, 0xe0, 0x52,
+	0x86, 0xa8, 0x59, 0xc2, 0x29, 0xfc, 0xf8, 0xff, 0x07, 0x00, 0x1b, 0x56, 0x3d, 0xb0, 0xa9, 0x39,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
-var _ grpc.ClientConn
+var _ grpc.ClientConnInterface
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+const _ = grpc.SupportPackageIsVersion6
 
 // ImmuServiceClient is the client API for ImmuService service.
 //
@@ -3067,6 +4642,18 @@ type ImmuServiceClient interface {
 	UpdateAuthConfig(ctx context.Context, in *AuthConfig, opts ...grpc.CallOption) (*empty.Empty, error)
 	UpdateMTLSConfig(ctx context.Context, in *MTLSConfig, opts ...grpc.CallOption) (*empty.Empty, error)
 	PrintTree(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Tree, error)
+	Report(ctx context.Context, in *ReportOptions, opts ...grpc.CallOption) (*StoreReport, error)
+	// Usage reports the operations and written bytes of each user, persisted in the system database across restarts
+	Usage(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*UsageList, error)
+	// OnBehalfOfRecords lists the writes executed on behalf of end users, in database and index order
+	OnBehalfOfRecords(ctx context.Context, in *OnBehalfOfRequest, opts ...grpc.CallOption) (*OnBehalfOfList, error)
+	// StartupProgress reports the progress of the databases loading at startup, in tree entries while they are replayed,
+	// and how long it took once done. Until then it is served without authentication and the other methods wait.
+	StartupProgress(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*StartupProgress, error)
+	// Logs streams the recent lines logged by the server, and the new ones when following
+	Logs(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (ImmuService_LogsClient, error)
+	// BulkLoad ingests a checksummed bulk load file found on the server file system into the selected database
+	BulkLoad(ctx context.Context, in *BulkLoadRequest, opts ...grpc.CallOption) (*BulkLoadReply, error)
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	Logout(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
 	Set(ctx context.Context, in *KeyValue, opts ...grpc.CallOption) (*Index, error)
@@ -3075,10 +4662,19 @@ type ImmuServiceClient interface {
 	SafeSetSV(ctx context.Context, in *SafeSetSVOptions, opts ...grpc.CallOption) (*Proof, error)
 	Get(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Item, error)
 	GetSV(ctx context.Context, in *Key, opts ...grpc.CallOption) (*StructuredItem, error)
+	GetCodecs(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Codecs, error)
 	SafeGet(ctx context.Context, in *SafeGetOptions, opts ...grpc.CallOption) (*SafeItem, error)
 	SafeGetSV(ctx context.Context, in *SafeGetOptions, opts ...grpc.CallOption) (*SafeStructuredItem, error)
+	// SetBatch commits the entries in a single transaction, half of which is taken by the tree leaves
+	// committed with them: a batch holds at most the maximum number of entries or of key and value bytes of the store,
+	// by default about 52k entries and 4.8 MB, and fails with ResourceExhausted otherwise.
 	SetBatch(ctx context.Context, in *KVList, opts ...grpc.CallOption) (*Index, error)
 	SetBatchSV(ctx context.Context, in *SKVList, opts ...grpc.CallOption) (*Index, error)
+	// SetBatchStream receives the entries of a batch in chunks and commits them atomically once the stream is closed.
+	// It doesn't raise the transaction limits, only the message size limit: the batch must fit in a single transaction
+	// and fails with ResourceExhausted as soon as it reaches the maximum number of entries or of key and value bytes of
+	// the store, by default about 52k entries and 4.8 MB, the same as SetBatch.
+	SetBatchStream(ctx context.Context, opts ...grpc.CallOption) (ImmuService_SetBatchStreamClient, error)
 	GetBatch(ctx context.Context, in *KeyList, opts ...grpc.CallOption) (*ItemList, error)
 	GetBatchSV(ctx context.Context, in *KeyList, opts ...grpc.CallOption) (*StructuredItemList, error)
 	Scan(ctx context.Context, in *ScanOptions, opts ...grpc.CallOption) (*ItemList, error)
@@ -3110,6 +4706,11 @@ type ImmuServiceClient interface {
 	//		};
 	//	}
 	CreateDatabase(ctx context.Context, in *Database, opts ...grpc.CallOption) (*CreateDatabaseReply, error)
+	CreateDatabaseFromTemplate(ctx context.Context, in *DatabaseTemplateRequest, opts ...grpc.CallOption) (*CreateDatabaseReply, error)
+	DeleteDatabase(ctx context.Context, in *Database, opts ...grpc.CallOption) (*DeletedDatabase, error)
+	RestoreDatabase(ctx context.Context, in *Database, opts ...grpc.CallOption) (*empty.Empty, error)
+	PurgeDatabase(ctx context.Context, in *Database, opts ...grpc.CallOption) (*empty.Empty, error)
+	DeletedDatabaseList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DeletedDatabaseList, error)
 	UseDatabase(ctx context.Context, in *Database, opts ...grpc.CallOption) (*UseDatabaseReply, error)
 	ChangePermission(ctx context.Context, in *ChangePermissionRequest, opts ...grpc.CallOption) (*Error, error)
 	SetActiveUser(ctx context.Context, in *SetActiveUserRequest, opts ...grpc.CallOption) (*empty.Empty, error)
@@ -3117,10 +4718,10 @@ type ImmuServiceClient interface {
 }
 
 type immuServiceClient struct {
-	cc *grpc.ClientConn
+	cc grpc.ClientConnInterface
 }
 
-func NewImmuServiceClient(cc *grpc.ClientConn) ImmuServiceClient {
+func NewImmuServiceClient(cc grpc.ClientConnInterface) ImmuServiceClient {
 	return &immuServiceClient{cc}
 }
 
@@ -3205,6 +4806,83 @@ func (c *immuServiceClient) PrintTree(ctx context.Context, in *empty.Empty, opts
 	return out, nil
 }
 
+func (c *immuServiceClient) Report(ctx context.Context, in *ReportOptions, opts ...grpc.CallOption) (*StoreReport, error) {
+	out := new(StoreReport)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/Report", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) Usage(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*UsageList, error) {
+	out := new(UsageList)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/Usage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) OnBehalfOfRecords(ctx context.Context, in *OnBehalfOfRequest, opts ...grpc.CallOption) (*OnBehalfOfList, error) {
+	out := new(OnBehalfOfList)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/OnBehalfOfRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) StartupProgress(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*StartupProgress, error) {
+	out := new(StartupProgress)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/StartupProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) Logs(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (ImmuService_LogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ImmuService_serviceDesc.Streams[0], "/immudb.schema.ImmuService/Logs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &immuServiceLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ImmuService_LogsClient interface {
+	Recv() (*LogLine, error)
+	grpc.ClientStream
+}
+
+type immuServiceLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *immuServiceLogsClient) Recv() (*LogLine, error) {
+	m := new(LogLine)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *immuServiceClient) BulkLoad(ctx context.Context, in *BulkLoadRequest, opts ...grpc.CallOption) (*BulkLoadReply, error) {
+	out := new(BulkLoadReply)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/BulkLoad", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *immuServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
 	out := new(LoginResponse)
 	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/Login", in, out, opts...)
@@ -3277,6 +4955,15 @@ func (c *immuServiceClient) GetSV(ctx context.Context, in *Key, opts ...grpc.Cal
 	return out, nil
 }
 
+func (c *immuServiceClient) GetCodecs(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Codecs, error) {
+	out := new(Codecs)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/GetCodecs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *immuServiceClient) SafeGet(ctx context.Context, in *SafeGetOptions, opts ...grpc.CallOption) (*SafeItem, error) {
 	out := new(SafeItem)
 	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/SafeGet", in, out, opts...)
@@ -3313,6 +5000,40 @@ func (c *immuServiceClient) SetBatchSV(ctx context.Context, in *SKVList, opts ..
 	return out, nil
 }
 
+func (c *immuServiceClient) SetBatchStream(ctx context.Context, opts ...grpc.CallOption) (ImmuService_SetBatchStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ImmuService_serviceDesc.Streams[1], "/immudb.schema.ImmuService/SetBatchStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &immuServiceSetBatchStreamClient{stream}
+	return x, nil
+}
+
+type ImmuService_SetBatchStreamClient interface {
+	Send(*KVList) error
+	CloseAndRecv() (*Index, error)
+	grpc.ClientStream
+}
+
+type immuServiceSetBatchStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *immuServiceSetBatchStreamClient) Send(m *KVList) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *immuServiceSetBatchStreamClient) CloseAndRecv() (*Index, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Index)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *immuServiceClient) GetBatch(ctx context.Context, in *KeyList, opts ...grpc.CallOption) (*ItemList, error) {
 	out := new(ItemList)
 	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/GetBatch", in, out, opts...)
@@ -3512,7 +5233,7 @@ func (c *immuServiceClient) IScanSV(ctx context.Context, in *IScanOptions, opts
 }
 
 func (c *immuServiceClient) Dump(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (ImmuService_DumpClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ImmuService_serviceDesc.Streams[0], "/immudb.schema.ImmuService/Dump", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ImmuService_serviceDesc.Streams[2], "/immudb.schema.ImmuService/Dump", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3552,6 +5273,51 @@ func (c *immuServiceClient) CreateDatabase(ctx context.Context, in *Database, op
 	return out, nil
 }
 
+func (c *immuServiceClient) CreateDatabaseFromTemplate(ctx context.Context, in *DatabaseTemplateRequest, opts ...grpc.CallOption) (*CreateDatabaseReply, error) {
+	out := new(CreateDatabaseReply)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/CreateDatabaseFromTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) DeleteDatabase(ctx context.Context, in *Database, opts ...grpc.CallOption) (*DeletedDatabase, error) {
+	out := new(DeletedDatabase)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/DeleteDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) RestoreDatabase(ctx context.Context, in *Database, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/RestoreDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) PurgeDatabase(ctx context.Context, in *Database, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/PurgeDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) DeletedDatabaseList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DeletedDatabaseList, error) {
+	out := new(DeletedDatabaseList)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/DeletedDatabaseList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *immuServiceClient) UseDatabase(ctx context.Context, in *Database, opts ...grpc.CallOption) (*UseDatabaseReply, error) {
 	out := new(UseDatabaseReply)
 	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/UseDatabase", in, out, opts...)
@@ -3599,6 +5365,18 @@ type ImmuServiceServer interface {
 	UpdateAuthConfig(context.Context, *AuthConfig) (*empty.Empty, error)
 	UpdateMTLSConfig(context.Context, *MTLSConfig) (*empty.Empty, error)
 	PrintTree(context.Context, *empty.Empty) (*Tree, error)
+	Report(context.Context, *ReportOptions) (*StoreReport, error)
+	// Usage reports the operations and written bytes of each user, persisted in the system database across restarts
+	Usage(context.Context, *empty.Empty) (*UsageList, error)
+	// OnBehalfOfRecords lists the writes executed on behalf of end users, in database and index order
+	OnBehalfOfRecords(context.Context, *OnBehalfOfRequest) (*OnBehalfOfList, error)
+	// StartupProgress reports the progress of the databases loading at startup, in tree entries while they are replayed,
+	// and how long it took once done. Until then it is served without authentication and the other methods wait.
+	StartupProgress(context.Context, *empty.Empty) (*StartupProgress, error)
+	// Logs streams the recent lines logged by the server, and the new ones when following
+	Logs(*LogRequest, ImmuService_LogsServer) error
+	// BulkLoad ingests a checksummed bulk load file found on the server file system into the selected database
+	BulkLoad(context.Context, *BulkLoadRequest) (*BulkLoadReply, error)
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
 	Logout(context.Context, *empty.Empty) (*empty.Empty, error)
 	Set(context.Context, *KeyValue) (*Index, error)
@@ -3607,10 +5385,19 @@ type ImmuServiceServer interface {
 	SafeSetSV(context.Context, *SafeSetSVOptions) (*Proof, error)
 	Get(context.Context, *Key) (*Item, error)
 	GetSV(context.Context, *Key) (*StructuredItem, error)
+	GetCodecs(context.Context, *Key) (*Codecs, error)
 	SafeGet(context.Context, *SafeGetOptions) (*SafeItem, error)
 	SafeGetSV(context.Context, *SafeGetOptions) (*SafeStructuredItem, error)
+	// SetBatch commits the entries in a single transaction, half of which is taken by the tree leaves
+	// committed with them: a batch holds at most the maximum number of entries or of key and value bytes of the store,
+	// by default about 52k entries and 4.8 MB, and fails with ResourceExhausted otherwise.
 	SetBatch(context.Context, *KVList) (*Index, error)
 	SetBatchSV(context.Context, *SKVList) (*Index, error)
+	// SetBatchStream receives the entries of a batch in chunks and commits them atomically once the stream is closed.
+	// It doesn't raise the transaction limits, only the message size limit: the batch must fit in a single transaction
+	// and fails with ResourceExhausted as soon as it reaches the maximum number of entries or of key and value bytes of
+	// the store, by default about 52k entries and 4.8 MB, the same as SetBatch.
+	SetBatchStream(ImmuService_SetBatchStreamServer) error
 	GetBatch(context.Context, *KeyList) (*ItemList, error)
 	GetBatchSV(context.Context, *KeyList) (*StructuredItemList, error)
 	Scan(context.Context, *ScanOptions) (*ItemList, error)
@@ -3642,6 +5429,11 @@ type ImmuServiceServer interface {
 	//		};
 	//	}
 	CreateDatabase(context.Context, *Database) (*CreateDatabaseReply, error)
+	CreateDatabaseFromTemplate(context.Context, *DatabaseTemplateRequest) (*CreateDatabaseReply, error)
+	DeleteDatabase(context.Context, *Database) (*DeletedDatabase, error)
+	RestoreDatabase(context.Context, *Database) (*empty.Empty, error)
+	PurgeDatabase(context.Context, *Database) (*empty.Empty, error)
+	DeletedDatabaseList(context.Context, *empty.Empty) (*DeletedDatabaseList, error)
 	UseDatabase(context.Context, *Database) (*UseDatabaseReply, error)
 	ChangePermission(context.Context, *ChangePermissionRequest) (*Error, error)
 	SetActiveUser(context.Context, *SetActiveUserRequest) (*empty.Empty, error)
@@ -3679,6 +5471,24 @@ func (*UnimplementedImmuServiceServer) UpdateMTLSConfig(ctx context.Context, req
 func (*UnimplementedImmuServiceServer) PrintTree(ctx context.Context, req *empty.Empty) (*Tree, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PrintTree not implemented")
 }
+func (*UnimplementedImmuServiceServer) Report(ctx context.Context, req *ReportOptions) (*StoreReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Report not implemented")
+}
+func (*UnimplementedImmuServiceServer) Usage(ctx context.Context, req *empty.Empty) (*UsageList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Usage not implemented")
+}
+func (*UnimplementedImmuServiceServer) OnBehalfOfRecords(ctx context.Context, req *OnBehalfOfRequest) (*OnBehalfOfList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnBehalfOfRecords not implemented")
+}
+func (*UnimplementedImmuServiceServer) StartupProgress(ctx context.Context, req *empty.Empty) (*StartupProgress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartupProgress not implemented")
+}
+func (*UnimplementedImmuServiceServer) Logs(req *LogRequest, srv ImmuService_LogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Logs not implemented")
+}
+func (*UnimplementedImmuServiceServer) BulkLoad(ctx context.Context, req *BulkLoadRequest) (*BulkLoadReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkLoad not implemented")
+}
 func (*UnimplementedImmuServiceServer) Login(ctx context.Context, req *LoginRequest) (*LoginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
 }
@@ -3703,6 +5513,9 @@ func (*UnimplementedImmuServiceServer) Get(ctx context.Context, req *Key) (*Item
 func (*UnimplementedImmuServiceServer) GetSV(ctx context.Context, req *Key) (*StructuredItem, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSV not implemented")
 }
+func (*UnimplementedImmuServiceServer) GetCodecs(ctx context.Context, req *Key) (*Codecs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCodecs not implemented")
+}
 func (*UnimplementedImmuServiceServer) SafeGet(ctx context.Context, req *SafeGetOptions) (*SafeItem, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SafeGet not implemented")
 }
@@ -3715,6 +5528,9 @@ func (*UnimplementedImmuServiceServer) SetBatch(ctx context.Context, req *KVList
 func (*UnimplementedImmuServiceServer) SetBatchSV(ctx context.Context, req *SKVList) (*Index, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetBatchSV not implemented")
 }
+func (*UnimplementedImmuServiceServer) SetBatchStream(srv ImmuService_SetBatchStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method SetBatchStream not implemented")
+}
 func (*UnimplementedImmuServiceServer) GetBatch(ctx context.Context, req *KeyList) (*ItemList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBatch not implemented")
 }
@@ -3787,6 +5603,21 @@ func (*UnimplementedImmuServiceServer) Dump(req *empty.Empty, srv ImmuService_Du
 func (*UnimplementedImmuServiceServer) CreateDatabase(ctx context.Context, req *Database) (*CreateDatabaseReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
 }
+func (*UnimplementedImmuServiceServer) CreateDatabaseFromTemplate(ctx context.Context, req *DatabaseTemplateRequest) (*CreateDatabaseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabaseFromTemplate not implemented")
+}
+func (*UnimplementedImmuServiceServer) DeleteDatabase(ctx context.Context, req *Database) (*DeletedDatabase, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDatabase not implemented")
+}
+func (*UnimplementedImmuServiceServer) RestoreDatabase(ctx context.Context, req *Database) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreDatabase not implemented")
+}
+func (*UnimplementedImmuServiceServer) PurgeDatabase(ctx context.Context, req *Database) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDatabase not implemented")
+}
+func (*UnimplementedImmuServiceServer) DeletedDatabaseList(ctx context.Context, req *empty.Empty) (*DeletedDatabaseList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletedDatabaseList not implemented")
+}
 func (*UnimplementedImmuServiceServer) UseDatabase(ctx context.Context, req *Database) (*UseDatabaseReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UseDatabase not implemented")
 }
@@ -3868,100 +5699,211 @@ func _ImmuService_ChangePassword_Handler(srv interface{}, ctx context.Context, d
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/immudb.schema.ImmuService/ChangePassword",
+		FullMethod: "/immudb.schema.ImmuService/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_SetPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Item)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).SetPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/SetPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).SetPermission(ctx, req.(*Item))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_DeactivateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).DeactivateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/DeactivateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).DeactivateUser(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_UpdateAuthConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).UpdateAuthConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/UpdateAuthConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).UpdateAuthConfig(ctx, req.(*AuthConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_UpdateMTLSConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MTLSConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).UpdateMTLSConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/UpdateMTLSConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).UpdateMTLSConfig(ctx, req.(*MTLSConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_PrintTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).PrintTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/PrintTree",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ImmuServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+		return srv.(ImmuServiceServer).PrintTree(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ImmuService_SetPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Item)
+func _ImmuService_Report_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportOptions)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ImmuServiceServer).SetPermission(ctx, in)
+		return srv.(ImmuServiceServer).Report(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/immudb.schema.ImmuService/SetPermission",
+		FullMethod: "/immudb.schema.ImmuService/Report",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ImmuServiceServer).SetPermission(ctx, req.(*Item))
+		return srv.(ImmuServiceServer).Report(ctx, req.(*ReportOptions))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ImmuService_DeactivateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserRequest)
+func _ImmuService_Usage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ImmuServiceServer).DeactivateUser(ctx, in)
+		return srv.(ImmuServiceServer).Usage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/immudb.schema.ImmuService/DeactivateUser",
+		FullMethod: "/immudb.schema.ImmuService/Usage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ImmuServiceServer).DeactivateUser(ctx, req.(*UserRequest))
+		return srv.(ImmuServiceServer).Usage(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ImmuService_UpdateAuthConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthConfig)
+func _ImmuService_OnBehalfOfRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnBehalfOfRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ImmuServiceServer).UpdateAuthConfig(ctx, in)
+		return srv.(ImmuServiceServer).OnBehalfOfRecords(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/immudb.schema.ImmuService/UpdateAuthConfig",
+		FullMethod: "/immudb.schema.ImmuService/OnBehalfOfRecords",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ImmuServiceServer).UpdateAuthConfig(ctx, req.(*AuthConfig))
+		return srv.(ImmuServiceServer).OnBehalfOfRecords(ctx, req.(*OnBehalfOfRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ImmuService_UpdateMTLSConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MTLSConfig)
+func _ImmuService_StartupProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ImmuServiceServer).UpdateMTLSConfig(ctx, in)
+		return srv.(ImmuServiceServer).StartupProgress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/immudb.schema.ImmuService/UpdateMTLSConfig",
+		FullMethod: "/immudb.schema.ImmuService/StartupProgress",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ImmuServiceServer).UpdateMTLSConfig(ctx, req.(*MTLSConfig))
+		return srv.(ImmuServiceServer).StartupProgress(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ImmuService_PrintTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _ImmuService_Logs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ImmuServiceServer).Logs(m, &immuServiceLogsServer{stream})
+}
+
+type ImmuService_LogsServer interface {
+	Send(*LogLine) error
+	grpc.ServerStream
+}
+
+type immuServiceLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *immuServiceLogsServer) Send(m *LogLine) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ImmuService_BulkLoad_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkLoadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ImmuServiceServer).PrintTree(ctx, in)
+		return srv.(ImmuServiceServer).BulkLoad(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/immudb.schema.ImmuService/PrintTree",
+		FullMethod: "/immudb.schema.ImmuService/BulkLoad",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ImmuServiceServer).PrintTree(ctx, req.(*empty.Empty))
+		return srv.(ImmuServiceServer).BulkLoad(ctx, req.(*BulkLoadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -4110,6 +6052,24 @@ func _ImmuService_GetSV_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImmuService_GetCodecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).GetCodecs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/GetCodecs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).GetCodecs(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ImmuService_SafeGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SafeGetOptions)
 	if err := dec(in); err != nil {
@@ -4182,6 +6142,32 @@ func _ImmuService_SetBatchSV_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImmuService_SetBatchStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ImmuServiceServer).SetBatchStream(&immuServiceSetBatchStreamServer{stream})
+}
+
+type ImmuService_SetBatchStreamServer interface {
+	SendAndClose(*Index) error
+	Recv() (*KVList, error)
+	grpc.ServerStream
+}
+
+type immuServiceSetBatchStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *immuServiceSetBatchStreamServer) SendAndClose(m *Index) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *immuServiceSetBatchStreamServer) Recv() (*KVList, error) {
+	m := new(KVList)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _ImmuService_GetBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(KeyList)
 	if err := dec(in); err != nil {
@@ -4617,6 +6603,96 @@ func _ImmuService_CreateDatabase_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImmuService_CreateDatabaseFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DatabaseTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).CreateDatabaseFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/CreateDatabaseFromTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).CreateDatabaseFromTemplate(ctx, req.(*DatabaseTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_DeleteDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Database)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).DeleteDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/DeleteDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).DeleteDatabase(ctx, req.(*Database))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_RestoreDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Database)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).RestoreDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/RestoreDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).RestoreDatabase(ctx, req.(*Database))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_PurgeDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Database)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).PurgeDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/PurgeDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).PurgeDatabase(ctx, req.(*Database))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_DeletedDatabaseList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).DeletedDatabaseList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/DeletedDatabaseList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).DeletedDatabaseList(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ImmuService_UseDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Database)
 	if err := dec(in); err != nil {
@@ -4729,6 +6805,26 @@ var _ImmuService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PrintTree",
 			Handler:    _ImmuService_PrintTree_Handler,
 		},
+		{
+			MethodName: "Report",
+			Handler:    _ImmuService_Report_Handler,
+		},
+		{
+			MethodName: "Usage",
+			Handler:    _ImmuService_Usage_Handler,
+		},
+		{
+			MethodName: "OnBehalfOfRecords",
+			Handler:    _ImmuService_OnBehalfOfRecords_Handler,
+		},
+		{
+			MethodName: "StartupProgress",
+			Handler:    _ImmuService_StartupProgress_Handler,
+		},
+		{
+			MethodName: "BulkLoad",
+			Handler:    _ImmuService_BulkLoad_Handler,
+		},
 		{
 			MethodName: "Login",
 			Handler:    _ImmuService_Login_Handler,
@@ -4761,6 +6857,10 @@ var _ImmuService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSV",
 			Handler:    _ImmuService_GetSV_Handler,
 		},
+		{
+			MethodName: "GetCodecs",
+			Handler:    _ImmuService_GetCodecs_Handler,
+		},
 		{
 			MethodName: "SafeGet",
 			Handler:    _ImmuService_SafeGet_Handler,
@@ -4869,6 +6969,26 @@ var _ImmuService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateDatabase",
 			Handler:    _ImmuService_CreateDatabase_Handler,
 		},
+		{
+			MethodName: "CreateDatabaseFromTemplate",
+			Handler:    _ImmuService_CreateDatabaseFromTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteDatabase",
+			Handler:    _ImmuService_DeleteDatabase_Handler,
+		},
+		{
+			MethodName: "RestoreDatabase",
+			Handler:    _ImmuService_RestoreDatabase_Handler,
+		},
+		{
+			MethodName: "PurgeDatabase",
+			Handler:    _ImmuService_PurgeDatabase_Handler,
+		},
+		{
+			MethodName: "DeletedDatabaseList",
+			Handler:    _ImmuService_DeletedDatabaseList_Handler,
+		},
 		{
 			MethodName: "UseDatabase",
 			Handler:    _ImmuService_UseDatabase_Handler,
@@ -4887,6 +7007,16 @@ var _ImmuService_serviceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Logs",
+			Handler:       _ImmuService_Logs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SetBatchStream",
+			Handler:       _ImmuService_SetBatchStream_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "Dump",
 			Handler:       _ImmuService_Dump_Handler,
@@ -4895,3 +7025,147 @@ var _ImmuService_serviceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "schema.proto",
 }
+
+// ImmuAuthorizerClient is the client API for ImmuAuthorizer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ImmuAuthorizerClient interface {
+	Authorize(ctx context.Context, in *AuthorizationRequest, opts ...grpc.CallOption) (*AuthorizationResponse, error)
+}
+
+type immuAuthorizerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewImmuAuthorizerClient(cc grpc.ClientConnInterface) ImmuAuthorizerClient {
+	return &immuAuthorizerClient{cc}
+}
+
+func (c *immuAuthorizerClient) Authorize(ctx context.Context, in *AuthorizationRequest, opts ...grpc.CallOption) (*AuthorizationResponse, error) {
+	out := new(AuthorizationResponse)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuAuthorizer/Authorize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ImmuAuthorizerServer is the server API for ImmuAuthorizer service.
+type ImmuAuthorizerServer interface {
+	Authorize(context.Context, *AuthorizationRequest) (*AuthorizationResponse, error)
+}
+
+// UnimplementedImmuAuthorizerServer can be embedded to have forward compatible implementations.
+type UnimplementedImmuAuthorizerServer struct {
+}
+
+func (*UnimplementedImmuAuthorizerServer) Authorize(ctx context.Context, req *AuthorizationRequest) (*AuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authorize not implemented")
+}
+
+func RegisterImmuAuthorizerServer(s *grpc.Server, srv ImmuAuthorizerServer) {
+	s.RegisterService(&_ImmuAuthorizer_serviceDesc, srv)
+}
+
+func _ImmuAuthorizer_Authorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuAuthorizerServer).Authorize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuAuthorizer/Authorize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuAuthorizerServer).Authorize(ctx, req.(*AuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ImmuAuthorizer_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "immudb.schema.ImmuAuthorizer",
+	HandlerType: (*ImmuAuthorizerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Authorize",
+			Handler:    _ImmuAuthorizer_Authorize_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "schema.proto",
+}
+
+// ImmuValidatorClient is the client API for ImmuValidator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ImmuValidatorClient interface {
+	Validate(ctx context.Context, in *ValidationRequest, opts ...grpc.CallOption) (*ValidationResponse, error)
+}
+
+type immuValidatorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewImmuValidatorClient(cc grpc.ClientConnInterface) ImmuValidatorClient {
+	return &immuValidatorClient{cc}
+}
+
+func (c *immuValidatorClient) Validate(ctx context.Context, in *ValidationRequest, opts ...grpc.CallOption) (*ValidationResponse, error) {
+	out := new(ValidationResponse)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuValidator/Validate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ImmuValidatorServer is the server API for ImmuValidator service.
+type ImmuValidatorServer interface {
+	Validate(context.Context, *ValidationRequest) (*ValidationResponse, error)
+}
+
+// UnimplementedImmuValidatorServer can be embedded to have forward compatible implementations.
+type UnimplementedImmuValidatorServer struct {
+}
+
+func (*UnimplementedImmuValidatorServer) Validate(ctx context.Context, req *ValidationRequest) (*ValidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validate not implemented")
+}
+
+func RegisterImmuValidatorServer(s *grpc.Server, srv ImmuValidatorServer) {
+	s.RegisterService(&_ImmuValidator_serviceDesc, srv)
+}
+
+func _ImmuValidator_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuValidatorServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuValidator/Validate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuValidatorServer).Validate(ctx, req.(*ValidationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ImmuValidator_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "immudb.schema.ImmuValidator",
+	HandlerType: (*ImmuValidatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Validate",
+			Handler:    _ImmuValidator_Validate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "schema.proto",
+}
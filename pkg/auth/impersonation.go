@@ -0,0 +1,43 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// OnBehalfOfHeader is the request metadata key carrying the end user a service account is acting for
+const OnBehalfOfHeader = "immudb-on-behalf-of"
+
+// WithOnBehalfOf returns a client context whose requests are executed on behalf of the given end user
+func WithOnBehalfOf(ctx context.Context, username string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, OnBehalfOfHeader, username)
+}
+
+// GetOnBehalfOf returns the end user the request is executed for, or an empty string if there is none
+func GetOnBehalfOf(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if v := md.Get(OnBehalfOfHeader); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
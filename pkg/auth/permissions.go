@@ -31,40 +31,53 @@ const (
 
 var methodsPermissions = map[string][]uint32{
 	// readwrite methods
-	"Set":           {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"Get":           {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
-	"SetSV":         {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"SafeSet":       {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"SafeGet":       {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
-	"SafeSetSV":     {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"SetBatch":      {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"SetBatchSV":    {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"Reference":     {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"SafeReference": {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"ZAdd":          {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"SafeZAdd":      {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"ZScan":         {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
-	"BySafeIndex":   {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
-	"IScan":         {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
-	"History":       {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
-	"ByIndex":       {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
-	"Count":         {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
-	"DatabaseList":  {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"Set":            {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"Get":            {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"SetSV":          {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"SafeSet":        {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"SafeGet":        {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"SafeSetSV":      {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"SetBatch":       {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"SetBatchSV":     {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"SetBatchStream": {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"Reference":      {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"SafeReference":  {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"ZAdd":           {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"SafeZAdd":       {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"ZScan":          {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"BySafeIndex":    {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"IScan":          {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"History":        {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"ByIndex":        {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"Count":          {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"DatabaseList":   {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"GetCodecs":      {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 
 	// admin methods
-	"ListUsers":        {PermissionSysAdmin, PermissionAdmin},
-	"CreateUser":       {PermissionSysAdmin, PermissionAdmin},
-	"ChangePassword":   {PermissionSysAdmin, PermissionAdmin},
-	"SetPermission":    {PermissionSysAdmin, PermissionAdmin},
-	"DeactivateUser":   {PermissionSysAdmin, PermissionAdmin},
-	"SetActiveUser":    {PermissionSysAdmin, PermissionAdmin},
-	"UpdateAuthConfig": {PermissionSysAdmin},
-	"UpdateMTLSConfig": {PermissionSysAdmin},
-	"CreateDatabase":   {PermissionSysAdmin},
-	"PrintTree":        {PermissionSysAdmin},
-	"Dump":             {PermissionSysAdmin, PermissionAdmin},
-	"Consistency":      {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
-	"CurrentRoot":      {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"ListUsers":                  {PermissionSysAdmin, PermissionAdmin},
+	"CreateUser":                 {PermissionSysAdmin, PermissionAdmin},
+	"ChangePassword":             {PermissionSysAdmin, PermissionAdmin},
+	"SetPermission":              {PermissionSysAdmin, PermissionAdmin},
+	"DeactivateUser":             {PermissionSysAdmin, PermissionAdmin},
+	"SetActiveUser":              {PermissionSysAdmin, PermissionAdmin},
+	"UpdateAuthConfig":           {PermissionSysAdmin},
+	"UpdateMTLSConfig":           {PermissionSysAdmin},
+	"CreateDatabase":             {PermissionSysAdmin},
+	"CreateDatabaseFromTemplate": {PermissionSysAdmin},
+	"DeleteDatabase":             {PermissionSysAdmin},
+	"RestoreDatabase":            {PermissionSysAdmin},
+	"PurgeDatabase":              {PermissionSysAdmin},
+	"DeletedDatabaseList":        {PermissionSysAdmin},
+	"PrintTree":                  {PermissionSysAdmin},
+	"Logs":                       {PermissionSysAdmin},
+	"Report":                     {PermissionSysAdmin, PermissionAdmin},
+	"BulkLoad":                   {PermissionSysAdmin, PermissionAdmin},
+	"Usage":                      {PermissionSysAdmin},
+	"OnBehalfOfRecords":          {PermissionSysAdmin},
+	"StartupProgress":            {PermissionSysAdmin, PermissionAdmin},
+	"Dump":                       {PermissionSysAdmin, PermissionAdmin},
+	"Consistency":                {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"CurrentRoot":                {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 }
 
 //HasPermissionForMethod checks if userPermission can access method name
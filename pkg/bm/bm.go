@@ -14,9 +14,13 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
+// Package bm runs benchmarks, either against a store or, through Op, against anything, e.g. an immudb server
+// through the client (see pkg/client/loadgen), recording the latency of every operation.
 package bm
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"runtime"
@@ -26,22 +30,49 @@ import (
 	"github.com/codenotary/immudb/pkg/store"
 )
 
+// ErrInvalidBenchmark is returned when the benchmark has neither Work nor Op, or Op has neither a number of
+// iterations nor a duration
+var ErrInvalidBenchmark = errors.New("invalid benchmark")
+
 // Bm benchmark
 type Bm struct {
 	CreateStore bool
 	Store       *store.Store
 	Name        string
+	// Concurrency is the number of goroutines running the iterations, 1 when not set
 	Concurrency int
 	Iterations  int
-	Before      func(bm *Bm)
-	After       func(bm *Bm)
-	Work        func(bm *Bm, start int, end int) error
+	// Duration limits the time Op is run for, when Iterations is 0 Op is run until Duration is over
+	Duration time.Duration
+	Before   func(bm *Bm)
+	After    func(bm *Bm)
+	// Work runs the iterations from start to end-1, it takes precedence over Op
+	Work func(bm *Bm, start int, end int) error
+	// Op runs the i-th iteration, worker is the index of the goroutine running it. Its latency is recorded and ctx
+	// is cancelled when the run is over, either because Duration elapsed or another iteration failed.
+	Op func(ctx context.Context, bm *Bm, worker int, i int) error
 }
 
-// Execute runs the benchmark
+// Execute runs the benchmark, exiting on the first error
 func (b *Bm) Execute() *BmResult {
-	var wg sync.WaitGroup
-	chunkSize := b.Iterations / b.Concurrency
+	result, err := b.Run(context.Background())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "\"%v\" error: %v\n", b.Name, err.Error())
+		os.Exit(1)
+	}
+	return result
+}
+
+// Run runs the benchmark, stopping at the first failed iteration whose error is returned along with the partial
+// result
+func (b *Bm) Run(ctx context.Context) (*BmResult, error) {
+	if b.Work == nil && (b.Op == nil || (b.Iterations <= 0 && b.Duration <= 0)) {
+		return nil, ErrInvalidBenchmark
+	}
+	concurrency := b.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
 	if b.Store == nil && b.CreateStore {
 		store, closer := makeStore()
 		b.Store = store
@@ -52,23 +83,66 @@ func (b *Bm) Execute() *BmResult {
 	if b.Before != nil {
 		b.Before(b)
 	}
+	if b.Work == nil && b.Duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, b.Duration)
+		defer cancel()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var errOnce sync.Once
+	var runErr error
+	fail := func(err error) {
+		errOnce.Do(func() {
+			runErr = err
+			cancel()
+		})
+	}
+	latency := NewLatencyRecorder()
+	chunkSize := b.Iterations / concurrency
 	startTime := time.Now()
-	for k := 0; k < b.Concurrency; k++ {
+	for k := 0; k < concurrency; k++ {
 		wg.Add(1)
 		go func(kk int) {
 			defer wg.Done()
-			start := kk * chunkSize
-			end := (kk + 1) * chunkSize
-			if err := b.Work(b, start, end); err != nil {
-				fmt.Fprintf(os.Stderr, "\"%v\" error: %v\n", b.Name, err.Error())
-				os.Exit(1)
+			if b.Work != nil {
+				if err := b.Work(b, kk*chunkSize, (kk+1)*chunkSize); err != nil {
+					fail(err)
+				}
+				return
+			}
+			first := kk * b.Iterations / concurrency
+			last := (kk+1)*b.Iterations/concurrency - 1
+			for i := first; b.Iterations <= 0 || i <= last; i++ {
+				if ctx.Err() != nil {
+					return
+				}
+				opStart := time.Now()
+				if err := b.Op(ctx, b, kk, i); err != nil {
+					if ctx.Err() == nil {
+						fail(fmt.Errorf("operation %d failed: %v", i, err))
+					}
+					// otherwise the operation was interrupted by the end of the run
+					return
+				}
+				latency.Record(time.Since(opStart))
 			}
 		}(k)
 	}
 	wg.Wait()
 	endTime := time.Now()
 	elapsed := float64(endTime.UnixNano()-startTime.UnixNano()) / (1000 * 1000 * 1000)
-	txnSec := float64(b.Iterations) / elapsed
+	stats := latency.Stats()
+	iterations := stats.Count
+	if b.Work != nil {
+		iterations = b.Iterations
+	}
+	var txnSec float64
+	if elapsed > 0 {
+		txnSec = float64(iterations) / elapsed
+	}
 	var memStatsBeforeGC runtime.MemStats
 	runtime.ReadMemStats(&memStatsBeforeGC)
 	if b.After != nil {
@@ -80,10 +154,13 @@ func (b *Bm) Execute() *BmResult {
 	runtime.ReadMemStats(&memStatsAfterGC)
 	return &BmResult{
 		Bm:                b,
+		Concurrency:       concurrency,
+		Iterations:        iterations,
 		Time:              elapsed,
 		Transactions:      txnSec,
+		Latency:           stats,
 		MemStatsBeforeRun: memStatsBeforeRun,
 		MemStatsBeforeGC:  memStatsBeforeGC,
 		MemStatsAfterGC:   memStatsAfterGC,
-	}
+	}, runErr
 }
@@ -23,9 +23,15 @@ import (
 
 // BmResult benchmark result
 type BmResult struct {
-	Bm           *Bm
+	Bm *Bm
+	// Concurrency and Iterations are those the benchmark ran with, Iterations counts the completed ones when it is
+	// limited by a duration
+	Concurrency  int
+	Iterations   int
 	Time         float64
 	Transactions float64
+	// Latency is recorded for the benchmarks running Op only
+	Latency LatencyStats
 
 	MemStatsBeforeRun runtime.MemStats
 	MemStatsBeforeGC  runtime.MemStats
@@ -33,7 +39,7 @@ type BmResult struct {
 }
 
 func (b BmResult) String() string {
-	return fmt.Sprintf(
+	s := fmt.Sprintf(
 		`
 Name:       %s
 Concurency: %d
@@ -44,8 +50,12 @@ Before Run: %dMB alloc, %dMB sys
 Before GC:  %dMB alloc, %dMB sys
 After GC:   %dMB alloc, %dMB sys
 `,
-		b.Bm.Name, b.Bm.Concurrency, b.Bm.Iterations, b.Time, b.Transactions,
+		b.Bm.Name, b.Concurrency, b.Iterations, b.Time, b.Transactions,
 		b.MemStatsBeforeRun.Alloc/1024/1024, b.MemStatsBeforeRun.Sys/1024/1024,
 		b.MemStatsBeforeGC.Alloc/1024/1024, b.MemStatsBeforeGC.Sys/1024/1024,
 		b.MemStatsAfterGC.Alloc/1024/1024, b.MemStatsAfterGC.Sys/1024/1024)
+	if b.Latency.Count > 0 {
+		s += fmt.Sprintf("Latency:    %s\n", b.Latency)
+	}
+	return s
 }
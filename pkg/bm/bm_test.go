@@ -0,0 +1,111 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bm
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunWork(t *testing.T) {
+	b := &Bm{
+		CreateStore: true,
+		Name:        "work",
+		Concurrency: 2,
+		Iterations:  100,
+		Work: func(bm *Bm, start int, end int) error {
+			for i := start; i < end; i++ {
+				kv := schema.KeyValue{Key: []byte(strconv.Itoa(i)), Value: []byte{1}}
+				if _, err := bm.Store.Set(kv); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	r, err := b.Run(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 100, r.Iterations)
+	assert.Equal(t, 2, r.Concurrency)
+	assert.Equal(t, 0, r.Latency.Count)
+	assert.NotContains(t, r.String(), "Latency:")
+}
+
+func TestRunOp(t *testing.T) {
+	var done int64
+	b := &Bm{
+		Name:       "op",
+		Iterations: 100,
+		Op: func(ctx context.Context, bm *Bm, worker int, i int) error {
+			atomic.AddInt64(&done, 1)
+			return nil
+		},
+	}
+	r, err := b.Run(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), done)
+	assert.Equal(t, 100, r.Iterations)
+	assert.Equal(t, 1, r.Concurrency)
+	assert.Equal(t, 100, r.Latency.Count)
+	assert.Contains(t, r.String(), "Latency:")
+
+	// the operations interrupted by the end of the run aren't failures
+	b = &Bm{
+		Name:        "timed",
+		Concurrency: 2,
+		Duration:    100 * time.Millisecond,
+		Op: func(ctx context.Context, bm *Bm, worker int, i int) error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Millisecond):
+				return nil
+			}
+		},
+	}
+	r, err = b.Run(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, r.Iterations > 0)
+
+	failure := errors.New("failure")
+	b = &Bm{
+		Name:        "failing",
+		Concurrency: 2,
+		Iterations:  100,
+		Op: func(ctx context.Context, bm *Bm, worker int, i int) error {
+			if i == 10 {
+				return failure
+			}
+			return nil
+		},
+	}
+	r, err = b.Run(context.Background())
+	assert.EqualError(t, err, "operation 10 failed: failure")
+	assert.True(t, r.Iterations < 100)
+
+	_, err = (&Bm{Name: "invalid"}).Run(context.Background())
+	assert.Equal(t, ErrInvalidBenchmark, err)
+	_, err = (&Bm{Name: "invalid", Op: b.Op}).Run(context.Background())
+	assert.Equal(t, ErrInvalidBenchmark, err)
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bm
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// LatencyRecorder collects operation latencies, it is safe for concurrent use
+type LatencyRecorder struct {
+	mu      sync.Mutex
+	samples []time.Duration
+}
+
+// LatencyStats summarizes the recorded latencies
+type LatencyStats struct {
+	Count int
+	Min   time.Duration
+	Max   time.Duration
+	Mean  time.Duration
+	P50   time.Duration
+	P90   time.Duration
+	P99   time.Duration
+}
+
+func (s LatencyStats) String() string {
+	return fmt.Sprintf("min %s mean %s p50 %s p90 %s p99 %s max %s", s.Min, s.Mean, s.P50, s.P90, s.P99, s.Max)
+}
+
+// NewLatencyRecorder returns an empty recorder
+func NewLatencyRecorder() *LatencyRecorder {
+	return &LatencyRecorder{}
+}
+
+// Record adds the latency of an operation
+func (r *LatencyRecorder) Record(d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.samples = append(r.samples, d)
+}
+
+// Stats returns the summary of the latencies recorded so far
+func (r *LatencyRecorder) Stats() LatencyStats {
+	r.mu.Lock()
+	samples := append([]time.Duration{}, r.samples...)
+	r.mu.Unlock()
+
+	stats := LatencyStats{Count: len(samples)}
+	if len(samples) == 0 {
+		return stats
+	}
+	sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+	var total time.Duration
+	for _, d := range samples {
+		total += d
+	}
+	stats.Min = samples[0]
+	stats.Max = samples[len(samples)-1]
+	stats.Mean = total / time.Duration(len(samples))
+	stats.P50 = percentile(samples, 50)
+	stats.P90 = percentile(samples, 90)
+	stats.P99 = percentile(samples, 99)
+	return stats
+}
+
+// percentile returns the nearest rank percentile of sorted samples
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
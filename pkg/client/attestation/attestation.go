@@ -0,0 +1,145 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package attestation records attestations of container images and other OCI artifacts, identified by their
+// content digest, and verifies them later against the tamper proof history of immudb.
+package attestation
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/codenotary/immudb/pkg/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var attestationsPrefix = []byte("_attestations/")
+
+// ErrInvalidDigest is returned for digests not in the OCI algorithm:hex form, e.g. sha256:3f2a...
+var ErrInvalidDigest = errors.New("invalid digest, expected algorithm:hex")
+
+// ErrNotAttested is returned when no attestation has been recorded for the artifact
+var ErrNotAttested = errors.New("artifact not attested")
+
+// ErrNotVerified is returned when the attestation read back does not match its proof
+var ErrNotVerified = errors.New("attestation verification failed")
+
+// ErrMismatch is returned when the recorded attestation differs from the expected one
+var ErrMismatch = errors.New("attestation does not match")
+
+// Attestation describes how an artifact was produced
+type Attestation struct {
+	Digest      string            `json:"digest"`
+	Builder     string            `json:"builder,omitempty"`
+	SBOMDigest  string            `json:"sbomDigest,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Record is an attestation as stored in immudb
+type Record struct {
+	Attestation
+	Index    uint64
+	Time     uint64
+	Verified bool
+}
+
+func checkDigest(digest string) error {
+	i := strings.Index(digest, ":")
+	if i <= 0 || i == len(digest)-1 {
+		return ErrInvalidDigest
+	}
+	if _, err := hex.DecodeString(digest[i+1:]); err != nil {
+		return ErrInvalidDigest
+	}
+	return nil
+}
+
+func attestationKey(digest string) []byte {
+	return append(append([]byte{}, attestationsPrefix...), digest...)
+}
+
+// Attest records the attestation with a verified set, replacing the previous one of the same artifact in the
+// current view while keeping it in its history
+func Attest(ctx context.Context, c client.ImmuClient, a *Attestation) (*Record, error) {
+	if err := checkDigest(a.Digest); err != nil {
+		return nil, err
+	}
+	if a.SBOMDigest != "" {
+		if err := checkDigest(a.SBOMDigest); err != nil {
+			return nil, err
+		}
+	}
+	value, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	index, err := c.SafeSet(ctx, attestationKey(a.Digest), value)
+	if err != nil {
+		return nil, err
+	}
+	if !index.Verified {
+		return nil, ErrNotVerified
+	}
+	return &Record{Attestation: *a, Index: index.Index, Verified: true}, nil
+}
+
+// Verify reads back the latest attestation of the artifact checking its inclusion proof. When expected is not nil,
+// its non empty builder, SBOM digest and annotations must match the recorded ones.
+func Verify(ctx context.Context, c client.ImmuClient, digest string, expected *Attestation) (*Record, error) {
+	if err := checkDigest(digest); err != nil {
+		return nil, err
+	}
+	item, err := c.SafeGet(ctx, attestationKey(digest))
+	if status.Code(err) == codes.NotFound {
+		return nil, ErrNotAttested
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !item.Verified {
+		return nil, ErrNotVerified
+	}
+	r := &Record{Index: item.Index, Time: item.Time, Verified: true}
+	if err := json.Unmarshal(item.Value, &r.Attestation); err != nil {
+		return nil, err
+	}
+	if r.Digest != digest {
+		return nil, ErrMismatch
+	}
+	if expected != nil && !matches(&r.Attestation, expected) {
+		return r, ErrMismatch
+	}
+	return r, nil
+}
+
+func matches(a *Attestation, expected *Attestation) bool {
+	if expected.Builder != "" && expected.Builder != a.Builder {
+		return false
+	}
+	if expected.SBOMDigest != "" && expected.SBOMDigest != a.SBOMDigest {
+		return false
+	}
+	for k, v := range expected.Annotations {
+		if a.Annotations[k] != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package attestation
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/client/cache"
+	"github.com/codenotary/immudb/pkg/client/timestamp"
+	"github.com/codenotary/immudb/pkg/logger"
+	"github.com/codenotary/immudb/pkg/server"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufSize = 1024 * 1024
+
+func newClient(t *testing.T, dir string) client.ImmuClient {
+	lis := bufconn.Listen(bufSize)
+	is := server.DefaultServer()
+	is = is.WithOptions(is.Options.
+		WithAuth(false).
+		WithMetricsServer(false).
+		WithCorruptionCheck(false).
+		WithDir(filepath.Join(dir, "data")).
+		WithListener(lis))
+	go is.Start()
+
+	dialOptions := []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+	}
+	ic := client.DefaultClient().WithOptions(client.DefaultOptions().WithAuth(false).WithDialOptions(&dialOptions))
+	conn, err := ic.Connect(context.Background())
+	assert.NoError(t, err)
+	ic.WithClientConn(conn)
+	serviceClient := schema.NewImmuServiceClient(conn)
+	ic.WithServiceClient(serviceClient)
+	ic.WithRootService(client.NewRootService(serviceClient, cache.NewFileCache(dir), logger.NewSimpleLogger("test", os.Stdout)))
+	ts, err := timestamp.NewTdefault()
+	assert.NoError(t, err)
+	ic.WithTimestampService(client.NewTimestampService(ts))
+	return ic
+}
+
+func TestAttestation(t *testing.T) {
+	dir := "attestation_test"
+	defer os.RemoveAll(dir)
+	ctx := context.Background()
+	c := newClient(t, dir)
+
+	image := "sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	sbom := "sha256:60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752"
+
+	r, err := Attest(ctx, c, &Attestation{Digest: image, Builder: "ci", SBOMDigest: sbom, Annotations: map[string]string{"commit": "abc123"}})
+	assert.NoError(t, err)
+	assert.True(t, r.Verified)
+
+	r, err = Verify(ctx, c, image, nil)
+	assert.NoError(t, err)
+	assert.True(t, r.Verified)
+	assert.Equal(t, "ci", r.Builder)
+	assert.Equal(t, sbom, r.SBOMDigest)
+
+	_, err = Verify(ctx, c, image, &Attestation{Builder: "ci", Annotations: map[string]string{"commit": "abc123"}})
+	assert.NoError(t, err)
+	r, err = Verify(ctx, c, image, &Attestation{SBOMDigest: image})
+	assert.Equal(t, ErrMismatch, err)
+	assert.Equal(t, sbom, r.SBOMDigest)
+
+	_, err = Verify(ctx, c, sbom, nil)
+	assert.Equal(t, ErrNotAttested, err)
+	_, err = Attest(ctx, c, &Attestation{Digest: "latest"})
+	assert.Equal(t, ErrInvalidDigest, err)
+	_, err = Attest(ctx, c, &Attestation{Digest: image, SBOMDigest: "sha256:zz"})
+	assert.Equal(t, ErrInvalidDigest, err)
+}
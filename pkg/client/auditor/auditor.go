@@ -52,7 +52,7 @@ type defaultAuditor struct {
 	databases     []string
 	password      []byte
 	slugifyRegExp *regexp.Regexp
-	updateMetrics func(string, string, bool, bool, bool, *schema.Root, *schema.Root)
+	updateMetrics func(string, string, string, bool, bool, bool, *schema.Root, *schema.Root)
 }
 
 // DefaultAuditor creates initializes a default auditor implementation
@@ -63,7 +63,7 @@ func DefaultAuditor(
 	username string,
 	passwordBase64 string,
 	history cache.HistoryCache,
-	updateMetrics func(string, string, bool, bool, bool, *schema.Root, *schema.Root),
+	updateMetrics func(string, string, string, bool, bool, bool, *schema.Root, *schema.Root),
 	logoutput io.Writer) (Auditor, error) {
 
 	password, err := auth.DecodeBase64Password(passwordBase64)
@@ -127,11 +127,12 @@ func (a *defaultAuditor) audit() error {
 	checked := false
 	withError := false
 	serverID := "unknown"
+	dbName := ""
 	var prevRoot *schema.Root
 	var root *schema.Root
 	defer func() {
 		a.updateMetrics(
-			serverID, a.serverAddress, checked, withError, verified, prevRoot, root)
+			serverID, a.serverAddress, dbName, checked, withError, verified, prevRoot, root)
 	}()
 
 	// returning an error would completely stop the auditor process
@@ -160,7 +161,7 @@ func (a *defaultAuditor) audit() error {
 		}
 		a.databaseIndex = 0
 	}
-	dbName := a.databases[a.databaseIndex]
+	dbName = a.databases[a.databaseIndex]
 	resp, err := serviceClient.UseDatabase(ctx, &schema.Database{
 		Databasename: dbName,
 	})
@@ -79,7 +79,7 @@ func makeAuditor() (Auditor, error) {
 		"immudb",
 		"immudb",
 		cache.NewHistoryFileCache(dirname),
-		func(string, string, bool, bool, bool, *schema.Root, *schema.Root) {},
+		func(string, string, string, bool, bool, bool, *schema.Root, *schema.Root) {},
 		nil)
 	return da, err
 }
@@ -27,6 +27,7 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"strconv"
 	"sync"
 	"time"
 
@@ -41,6 +42,8 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/store"
@@ -63,20 +66,32 @@ type ImmuClient interface {
 	UpdateAuthConfig(ctx context.Context, kind auth.Kind) error
 	UpdateMTLSConfig(ctx context.Context, enabled bool) error
 	PrintTree(ctx context.Context) (*schema.Tree, error)
+	Report(ctx context.Context, prefixLength uint32) (*schema.StoreReport, error)
+	BulkLoad(ctx context.Context, filename string, resumeAfterBatches uint64) (*schema.BulkLoadReply, error)
+	Usage(ctx context.Context) (*schema.UsageList, error)
+	OnBehalfOfRecords(ctx context.Context, req *schema.OnBehalfOfRequest) (*schema.OnBehalfOfList, error)
+	StartupProgress(ctx context.Context) (*schema.StartupProgress, error)
+	Logs(ctx context.Context, req *schema.LogRequest, onLine func(*schema.LogLine) error) error
 	CurrentRoot(ctx context.Context) (*schema.Root, error)
 	Set(ctx context.Context, key []byte, value []byte) (*schema.Index, error)
+	SetIfChanged(ctx context.Context, key []byte, value []byte) (*schema.Index, error)
+	SetWithCodecs(ctx context.Context, key []byte, value []byte, codecs ...uint32) (*schema.Index, error)
+	SetWithLabels(ctx context.Context, key []byte, value []byte, labels map[string]string) (*schema.Index, error)
 	SafeSet(ctx context.Context, key []byte, value []byte) (*VerifiedIndex, error)
 	RawSafeSet(ctx context.Context, key []byte, value []byte) (*VerifiedIndex, error)
 	Get(ctx context.Context, key []byte) (*schema.StructuredItem, error)
+	GetCodecs(ctx context.Context, key []byte) (*schema.Codecs, error)
 	SafeGet(ctx context.Context, key []byte, opts ...grpc.CallOption) (*VerifiedItem, error)
 	RawSafeGet(ctx context.Context, key []byte, opts ...grpc.CallOption) (*VerifiedItem, error)
 	Scan(ctx context.Context, prefix []byte) (*schema.StructuredItemList, error)
+	ScanByLabels(ctx context.Context, prefix []byte, labels map[string]string) (*schema.StructuredItemList, error)
 	ZScan(ctx context.Context, set []byte) (*schema.StructuredItemList, error)
 	ByIndex(ctx context.Context, index uint64) (*schema.StructuredItem, error)
 	RawBySafeIndex(ctx context.Context, index uint64) (*VerifiedItem, error)
 	IScan(ctx context.Context, pageNumber uint64, pageSize uint64) (*schema.SPage, error)
 	Count(ctx context.Context, prefix []byte) (*schema.ItemsCount, error)
 	SetBatch(ctx context.Context, request *BatchRequest) (*schema.Index, error)
+	SetBatchStream(ctx context.Context, request *BatchRequest, chunkSize int) (*schema.Index, error)
 	GetBatch(ctx context.Context, keys [][]byte) (*schema.StructuredItemList, error)
 	Inclusion(ctx context.Context, index uint64) (*schema.InclusionProof, error)
 	Consistency(ctx context.Context, index uint64) (*schema.ConsistencyProof, error)
@@ -87,6 +102,7 @@ type ImmuClient interface {
 	SafeZAdd(ctx context.Context, set []byte, score float64, key []byte) (*VerifiedIndex, error)
 	Dump(ctx context.Context, writer io.WriteSeeker) (int64, error)
 	HealthCheck(ctx context.Context) error
+	ServerInfo(ctx context.Context) (*schema.HealthResponse, error)
 	verifyAndSetRoot(result *schema.Proof, root *schema.Root, ctx context.Context) (bool, error)
 
 	WithOptions(options *Options) *immuClient
@@ -99,6 +115,11 @@ type ImmuClient interface {
 	GetServiceClient() *schema.ImmuServiceClient
 	GetOptions() *Options
 	CreateDatabase(ctx context.Context, d *schema.Database) (*schema.CreateDatabaseReply, error)
+	CreateDatabaseFromTemplate(ctx context.Context, d *schema.DatabaseTemplateRequest) (*schema.CreateDatabaseReply, error)
+	DeleteDatabase(ctx context.Context, d *schema.Database) (*schema.DeletedDatabase, error)
+	RestoreDatabase(ctx context.Context, d *schema.Database) error
+	PurgeDatabase(ctx context.Context, d *schema.Database) error
+	DeletedDatabaseList(ctx context.Context) (*schema.DeletedDatabaseList, error)
 	UseDatabase(ctx context.Context, d *schema.Database) (*schema.UseDatabaseReply, error)
 	ChangePermission(ctx context.Context, d *schema.ChangePermissionRequest) (*schema.Error, error)
 	SetActiveUser(ctx context.Context, u *schema.SetActiveUserRequest) (*empty.Empty, error)
@@ -388,6 +409,101 @@ func (c *immuClient) PrintTree(ctx context.Context) (*schema.Tree, error) {
 	return tree, err
 }
 
+// Report returns a summary of the keys and values of the current database
+func (c *immuClient) Report(ctx context.Context, prefixLength uint32) (*schema.StoreReport, error) {
+	start := time.Now()
+	if !c.IsConnected() {
+		return nil, ErrNotConnected
+	}
+	report, err := c.ServiceClient.Report(ctx, &schema.ReportOptions{PrefixLength: prefixLength})
+	c.Logger.Debugf("report finished in %s", time.Since(start))
+	return report, err
+}
+
+// BulkLoad ingests into the current database a bulk load file found in the server bulk load directory, skipping
+// the batches committed by a previous interrupted load, as reported by BulkLoadProgress
+func (c *immuClient) BulkLoad(ctx context.Context, filename string, resumeAfterBatches uint64) (*schema.BulkLoadReply, error) {
+	start := time.Now()
+	if !c.IsConnected() {
+		return nil, ErrNotConnected
+	}
+	reply, err := c.ServiceClient.BulkLoad(ctx, &schema.BulkLoadRequest{Filename: filename, ResumeAfterBatches: resumeAfterBatches})
+	c.Logger.Debugf("bulk load finished in %s", time.Since(start))
+	return reply, err
+}
+
+// BulkLoadProgress returns what an interrupted bulk load committed before failing with err, nil when nothing was
+// committed
+func BulkLoadProgress(err error) *schema.BulkLoadReply {
+	for _, detail := range status.Convert(err).Details() {
+		if reply, ok := detail.(*schema.BulkLoadReply); ok {
+			return reply
+		}
+	}
+	return nil
+}
+
+// Usage returns the operations and written bytes of each user, it requires the sysadmin permission
+func (c *immuClient) Usage(ctx context.Context) (*schema.UsageList, error) {
+	start := time.Now()
+	if !c.IsConnected() {
+		return nil, ErrNotConnected
+	}
+	usages, err := c.ServiceClient.Usage(ctx, &empty.Empty{})
+	c.Logger.Debugf("usage finished in %s", time.Since(start))
+	return usages, err
+}
+
+// OnBehalfOfRecords returns the writes executed on behalf of end users, see auth.WithOnBehalfOf. It requires the
+// sysadmin permission
+func (c *immuClient) OnBehalfOfRecords(ctx context.Context, req *schema.OnBehalfOfRequest) (*schema.OnBehalfOfList, error) {
+	start := time.Now()
+	if !c.IsConnected() {
+		return nil, ErrNotConnected
+	}
+	records, err := c.ServiceClient.OnBehalfOfRecords(ctx, req)
+	c.Logger.Debugf("on-behalf-of-records finished in %s", time.Since(start))
+	return records, err
+}
+
+// StartupProgress returns how far the server is in loading its databases, or how long it took once ready
+func (c *immuClient) StartupProgress(ctx context.Context) (*schema.StartupProgress, error) {
+	start := time.Now()
+	if !c.IsConnected() {
+		return nil, ErrNotConnected
+	}
+	progress, err := c.ServiceClient.StartupProgress(ctx, &empty.Empty{})
+	c.Logger.Debugf("startup progress finished in %s", time.Since(start))
+	return progress, err
+}
+
+// Logs passes to onLine the recent lines logged by the server and, when following, the new ones until ctx is done.
+// An error returned by onLine stops the stream.
+func (c *immuClient) Logs(ctx context.Context, req *schema.LogRequest, onLine func(*schema.LogLine) error) error {
+	start := time.Now()
+	if !c.IsConnected() {
+		return ErrNotConnected
+	}
+	stream, err := c.ServiceClient.Logs(ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		line, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err = onLine(line); err != nil {
+			return err
+		}
+	}
+	c.Logger.Debugf("logs finished in %s", time.Since(start))
+	return nil
+}
+
 // Login ...
 func (c *immuClient) Login(ctx context.Context, user []byte, pass []byte) (*schema.LoginResponse, error) {
 	start := time.Now()
@@ -395,8 +511,9 @@ func (c *immuClient) Login(ctx context.Context, user []byte, pass []byte) (*sche
 		return nil, ErrNotConnected
 	}
 	result, err := c.ServiceClient.Login(ctx, &schema.LoginRequest{
-		User:     user,
-		Password: pass,
+		User:       user,
+		Password:   pass,
+		ApiVersion: schema.APIVersion,
 	})
 	c.Logger.Debugf("set finished in %s", time.Since(start))
 	return result, err
@@ -434,6 +551,20 @@ func (c *immuClient) Get(ctx context.Context, key []byte) (*schema.StructuredIte
 	return result, err
 }
 
+// GetCodecs returns the codecs the value of the key was encoded with when it was stored
+func (c *immuClient) GetCodecs(ctx context.Context, key []byte) (*schema.Codecs, error) {
+	start := time.Now()
+	if !c.IsConnected() {
+		return nil, ErrNotConnected
+	}
+	result, err := c.ServiceClient.GetCodecs(ctx, &schema.Key{Key: key})
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debugf("get codecs finished in %s", time.Since(start))
+	return result, err
+}
+
 // CurrentRoot returns current merkle tree root and index
 func (c *immuClient) CurrentRoot(ctx context.Context) (*schema.Root, error) {
 	start := time.Now()
@@ -574,6 +705,19 @@ func (c *immuClient) Scan(ctx context.Context, prefix []byte) (*schema.Structure
 	return list.ToSItemList()
 }
 
+// ScanByLabels returns the entries having the prefix and all the given labels. Labels are not part of the merkle
+// tree, so neither the labels of the entries nor the completeness of the result can be verified.
+func (c *immuClient) ScanByLabels(ctx context.Context, prefix []byte, labels map[string]string) (*schema.StructuredItemList, error) {
+	if !c.IsConnected() {
+		return nil, ErrNotConnected
+	}
+	list, err := c.ServiceClient.Scan(ctx, &schema.ScanOptions{Prefix: prefix, Labels: labels})
+	if err != nil {
+		return nil, err
+	}
+	return list.ToSItemList()
+}
+
 // ZScan ...
 func (c *immuClient) ZScan(ctx context.Context, set []byte) (*schema.StructuredItemList, error) {
 	if !c.IsConnected() {
@@ -625,6 +769,63 @@ func (c *immuClient) Set(ctx context.Context, key []byte, value []byte) (*schema
 	return result, err
 }
 
+// SetIfChanged is like Set but, when the current value of the key holds the same payload, nothing is written and the
+// index of the current entry is returned
+func (c *immuClient) SetIfChanged(ctx context.Context, key []byte, value []byte) (*schema.Index, error) {
+	start := time.Now()
+	if !c.IsConnected() {
+		return nil, ErrNotConnected
+	}
+	skv := c.NewSKV(key, value)
+	skv.SkipUnchanged = true
+	result, err := c.ServiceClient.SetSV(ctx, skv)
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debugf("set if changed finished in %s", time.Since(start))
+	return result, err
+}
+
+// SetWithCodecs is like Set but the server encodes the value with the given codecs, in order, before storing it.
+// Reads return the value as it was set.
+func (c *immuClient) SetWithCodecs(ctx context.Context, key []byte, value []byte, codecs ...uint32) (*schema.Index, error) {
+	start := time.Now()
+	if !c.IsConnected() {
+		return nil, ErrNotConnected
+	}
+	kv, err := c.NewSKV(key, value).ToKV()
+	if err != nil {
+		return nil, err
+	}
+	kv.Codecs = codecs
+	result, err := c.ServiceClient.Set(ctx, kv)
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debugf("set with codecs finished in %s", time.Since(start))
+	return result, err
+}
+
+// SetWithLabels is like Set but attaches the labels to the entry, see ScanByLabels. Only the key and the value are
+// added to the merkle tree: the labels are not covered by the proofs of SafeGet and the other safe operations.
+func (c *immuClient) SetWithLabels(ctx context.Context, key []byte, value []byte, labels map[string]string) (*schema.Index, error) {
+	start := time.Now()
+	if !c.IsConnected() {
+		return nil, ErrNotConnected
+	}
+	kv, err := c.NewSKV(key, value).ToKV()
+	if err != nil {
+		return nil, err
+	}
+	kv.Labels = labels
+	result, err := c.ServiceClient.Set(ctx, kv)
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debugf("set with labels finished in %s", time.Since(start))
+	return result, err
+}
+
 // SafeSet ...
 func (c *immuClient) SafeSet(ctx context.Context, key []byte, value []byte) (*VerifiedIndex, error) {
 	start := time.Now()
@@ -761,7 +962,8 @@ func (c *immuClient) RawSafeSet(ctx context.Context, key []byte, value []byte) (
 		nil
 }
 
-// SetBatch ...
+// SetBatch commits the entries in a single transaction, which holds by default about 52k entries and 4.8 MB of keys
+// and values on the server
 func (c *immuClient) SetBatch(ctx context.Context, request *BatchRequest) (*schema.Index, error) {
 	start := time.Now()
 	if !c.IsConnected() {
@@ -776,6 +978,40 @@ func (c *immuClient) SetBatch(ctx context.Context, request *BatchRequest) (*sche
 	return result, err
 }
 
+// SetBatchStream sends the batch to the server in chunks of at most chunkSize entries.
+// All the entries are committed atomically once the whole batch has been received, so the batch must fit in a
+// single transaction of the server store, by default about 52k entries and 4.8 MB of keys and values, as with SetBatch.
+// Streaming only avoids the gRPC message size limit.
+func (c *immuClient) SetBatchStream(ctx context.Context, request *BatchRequest, chunkSize int) (*schema.Index, error) {
+	start := time.Now()
+	if !c.IsConnected() {
+		return nil, ErrNotConnected
+	}
+	if chunkSize <= 0 {
+		return nil, ErrIllegalArguments
+	}
+	list, err := request.toKVList()
+	if err != nil {
+		return nil, err
+	}
+	stream, err := c.ServiceClient.SetBatchStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < len(list.KVs); i += chunkSize {
+		end := i + chunkSize
+		if end > len(list.KVs) {
+			end = len(list.KVs)
+		}
+		if err = stream.Send(&schema.KVList{KVs: list.KVs[i:end]}); err != nil {
+			return nil, err
+		}
+	}
+	result, err := stream.CloseAndRecv()
+	c.Logger.Debugf("set-batch-stream finished in %s", time.Since(start))
+	return result, err
+}
+
 // GetBatch ...
 func (c *immuClient) GetBatch(ctx context.Context, keys [][]byte) (*schema.StructuredItemList, error) {
 	start := time.Now()
@@ -1184,7 +1420,7 @@ func (c *immuClient) HealthCheck(ctx context.Context) error {
 	if !c.IsConnected() {
 		return ErrNotConnected
 	}
-	response, err := c.ServiceClient.Health(ctx, &empty.Empty{})
+	response, err := c.ServiceClient.Health(withAPIVersion(ctx), &empty.Empty{})
 	if err != nil {
 		return err
 	}
@@ -1195,6 +1431,24 @@ func (c *immuClient) HealthCheck(ctx context.Context) error {
 	return nil
 }
 
+// ServerInfo returns the server version, API version and capabilities, it doesn't require to be logged in.
+// Servers predating the API version handshake return 0 and no capabilities.
+func (c *immuClient) ServerInfo(ctx context.Context) (*schema.HealthResponse, error) {
+	start := time.Now()
+	if !c.IsConnected() {
+		return nil, ErrNotConnected
+	}
+	response, err := c.ServiceClient.Health(withAPIVersion(ctx), &empty.Empty{})
+	c.Logger.Debugf("server-info finished in %s", time.Since(start))
+	return response, err
+}
+
+// withAPIVersion sends the client API version to the calls whose request can't hold it, so that the server rejects
+// the versions it doesn't serve
+func withAPIVersion(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, schema.APIVersionHeader, strconv.FormatUint(uint64(schema.APIVersion), 10))
+}
+
 // todo(joe-dz): Enable restore when the feature is required again.
 // Also, make sure that the generated files are updated
 //func (c *immuClient) restoreChunk(ctx context.Context, kvList *pb.KVList) error {
@@ -1277,6 +1531,61 @@ func (c *immuClient) CreateDatabase(ctx context.Context, db *schema.Database) (*
 	return result, err
 }
 
+// CreateDatabaseFromTemplate create a new database from a template database by making a grpc call
+func (c *immuClient) CreateDatabaseFromTemplate(ctx context.Context, d *schema.DatabaseTemplateRequest) (*schema.CreateDatabaseReply, error) {
+	start := time.Now()
+	if !c.IsConnected() {
+		return nil, ErrNotConnected
+	}
+	result, err := c.ServiceClient.CreateDatabaseFromTemplate(ctx, d)
+	c.Logger.Debugf("CreateDatabaseFromTemplate finished in %s", time.Since(start))
+	return result, err
+}
+
+// DeleteDatabase soft deletes a database, it can be restored until it is purged
+func (c *immuClient) DeleteDatabase(ctx context.Context, d *schema.Database) (*schema.DeletedDatabase, error) {
+	start := time.Now()
+	if !c.IsConnected() {
+		return nil, ErrNotConnected
+	}
+	result, err := c.ServiceClient.DeleteDatabase(ctx, d)
+	c.Logger.Debugf("DeleteDatabase finished in %s", time.Since(start))
+	return result, err
+}
+
+// RestoreDatabase restores a deleted database
+func (c *immuClient) RestoreDatabase(ctx context.Context, d *schema.Database) error {
+	start := time.Now()
+	if !c.IsConnected() {
+		return ErrNotConnected
+	}
+	_, err := c.ServiceClient.RestoreDatabase(ctx, d)
+	c.Logger.Debugf("RestoreDatabase finished in %s", time.Since(start))
+	return err
+}
+
+// PurgeDatabase permanently drops a deleted database once its restore window is over
+func (c *immuClient) PurgeDatabase(ctx context.Context, d *schema.Database) error {
+	start := time.Now()
+	if !c.IsConnected() {
+		return ErrNotConnected
+	}
+	_, err := c.ServiceClient.PurgeDatabase(ctx, d)
+	c.Logger.Debugf("PurgeDatabase finished in %s", time.Since(start))
+	return err
+}
+
+// DeletedDatabaseList returns the deleted databases which can still be restored
+func (c *immuClient) DeletedDatabaseList(ctx context.Context) (*schema.DeletedDatabaseList, error) {
+	start := time.Now()
+	if !c.IsConnected() {
+		return nil, ErrNotConnected
+	}
+	result, err := c.ServiceClient.DeletedDatabaseList(ctx, &empty.Empty{})
+	c.Logger.Debugf("DeletedDatabaseList finished in %s", time.Since(start))
+	return result, err
+}
+
 // UseDatabase create a new database by making a grpc call
 func (c *immuClient) UseDatabase(ctx context.Context, db *schema.Database) (*schema.UseDatabaseReply, error) {
 	start := time.Now()
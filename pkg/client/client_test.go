@@ -21,9 +21,11 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/stretchr/testify/assert"
 	"io"
+	"io/ioutil"
 	"log"
 	"net"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -36,9 +38,12 @@ import (
 	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/logger"
 	"github.com/codenotary/immudb/pkg/server"
+	"github.com/codenotary/immudb/pkg/store"
 	"github.com/stretchr/testify/require"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 )
 
@@ -73,7 +78,7 @@ var plainPass string
 
 func newServer() *server.ImmuServer {
 	is := server.DefaultServer()
-	is = is.WithOptions(is.Options.WithAuth(true).WithInMemoryStore(true))
+	is = is.WithOptions(is.Options.WithAuth(true).WithInMemoryStore(true).WithBulkLoadDir(os.TempDir()))
 	auth.AuthEnabled = is.Options.GetAuth()
 
 	username, plainPass = auth.SysAdminUsername, auth.SysAdminPassword
@@ -558,6 +563,81 @@ func TestImmuClient_SetBatch(t *testing.T) {
 	client.Disconnect()
 }
 
+func TestImmuClient_SetBatchStream(t *testing.T) {
+	setup()
+	br := BatchRequest{
+		Keys:   []io.Reader{strings.NewReader("key1"), strings.NewReader("key2"), strings.NewReader("key3")},
+		Values: []io.Reader{strings.NewReader("val1"), strings.NewReader("val2"), strings.NewReader("val3")},
+	}
+
+	_, err := client.SetBatchStream(context.TODO(), &br, 0)
+	assert.Equal(t, ErrIllegalArguments, err)
+
+	ind, err := client.SetBatchStream(context.TODO(), &br, 2)
+	assert.Nil(t, err)
+	assert.IsType(t, &schema.Index{}, ind)
+
+	item, err := (*client.GetServiceClient()).Get(context.TODO(), &schema.Key{Key: []byte(`key3`)})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(`val3`), item.Value)
+	assert.Equal(t, ind.Index, item.Index)
+	client.Disconnect()
+}
+
+func TestImmuClient_SetWithCodecs(t *testing.T) {
+	setup()
+	_, err := client.SetWithCodecs(context.TODO(), []byte(`compressed`), []byte(`val`), 1)
+	assert.Nil(t, err)
+	item, err := client.Get(context.TODO(), []byte(`compressed`))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(`val`), item.Value.Payload)
+
+	codecs, err := client.GetCodecs(context.TODO(), []byte(`compressed`))
+	assert.Nil(t, err)
+	assert.Len(t, codecs.Codecs, 1)
+	assert.Equal(t, "gzip", codecs.Codecs[0].Name)
+	assert.Equal(t, "compression", codecs.Codecs[0].Kind)
+
+	_, err = client.SetWithCodecs(context.TODO(), []byte(`compressed`), []byte(`val`), 99)
+	assert.Error(t, err)
+	client.Disconnect()
+}
+
+func TestImmuClient_SetWithLabels(t *testing.T) {
+	setup()
+	_, err := client.SetWithLabels(context.TODO(), []byte(`labelled1`), []byte(`val1`), map[string]string{"env": "prod"})
+	assert.Nil(t, err)
+	_, err = client.SetWithLabels(context.TODO(), []byte(`labelled2`), []byte(`val2`), map[string]string{"env": "dev"})
+	assert.Nil(t, err)
+	item, err := client.Get(context.TODO(), []byte(`labelled1`))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(`val1`), item.Value.Payload)
+	assert.Equal(t, map[string]string{"env": "prod"}, item.Labels)
+
+	list, err := client.ScanByLabels(context.TODO(), []byte(`labelled`), map[string]string{"env": "dev"})
+	assert.Nil(t, err)
+	assert.Len(t, list.Items, 1)
+	assert.Equal(t, []byte(`labelled2`), list.Items[0].Key)
+	assert.Equal(t, []byte(`val2`), list.Items[0].Value.Payload)
+	client.Disconnect()
+}
+
+func TestImmuClient_SetIfChanged(t *testing.T) {
+	setup()
+	first, err := client.Set(context.TODO(), []byte(`dedup`), []byte(`val`))
+	assert.Nil(t, err)
+
+	again, err := client.SetIfChanged(context.TODO(), []byte(`dedup`), []byte(`val`))
+	assert.Nil(t, err)
+	assert.Equal(t, first.Index, again.Index)
+
+	changed, err := client.SetIfChanged(context.TODO(), []byte(`dedup`), []byte(`val2`))
+	assert.Nil(t, err)
+	assert.True(t, changed.Index > first.Index)
+
+	client.Disconnect()
+}
+
 func TestImmuClient_Count(t *testing.T) {
 	setup()
 	_, _ = client.SafeSet(context.TODO(), []byte(`key1`), []byte(`val1`))
@@ -631,6 +711,74 @@ func TestImmuClient_PrintTree(t *testing.T) {
 	client.Disconnect()
 }
 
+func TestImmuClient_Report(t *testing.T) {
+	setup()
+	_, _ = client.SafeSet(context.TODO(), []byte(`key1`), []byte(`val1`))
+	_, _ = client.SafeSet(context.TODO(), []byte(`key2`), []byte(`val2`))
+
+	report, err := client.Report(context.TODO(), 3)
+
+	assert.Nil(t, err)
+	assert.IsType(t, &schema.StoreReport{}, report)
+	assert.True(t, report.Keys >= 2)
+	assert.NotEmpty(t, report.Growth)
+
+	client.Disconnect()
+}
+
+func TestImmuClient_BulkLoad(t *testing.T) {
+	setup()
+	f, err := ioutil.TempFile(os.TempDir(), "bulkload")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	bw, err := store.NewBulkWriter(f, 2)
+	require.NoError(t, err)
+	require.NoError(t, bw.Add([]byte(`bulk1`), []byte(`val1`)))
+	require.NoError(t, bw.Add([]byte(`bulk2`), []byte(`val2`)))
+	require.NoError(t, bw.Add([]byte(`bulk3`), []byte(`val3`)))
+	digest, err := bw.Close()
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	reply, err := client.BulkLoad(context.TODO(), filepath.Base(f.Name()), 0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), reply.Entries)
+	assert.Equal(t, uint64(2), reply.Batches)
+	assert.Equal(t, digest, reply.Digest)
+	assert.Nil(t, BulkLoadProgress(err))
+
+	_, err = client.BulkLoad(context.TODO(), f.Name(), 0)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	client.Disconnect()
+}
+
+func TestImmuClient_Usage(t *testing.T) {
+	setup()
+	usages, err := client.Usage(context.TODO())
+
+	assert.Nil(t, err)
+	assert.IsType(t, &schema.UsageList{}, usages)
+
+	client.Disconnect()
+}
+
+func TestImmuClient_ServerInfo(t *testing.T) {
+	setup()
+	info, err := client.ServerInfo(context.TODO())
+	assert.Nil(t, err)
+	assert.Equal(t, schema.APIVersion, info.ApiVersion)
+	assert.True(t, schema.HasCapability(info.Capabilities, schema.CapabilityStreams))
+	assert.False(t, schema.HasCapability(info.Capabilities, schema.CapabilitySQL))
+
+	r, err := client.Login(context.TODO(), []byte(username), []byte(plainPass))
+	assert.Nil(t, err)
+	assert.Equal(t, schema.APIVersion, r.ApiVersion)
+	assert.Equal(t, info.Capabilities, r.Capabilities)
+	client.Disconnect()
+}
+
 func TestImmuClient_GetServiceClient(t *testing.T) {
 	setup()
 	cli := client.GetServiceClient()
@@ -0,0 +1,50 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package edgesync
+
+// Options edge sync options
+type Options struct {
+	Namespace []byte
+	StateFile string
+	BatchSize int
+}
+
+// DefaultOptions ...
+func DefaultOptions() *Options {
+	return &Options{
+		StateFile: ".edgesync",
+		BatchSize: 100,
+	}
+}
+
+// WithNamespace sets the prefix prepended to every key pushed to the central server
+func (o *Options) WithNamespace(namespace []byte) *Options {
+	o.Namespace = namespace
+	return o
+}
+
+// WithStateFile sets the file holding the index of the next local entry to be pushed
+func (o *Options) WithStateFile(stateFile string) *Options {
+	o.StateFile = stateFile
+	return o
+}
+
+// WithBatchSize sets the maximum number of entries written to the central server at once
+func (o *Options) WithBatchSize(batchSize int) *Options {
+	o.BatchSize = batchSize
+	return o
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package edgesync pushes the entries written on a local immudb, typically an edge node with intermittent
+// connectivity, to a central immudb under a dedicated key namespace.
+package edgesync
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrNoNamespace is returned when the syncer is not configured with a namespace
+var ErrNoNamespace = errors.New("a namespace is required to push entries to the central server")
+
+// Syncer the edge sync interface
+type Syncer interface {
+	Push(ctx context.Context) (*Report, error)
+}
+
+// Conflict is a local entry that was not pushed because the central copy of the key was modified by someone else
+type Conflict struct {
+	Index   uint64
+	Key     []byte
+	Local   []byte
+	Central []byte
+}
+
+// Report summarizes a push: local entries in the [From, To) index range were processed
+type Report struct {
+	From      uint64
+	To        uint64
+	Pushed    uint64
+	Conflicts []*Conflict
+}
+
+type syncer struct {
+	local   schema.ImmuServiceClient
+	central schema.ImmuServiceClient
+	opts    *Options
+}
+
+// NewSyncer creates a syncer pushing the entries of the database selected on local into the one selected on central
+func NewSyncer(local schema.ImmuServiceClient, central schema.ImmuServiceClient, opts *Options) Syncer {
+	return &syncer{local: local, central: central, opts: opts}
+}
+
+// Push sends to the central server, in index order, all the local entries written since the previous push.
+// Each key is written as namespace+key. An entry is a conflict when the central copy of the key is not the one
+// left by the previous local version of that key: conflicts are reported and never overwritten.
+// Progress is saved after every batch, so an interrupted push resumes where it stopped.
+func (s *syncer) Push(ctx context.Context) (*Report, error) {
+	if len(s.opts.Namespace) == 0 {
+		return nil, ErrNoNamespace
+	}
+	next, err := s.loadState()
+	if err != nil {
+		return nil, err
+	}
+	report := &Report{From: next, To: next}
+	for done := false; !done; {
+		batch := &schema.KVList{}
+		pending := map[string][]byte{}
+		for len(batch.KVs) < s.opts.BatchSize {
+			item, err := s.local.ByIndex(ctx, &schema.Index{Index: next})
+			if err != nil {
+				if status.Code(err) == codes.NotFound {
+					done = true
+					break
+				}
+				return report, err
+			}
+			conflict, synced, err := s.check(ctx, item, pending)
+			if err != nil {
+				return report, err
+			}
+			if conflict != nil {
+				report.Conflicts = append(report.Conflicts, conflict)
+			} else if !synced {
+				key := s.namespaced(item.Key)
+				batch.KVs = append(batch.KVs, &schema.KeyValue{Key: key, Value: item.Value})
+				pending[string(key)] = item.Value
+			}
+			next++
+		}
+		if len(batch.KVs) > 0 {
+			if _, err := s.central.SetBatch(ctx, batch); err != nil {
+				return report, err
+			}
+			report.Pushed += uint64(len(batch.KVs))
+		}
+		if next == report.To {
+			break
+		}
+		if err := s.saveState(next); err != nil {
+			return report, err
+		}
+		report.To = next
+	}
+	return report, nil
+}
+
+// check compares the central copy of the key with the value expected after the previous local version of the key
+// was pushed. synced is true when the central copy already holds the value of item.
+func (s *syncer) check(ctx context.Context, item *schema.Item, pending map[string][]byte) (conflict *Conflict, synced bool, err error) {
+	key := s.namespaced(item.Key)
+	central, found := pending[string(key)]
+	if !found {
+		if central, found, err = s.centralValue(ctx, key); err != nil {
+			return nil, false, err
+		}
+	}
+	if found && bytes.Equal(central, item.Value) {
+		return nil, true, nil
+	}
+	expected, expectedFound, err := s.previousValue(ctx, item)
+	if err != nil {
+		return nil, false, err
+	}
+	if found != expectedFound || !bytes.Equal(central, expected) {
+		return &Conflict{Index: item.Index, Key: item.Key, Local: item.Value, Central: central}, false, nil
+	}
+	return nil, false, nil
+}
+
+func (s *syncer) centralValue(ctx context.Context, key []byte) ([]byte, bool, error) {
+	item, err := s.central.Get(ctx, &schema.Key{Key: key})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	return item.Value, true, nil
+}
+
+// previousValue returns the value of the most recent local version of the key written before item
+func (s *syncer) previousValue(ctx context.Context, item *schema.Item) ([]byte, bool, error) {
+	history, err := s.local.History(ctx, &schema.Key{Key: item.Key})
+	if err != nil {
+		return nil, false, err
+	}
+	var previous *schema.Item
+	for _, h := range history.Items {
+		if h.Index < item.Index && (previous == nil || h.Index > previous.Index) {
+			previous = h
+		}
+	}
+	if previous == nil {
+		return nil, false, nil
+	}
+	return previous.Value, true, nil
+}
+
+func (s *syncer) namespaced(key []byte) []byte {
+	return append(append([]byte{}, s.opts.Namespace...), key...)
+}
+
+func (s *syncer) loadState() (uint64, error) {
+	raw, err := ioutil.ReadFile(s.opts.StateFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(raw)), 10, 64)
+}
+
+func (s *syncer) saveState(next uint64) error {
+	tmp := s.opts.StateFile + ".tmp"
+	if err := ioutil.WriteFile(tmp, []byte(strconv.FormatUint(next, 10)), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.opts.StateFile)
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package edgesync
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/server"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufSize = 1024 * 1024
+
+func newServiceClient(t *testing.T, dir string) schema.ImmuServiceClient {
+	lis := bufconn.Listen(bufSize)
+	is := server.DefaultServer()
+	is = is.WithOptions(is.Options.
+		WithAuth(false).
+		WithMetricsServer(false).
+		WithCorruptionCheck(false).
+		WithDir(dir).
+		WithListener(lis))
+	go is.Start()
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) {
+		return lis.Dial()
+	}))
+	assert.NoError(t, err)
+	return schema.NewImmuServiceClient(conn)
+}
+
+func TestSyncerPush(t *testing.T) {
+	dir := "edgesync_test"
+	defer os.RemoveAll(dir)
+	ctx := context.Background()
+	local := newServiceClient(t, filepath.Join(dir, "edge"))
+	central := newServiceClient(t, filepath.Join(dir, "central"))
+
+	opts := DefaultOptions().
+		WithNamespace([]byte("edge1/")).
+		WithStateFile(filepath.Join(dir, "edge1.state")).
+		WithBatchSize(2)
+	syncer := NewSyncer(local, central, opts)
+
+	for _, kv := range []*schema.KeyValue{
+		{Key: []byte("a"), Value: []byte("1")},
+		{Key: []byte("b"), Value: []byte("1")},
+		{Key: []byte("a"), Value: []byte("2")},
+	} {
+		_, err := local.Set(ctx, kv)
+		assert.NoError(t, err)
+	}
+
+	report, err := syncer.Push(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), report.From)
+	assert.Equal(t, uint64(3), report.To)
+	assert.Equal(t, uint64(3), report.Pushed)
+	assert.Empty(t, report.Conflicts)
+
+	item, err := central.Get(ctx, &schema.Key{Key: []byte("edge1/a")})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("2"), item.Value)
+
+	// nothing new to push
+	report, err = syncer.Push(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), report.From)
+	assert.Equal(t, uint64(3), report.To)
+	assert.Equal(t, uint64(0), report.Pushed)
+
+	// the central copy of b is modified by someone else while the edge updates it too
+	_, err = central.Set(ctx, &schema.KeyValue{Key: []byte("edge1/b"), Value: []byte("central")})
+	assert.NoError(t, err)
+	_, err = local.Set(ctx, &schema.KeyValue{Key: []byte("b"), Value: []byte("2")})
+	assert.NoError(t, err)
+	_, err = local.Set(ctx, &schema.KeyValue{Key: []byte("c"), Value: []byte("1")})
+	assert.NoError(t, err)
+
+	report, err = syncer.Push(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), report.To)
+	assert.Equal(t, uint64(1), report.Pushed)
+	if !assert.Len(t, report.Conflicts, 1) {
+		return
+	}
+	assert.Equal(t, []byte("b"), report.Conflicts[0].Key)
+	assert.Equal(t, []byte("2"), report.Conflicts[0].Local)
+	assert.Equal(t, []byte("central"), report.Conflicts[0].Central)
+
+	item, err = central.Get(ctx, &schema.Key{Key: []byte("edge1/b")})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("central"), item.Value)
+
+	// a new syncer resumes from the saved state
+	report, err = NewSyncer(local, central, opts).Push(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), report.From)
+
+	_, err = NewSyncer(local, central, DefaultOptions()).Push(ctx)
+	assert.Equal(t, ErrNoNamespace, err)
+}
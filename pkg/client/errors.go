@@ -26,3 +26,8 @@ var (
 	ErrNotConnected      = errors.New("not connected")
 	ErrHealthCheckFailed = errors.New("health check failed")
 )
+
+// Errors related to request arguments
+var (
+	ErrIllegalArguments = errors.New("illegal arguments")
+)
@@ -0,0 +1,70 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package loadgen runs workloads against an immudb server through the client on top of the pkg/bm benchmark
+// engine, recording the latency of every operation, so that performance tests can be embedded into CI pipelines and
+// capacity tests.
+package loadgen
+
+import (
+	"context"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/bm"
+	"github.com/codenotary/immudb/pkg/client"
+)
+
+// ErrInvalidWorkload is returned when the workload has no operation, or neither a number of operations nor a duration
+var ErrInvalidWorkload = bm.ErrInvalidBenchmark
+
+// Op performs the i-th operation of a workload, worker is the index of the goroutine running it
+type Op func(ctx context.Context, c client.ImmuClient, worker int, i int) error
+
+// Workload describes the operations to run and how
+type Workload struct {
+	Name string
+	// Concurrency is the number of goroutines running the operations, 1 when not set
+	Concurrency int
+	// Operations is the total number of operations, split among the goroutines. When 0 the operations are run until
+	// Duration is over.
+	Operations int
+	// Duration limits the time the workload runs for, 0 means no limit
+	Duration time.Duration
+	Op       Op
+}
+
+// Benchmark returns the benchmark running the workload with c, Before and After can be set on it to prepare and
+// clean up the server
+func Benchmark(c client.ImmuClient, w Workload) bm.Bm {
+	b := bm.Bm{
+		Name:        w.Name,
+		Concurrency: w.Concurrency,
+		Iterations:  w.Operations,
+		Duration:    w.Duration,
+	}
+	if w.Op != nil {
+		b.Op = func(ctx context.Context, b *bm.Bm, worker int, i int) error {
+			return w.Op(ctx, c, worker, i)
+		}
+	}
+	return b
+}
+
+// Run runs the workload, stopping at the first failed operation whose error is returned along with the partial result
+func Run(ctx context.Context, c client.ImmuClient, w Workload) (*bm.BmResult, error) {
+	b := Benchmark(c, w)
+	return b.Run(ctx)
+}
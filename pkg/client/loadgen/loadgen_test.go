@@ -0,0 +1,117 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadgen
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/client/cache"
+	"github.com/codenotary/immudb/pkg/client/timestamp"
+	"github.com/codenotary/immudb/pkg/logger"
+	"github.com/codenotary/immudb/pkg/server"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufSize = 1024 * 1024
+
+func newClient(t *testing.T, dir string) client.ImmuClient {
+	lis := bufconn.Listen(bufSize)
+	is := server.DefaultServer()
+	is = is.WithOptions(is.Options.
+		WithAuth(false).
+		WithMetricsServer(false).
+		WithCorruptionCheck(false).
+		WithDir(filepath.Join(dir, "data")).
+		WithListener(lis))
+	go is.Start()
+
+	dialOptions := []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+	}
+	ic := client.DefaultClient().WithOptions(client.DefaultOptions().WithAuth(false).WithDialOptions(&dialOptions))
+	conn, err := ic.Connect(context.Background())
+	assert.NoError(t, err)
+	ic.WithClientConn(conn)
+	serviceClient := schema.NewImmuServiceClient(conn)
+	ic.WithServiceClient(serviceClient)
+	ic.WithRootService(client.NewRootService(serviceClient, cache.NewFileCache(dir), logger.NewSimpleLogger("test", os.Stdout)))
+	ts, err := timestamp.NewTdefault()
+	assert.NoError(t, err)
+	ic.WithTimestampService(client.NewTimestampService(ts))
+	return ic
+}
+
+func TestRun(t *testing.T) {
+	dir := "loadgen_test"
+	defer os.RemoveAll(dir)
+	ctx := context.Background()
+	c := newClient(t, dir)
+
+	keys := SequentialKeys("loadgen-")
+	r, err := Run(ctx, c, Workload{Name: "set", Concurrency: 3, Operations: 100, Op: SetOp(keys, 16)})
+	assert.NoError(t, err)
+	assert.Equal(t, 100, r.Iterations)
+	assert.Equal(t, 100, r.Latency.Count)
+	assert.True(t, r.Transactions > 0)
+	assert.Contains(t, r.String(), "Latency:")
+
+	r, err = Run(ctx, c, Workload{Name: "get", Concurrency: 4, Operations: 100, Op: GetOp(keys)})
+	assert.NoError(t, err)
+	assert.Equal(t, 100, r.Iterations)
+	item, err := c.Get(ctx, keys(42))
+	assert.NoError(t, err)
+	assert.Equal(t, ValueOfSize(42, 16), item.Value.Payload)
+
+	r, err = Run(ctx, c, Workload{Name: "batch", Concurrency: 2, Operations: 10, Op: SetBatchOp(SequentialKeys("batch-"), 10, 8)})
+	assert.NoError(t, err)
+	assert.Equal(t, 10, r.Iterations)
+
+	r, err = Run(ctx, c, Workload{Name: "mixed", Operations: 20, Op: MixedOp(SafeSetOp(keys, 8), SafeGetOp(keys), 2)})
+	assert.NoError(t, err)
+	assert.Equal(t, 20, r.Iterations)
+
+	r, err = Run(ctx, c, Workload{Name: "timed", Concurrency: 2, Duration: 200 * time.Millisecond, Op: SetOp(keys, 8)})
+	assert.NoError(t, err)
+	assert.True(t, r.Iterations > 0)
+
+	// the run stops at the first error
+	failure := errors.New("failure")
+	r, err = Run(ctx, c, Workload{Name: "failing", Concurrency: 2, Operations: 100, Op: func(ctx context.Context, c client.ImmuClient, worker int, i int) error {
+		if i == 10 {
+			return failure
+		}
+		return nil
+	}})
+	assert.EqualError(t, err, "operation 10 failed: failure")
+	assert.True(t, r.Iterations < 100)
+
+	_, err = Run(ctx, c, Workload{Name: "invalid", Op: SetOp(keys, 8)})
+	assert.Equal(t, ErrInvalidWorkload, err)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadgen
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/codenotary/immudb/pkg/client"
+)
+
+// KeyFunc returns the key of the i-th operation
+type KeyFunc func(i int) []byte
+
+// SequentialKeys returns keys made of prefix followed by the operation number, zero padded so that they sort in
+// insertion order
+func SequentialKeys(prefix string) KeyFunc {
+	return func(i int) []byte {
+		return []byte(fmt.Sprintf("%s%010d", prefix, i))
+	}
+}
+
+// ValueOfSize returns a value of n bytes derived from the operation number
+func ValueOfSize(i int, n int) []byte {
+	value := make([]byte, n)
+	for k := range value {
+		value[k] = byte(i + k)
+	}
+	return value
+}
+
+// SetOp writes values of valueSize bytes
+func SetOp(keys KeyFunc, valueSize int) Op {
+	return func(ctx context.Context, c client.ImmuClient, worker int, i int) error {
+		_, err := c.Set(ctx, keys(i), ValueOfSize(i, valueSize))
+		return err
+	}
+}
+
+// SafeSetOp writes values of valueSize bytes verifying the inclusion and consistency proofs of each write
+func SafeSetOp(keys KeyFunc, valueSize int) Op {
+	return func(ctx context.Context, c client.ImmuClient, worker int, i int) error {
+		_, err := c.SafeSet(ctx, keys(i), ValueOfSize(i, valueSize))
+		return err
+	}
+}
+
+// GetOp reads the keys, which must have been written before, e.g. by a SetOp workload with the same keys
+func GetOp(keys KeyFunc) Op {
+	return func(ctx context.Context, c client.ImmuClient, worker int, i int) error {
+		_, err := c.Get(ctx, keys(i))
+		return err
+	}
+}
+
+// SafeGetOp reads the keys verifying the inclusion and consistency proofs of each read
+func SafeGetOp(keys KeyFunc) Op {
+	return func(ctx context.Context, c client.ImmuClient, worker int, i int) error {
+		_, err := c.SafeGet(ctx, keys(i))
+		return err
+	}
+}
+
+// SetBatchOp writes batchSize values of valueSize bytes per operation, the keys of the i-th operation are those of
+// the operations i*batchSize to (i+1)*batchSize-1
+func SetBatchOp(keys KeyFunc, batchSize int, valueSize int) Op {
+	return func(ctx context.Context, c client.ImmuClient, worker int, i int) error {
+		br := &client.BatchRequest{
+			Keys:   make([]io.Reader, batchSize),
+			Values: make([]io.Reader, batchSize),
+		}
+		for k := 0; k < batchSize; k++ {
+			n := i*batchSize + k
+			br.Keys[k] = bytes.NewReader(keys(n))
+			br.Values[k] = bytes.NewReader(ValueOfSize(n, valueSize))
+		}
+		_, err := c.SetBatch(ctx, br)
+		return err
+	}
+}
+
+// MixedOp runs read every readEvery operations and write otherwise, e.g. readEvery 5 makes a 20% reads workload. The
+// keys read by the i-th operation must already exist since it is not written by the workload.
+func MixedOp(write Op, read Op, readEvery int) Op {
+	return func(ctx context.Context, c client.ImmuClient, worker int, i int) error {
+		if readEvery > 0 && i%readEvery == readEvery-1 {
+			return read(ctx, c, worker, i)
+		}
+		return write(ctx, c, worker, i)
+	}
+}
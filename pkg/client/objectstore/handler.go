@@ -0,0 +1,193 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const metadataHeaderPrefix = "X-Amz-Meta-"
+
+// VerifiedHeader is set to true on the responses whose object manifest has been verified
+const VerifiedHeader = "X-Immudb-Verified"
+
+type s3Error struct {
+	XMLName xml.Name `xml:"Error"`
+	Code    string   `xml:"Code"`
+	Message string   `xml:"Message"`
+}
+
+type listEntry struct {
+	Key       string `xml:"Key"`
+	VersionID string `xml:"VersionId,omitempty"`
+	ETag      string `xml:"ETag"`
+	Size      int64  `xml:"Size"`
+}
+
+type listBucketResult struct {
+	XMLName  xml.Name    `xml:"ListBucketResult"`
+	Name     string      `xml:"Name"`
+	Prefix   string      `xml:"Prefix"`
+	KeyCount int         `xml:"KeyCount"`
+	Contents []listEntry `xml:"Contents"`
+}
+
+type listVersionsResult struct {
+	XMLName  xml.Name    `xml:"ListVersionsResult"`
+	Name     string      `xml:"Name"`
+	Prefix   string      `xml:"Prefix"`
+	Versions []listEntry `xml:"Version"`
+}
+
+type handler struct {
+	store *Store
+}
+
+// NewHandler returns a minimal, path style, S3 compatible HTTP API over the store:
+//
+//	PUT /bucket/name                 writes a new version, X-Amz-Meta-* headers are kept as metadata
+//	GET|HEAD /bucket/name[?versionId] reads the latest or the given version
+//	GET /bucket[?prefix]             lists the latest version of the objects
+//	GET /bucket?versions[&prefix]    lists all the versions of the objects
+//
+// Objects are immutable, so DELETE and the other S3 operations are not implemented.
+func NewHandler(store *Store) http.Handler {
+	return &handler{store: store}
+}
+
+func writeError(w http.ResponseWriter, status int, code string, message string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	xml.NewEncoder(w).Encode(&s3Error{Code: code, Message: message})
+}
+
+func writeStoreError(w http.ResponseWriter, err error) {
+	switch err {
+	case ErrInvalidName:
+		writeError(w, http.StatusBadRequest, "InvalidBucketName", err.Error())
+	case ErrNoSuchObject:
+		writeError(w, http.StatusNotFound, "NoSuchKey", err.Error())
+	default:
+		writeError(w, http.StatusInternalServerError, "InternalError", err.Error())
+	}
+}
+
+func writeXML(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/xml")
+	xml.NewEncoder(w).Encode(v)
+}
+
+func etag(obj *Object) string {
+	return strconv.Quote(obj.SHA256)
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	bucket, name := path, ""
+	if i := strings.Index(path, "/"); i >= 0 {
+		bucket, name = path[:i], path[i+1:]
+	}
+	switch {
+	case name == "" && r.Method == http.MethodGet:
+		h.list(w, r, bucket)
+	case name != "" && r.Method == http.MethodPut:
+		h.put(w, r, bucket, name)
+	case name != "" && (r.Method == http.MethodGet || r.Method == http.MethodHead):
+		h.get(w, r, bucket, name)
+	default:
+		writeError(w, http.StatusNotImplemented, "NotImplemented", "operation not supported")
+	}
+}
+
+func (h *handler) put(w http.ResponseWriter, r *http.Request, bucket, name string) {
+	metadata := map[string]string{}
+	for header := range r.Header {
+		if strings.HasPrefix(header, metadataHeaderPrefix) {
+			metadata[strings.ToLower(strings.TrimPrefix(header, metadataHeaderPrefix))] = r.Header.Get(header)
+		}
+	}
+	obj, err := h.store.Put(r.Context(), bucket, name, r.Body, r.Header.Get("Content-Type"), metadata)
+	if err != nil {
+		writeStoreError(w, err)
+		return
+	}
+	w.Header().Set("ETag", etag(obj))
+	w.Header().Set("X-Amz-Version-Id", strconv.FormatUint(obj.Version, 10))
+	w.Header().Set(VerifiedHeader, strconv.FormatBool(obj.Verified))
+}
+
+func (h *handler) get(w http.ResponseWriter, r *http.Request, bucket, name string) {
+	version := LatestVersion
+	if v := r.URL.Query().Get("versionId"); v != "" {
+		var err error
+		if version, err = strconv.ParseUint(v, 10, 64); err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidArgument", "invalid version id")
+			return
+		}
+	}
+	obj, content, err := h.store.Get(r.Context(), bucket, name, version)
+	if err != nil {
+		writeStoreError(w, err)
+		return
+	}
+	if obj.ContentType != "" {
+		w.Header().Set("Content-Type", obj.ContentType)
+	}
+	for k, v := range obj.Metadata {
+		w.Header().Set(metadataHeaderPrefix+k, v)
+	}
+	w.Header().Set("Content-Length", strconv.FormatInt(obj.Size, 10))
+	w.Header().Set("ETag", etag(obj))
+	w.Header().Set("X-Amz-Version-Id", strconv.FormatUint(obj.Version, 10))
+	w.Header().Set(VerifiedHeader, strconv.FormatBool(obj.Verified))
+	if r.Method == http.MethodHead {
+		return
+	}
+	// headers are already sent, a chunk failing verification can only abort the transfer
+	io.Copy(w, content)
+}
+
+func (h *handler) list(w http.ResponseWriter, r *http.Request, bucket string) {
+	prefix := r.URL.Query().Get("prefix")
+	if _, ok := r.URL.Query()["versions"]; ok {
+		objects, err := h.store.VersionsByPrefix(r.Context(), bucket, prefix)
+		if err != nil {
+			writeStoreError(w, err)
+			return
+		}
+		res := &listVersionsResult{Name: bucket, Prefix: prefix}
+		for _, obj := range objects {
+			res.Versions = append(res.Versions, listEntry{Key: obj.Name, VersionID: strconv.FormatUint(obj.Version, 10), ETag: etag(obj), Size: obj.Size})
+		}
+		writeXML(w, res)
+		return
+	}
+	objects, err := h.store.List(r.Context(), bucket, prefix)
+	if err != nil {
+		writeStoreError(w, err)
+		return
+	}
+	res := &listBucketResult{Name: bucket, Prefix: prefix, KeyCount: len(objects)}
+	for _, obj := range objects {
+		res.Contents = append(res.Contents, listEntry{Key: obj.Name, ETag: etag(obj), Size: obj.Size})
+	}
+	writeXML(w, res)
+}
@@ -0,0 +1,347 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package objectstore stores versioned objects grouped in buckets on top of immudb.
+// Objects are split in content addressed chunks written through the batch stream, then a manifest listing the chunk
+// digests is written with a verified set. Each manifest write is an object version: reading it back checks its
+// inclusion proof against the trusted root and every chunk against the digests listed in the manifest, so a
+// tampered object is detected.
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"hash"
+	"io"
+	"strings"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var objectsPrefix = []byte("_objects/")
+var chunksPrefix = []byte("_chunks/")
+
+// LatestVersion selects the latest version of an object
+const LatestVersion = ^uint64(0)
+
+// ErrInvalidName is returned for empty bucket or object names, or bucket names containing a slash
+var ErrInvalidName = errors.New("invalid bucket or object name")
+
+// ErrNoSuchObject is returned when the object, or the requested version of it, does not exist
+var ErrNoSuchObject = errors.New("no such object")
+
+// ErrNotVerified is returned when an object manifest or chunk does not match its proof or digest
+var ErrNotVerified = errors.New("object verification failed")
+
+// ErrChunkTooLarge is returned when the chunk size does not fit in a message of the upload stream
+var ErrChunkTooLarge = errors.New("chunk size exceeds 3 MiB")
+
+// Object describes a version of an object
+type Object struct {
+	Bucket      string            `json:"bucket"`
+	Name        string            `json:"name"`
+	Version     uint64            `json:"-"`
+	Size        int64             `json:"size"`
+	ContentType string            `json:"contentType,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+	SHA256      string            `json:"sha256"`
+	Chunks      []string          `json:"chunks"`
+	Verified    bool              `json:"-"`
+}
+
+// Store reads and writes objects through an immudb client
+type Store struct {
+	client client.ImmuClient
+	opts   *Options
+}
+
+// New returns an object store writing to the current database of the given client
+func New(c client.ImmuClient, opts *Options) *Store {
+	return &Store{client: c, opts: opts}
+}
+
+func bucketPrefix(bucket string) ([]byte, error) {
+	if bucket == "" || strings.Contains(bucket, "/") {
+		return nil, ErrInvalidName
+	}
+	prefix := append([]byte{}, objectsPrefix...)
+	prefix = append(prefix, bucket...)
+	return append(prefix, '/'), nil
+}
+
+func objectKey(bucket, name string) ([]byte, error) {
+	prefix, err := bucketPrefix(bucket)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		return nil, ErrInvalidName
+	}
+	return append(prefix, name...), nil
+}
+
+func chunkKey(digest string) []byte {
+	return append(append([]byte{}, chunksPrefix...), digest...)
+}
+
+func isNotFound(err error) bool {
+	return status.Code(err) == codes.NotFound
+}
+
+// Put writes a new version of the object with the content read from r
+func (s *Store) Put(ctx context.Context, bucket, name string, r io.Reader, contentType string, metadata map[string]string) (*Object, error) {
+	key, err := objectKey(bucket, name)
+	if err != nil {
+		return nil, err
+	}
+	// chunks are content addressed and only referenced by the manifest written last, so they can be committed
+	// through several streams, each one fitting in a transaction
+	var stream schema.ImmuService_SetBatchStreamClient
+	streamSize := 0
+	closeStream := func() error {
+		if stream == nil {
+			return nil
+		}
+		_, err := stream.CloseAndRecv()
+		stream, streamSize = nil, 0
+		return err
+	}
+	send := func(batch *schema.KVList) (err error) {
+		size := 0
+		for _, kv := range batch.KVs {
+			size += len(kv.Value)
+		}
+		if streamSize+size > maxStreamCommitSize {
+			if err = closeStream(); err != nil {
+				return err
+			}
+		}
+		if stream == nil {
+			if stream, err = (*s.client.GetServiceClient()).SetBatchStream(ctx); err != nil {
+				return err
+			}
+		}
+		streamSize += size
+		return stream.Send(batch)
+	}
+	obj := &Object{Bucket: bucket, Name: name, ContentType: contentType, Metadata: metadata, Chunks: []string{}}
+	digest := sha256.New()
+	if s.opts.ChunkSize > maxStreamMessageSize {
+		return nil, ErrChunkTooLarge
+	}
+	batch := &schema.KVList{}
+	batchSize := 0
+	buf := make([]byte, s.opts.ChunkSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			if batchSize+n > maxStreamMessageSize {
+				if err := send(batch); err != nil {
+					return nil, err
+				}
+				batch, batchSize = &schema.KVList{}, 0
+			}
+			chunk := append([]byte{}, buf[:n]...)
+			sum := sha256.Sum256(chunk)
+			obj.Chunks = append(obj.Chunks, hex.EncodeToString(sum[:]))
+			obj.Size += int64(n)
+			digest.Write(chunk)
+			batch.KVs = append(batch.KVs, &schema.KeyValue{Key: chunkKey(obj.Chunks[len(obj.Chunks)-1]), Value: chunk})
+			batchSize += n
+			if len(batch.KVs) >= s.opts.StreamBatch {
+				if err := send(batch); err != nil {
+					return nil, err
+				}
+				batch, batchSize = &schema.KVList{}, 0
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(batch.KVs) > 0 {
+		if err := send(batch); err != nil {
+			return nil, err
+		}
+	}
+	if err := closeStream(); err != nil {
+		return nil, err
+	}
+	obj.SHA256 = hex.EncodeToString(digest.Sum(nil))
+
+	manifest, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	index, err := s.client.RawSafeSet(ctx, key, manifest)
+	if err != nil {
+		return nil, err
+	}
+	if !index.Verified {
+		return nil, ErrNotVerified
+	}
+	obj.Version = index.Index
+	obj.Verified = true
+	return obj, nil
+}
+
+// Head returns the verified manifest of the given version of the object, or of the latest one with LatestVersion
+func (s *Store) Head(ctx context.Context, bucket, name string, version uint64) (*Object, error) {
+	key, err := objectKey(bucket, name)
+	if err != nil {
+		return nil, err
+	}
+	var item *client.VerifiedItem
+	if version == LatestVersion {
+		item, err = s.client.RawSafeGet(ctx, key)
+	} else {
+		item, err = s.client.RawBySafeIndex(ctx, version)
+	}
+	if isNotFound(err) {
+		return nil, ErrNoSuchObject
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(item.Key, key) {
+		return nil, ErrNoSuchObject
+	}
+	if !item.Verified {
+		return nil, ErrNotVerified
+	}
+	obj := &Object{}
+	if err := json.Unmarshal(item.Value, obj); err != nil {
+		return nil, err
+	}
+	obj.Version = item.Index
+	obj.Verified = true
+	return obj, nil
+}
+
+// Get returns the verified manifest of the given version of the object, or of the latest one with LatestVersion,
+// and a reader of its content. Chunks are fetched lazily and the reader fails with ErrNotVerified as soon as one does not match
+// its digest.
+func (s *Store) Get(ctx context.Context, bucket, name string, version uint64) (*Object, io.Reader, error) {
+	obj, err := s.Head(ctx, bucket, name, version)
+	if err != nil {
+		return nil, nil, err
+	}
+	return obj, &chunkReader{ctx: ctx, store: s, chunks: obj.Chunks, digest: sha256.New(), sum: obj.SHA256}, nil
+}
+
+// Versions returns the manifests of all the versions of the object, oldest first.
+// They are not verified, use Head with the version to verify one.
+func (s *Store) Versions(ctx context.Context, bucket, name string) ([]*Object, error) {
+	key, err := objectKey(bucket, name)
+	if err != nil {
+		return nil, err
+	}
+	list, err := (*s.client.GetServiceClient()).History(ctx, &schema.Key{Key: key})
+	if err != nil {
+		return nil, err
+	}
+	return decodeManifests(list)
+}
+
+// VersionsByPrefix returns the manifests of all the versions of the objects of the bucket whose name starts with
+// prefix, grouped by object in name order and oldest first within an object.
+// They are not verified, use Head with the version to verify one.
+func (s *Store) VersionsByPrefix(ctx context.Context, bucket, prefix string) ([]*Object, error) {
+	objects, err := s.List(ctx, bucket, prefix)
+	if err != nil {
+		return nil, err
+	}
+	var versions []*Object
+	for _, obj := range objects {
+		objVersions, err := s.Versions(ctx, bucket, obj.Name)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, objVersions...)
+	}
+	return versions, nil
+}
+
+// List returns the latest manifest of the objects of the bucket whose name starts with prefix.
+// They are not verified, use Head to verify one.
+func (s *Store) List(ctx context.Context, bucket, prefix string) ([]*Object, error) {
+	key, err := bucketPrefix(bucket)
+	if err != nil {
+		return nil, err
+	}
+	list, err := (*s.client.GetServiceClient()).Scan(ctx, &schema.ScanOptions{Prefix: append(key, prefix...)})
+	if err != nil {
+		return nil, err
+	}
+	return decodeManifests(list)
+}
+
+func decodeManifests(list *schema.ItemList) ([]*Object, error) {
+	objects := make([]*Object, 0, len(list.Items))
+	for _, item := range list.Items {
+		obj := &Object{}
+		if err := json.Unmarshal(item.Value, obj); err != nil {
+			return nil, err
+		}
+		obj.Version = item.Index
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
+
+type chunkReader struct {
+	ctx     context.Context
+	store   *Store
+	chunks  []string
+	current []byte
+	digest  hash.Hash
+	sum     string
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.current) == 0 {
+		if len(r.chunks) == 0 {
+			if hex.EncodeToString(r.digest.Sum(nil)) != r.sum {
+				return 0, ErrNotVerified
+			}
+			return 0, io.EOF
+		}
+		item, err := (*r.store.client.GetServiceClient()).Get(r.ctx, &schema.Key{Key: chunkKey(r.chunks[0])})
+		if err != nil {
+			return 0, err
+		}
+		sum := sha256.Sum256(item.Value)
+		if hex.EncodeToString(sum[:]) != r.chunks[0] {
+			return 0, ErrNotVerified
+		}
+		r.digest.Write(item.Value)
+		r.current = item.Value
+		r.chunks = r.chunks[1:]
+	}
+	n := copy(p, r.current)
+	r.current = r.current[n:]
+	return n, nil
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/client/cache"
+	"github.com/codenotary/immudb/pkg/logger"
+	"github.com/codenotary/immudb/pkg/server"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufSize = 1024 * 1024
+
+func newStore(t *testing.T, dir string, opts *Options) *Store {
+	lis := bufconn.Listen(bufSize)
+	is := server.DefaultServer()
+	is = is.WithOptions(is.Options.
+		WithAuth(false).
+		WithMetricsServer(false).
+		WithCorruptionCheck(false).
+		WithDir(filepath.Join(dir, "data")).
+		WithListener(lis))
+	go is.Start()
+
+	dialOptions := []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+	}
+	ic := client.DefaultClient().WithOptions(client.DefaultOptions().WithAuth(false).WithDialOptions(&dialOptions))
+	conn, err := ic.Connect(context.Background())
+	assert.NoError(t, err)
+	ic.WithClientConn(conn)
+	serviceClient := schema.NewImmuServiceClient(conn)
+	ic.WithServiceClient(serviceClient)
+	ic.WithRootService(client.NewRootService(serviceClient, cache.NewFileCache(dir), logger.NewSimpleLogger("test", os.Stdout)))
+	return New(ic, opts)
+}
+
+func TestObjectStore(t *testing.T) {
+	dir := "objectstore_test"
+	defer os.RemoveAll(dir)
+	ctx := context.Background()
+	s := newStore(t, dir, DefaultOptions().WithChunkSize(4).WithStreamBatch(2))
+
+	v1, err := s.Put(ctx, "artifacts", "app/v1.tar", strings.NewReader("first content"), "application/x-tar", map[string]string{"builder": "ci"})
+	assert.NoError(t, err)
+	assert.True(t, v1.Verified)
+	assert.Equal(t, int64(13), v1.Size)
+	assert.Len(t, v1.Chunks, 4)
+
+	v2, err := s.Put(ctx, "artifacts", "app/v1.tar", strings.NewReader("second"), "", nil)
+	assert.NoError(t, err)
+	assert.True(t, v2.Version > v1.Version)
+
+	obj, content, err := s.Get(ctx, "artifacts", "app/v1.tar", LatestVersion)
+	assert.NoError(t, err)
+	assert.True(t, obj.Verified)
+	assert.Equal(t, v2.Version, obj.Version)
+	data, err := ioutil.ReadAll(content)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("second"), data)
+
+	obj, content, err = s.Get(ctx, "artifacts", "app/v1.tar", v1.Version)
+	assert.NoError(t, err)
+	assert.Equal(t, "application/x-tar", obj.ContentType)
+	assert.Equal(t, map[string]string{"builder": "ci"}, obj.Metadata)
+	data, err = ioutil.ReadAll(content)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("first content"), data)
+
+	versions, err := s.Versions(ctx, "artifacts", "app/v1.tar")
+	assert.NoError(t, err)
+	assert.Len(t, versions, 2)
+
+	empty, err := s.Put(ctx, "artifacts", "empty", bytes.NewReader(nil), "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), empty.Size)
+
+	objects, err := s.List(ctx, "artifacts", "app/")
+	assert.NoError(t, err)
+	assert.Len(t, objects, 1)
+	assert.Equal(t, "app/v1.tar", objects[0].Name)
+
+	_, err = s.Put(ctx, "artifacts", "app/v2.tar", strings.NewReader("third"), "", nil)
+	assert.NoError(t, err)
+	versions, err = s.VersionsByPrefix(ctx, "artifacts", "app/")
+	assert.NoError(t, err)
+	assert.Len(t, versions, 3)
+	assert.Equal(t, []string{"app/v1.tar", "app/v1.tar", "app/v2.tar"}, []string{versions[0].Name, versions[1].Name, versions[2].Name})
+
+	_, err = s.Head(ctx, "artifacts", "missing", LatestVersion)
+	assert.Equal(t, ErrNoSuchObject, err)
+	// a version of another key is not a version of this object
+	_, err = s.Head(ctx, "artifacts", "empty", v1.Version)
+	assert.Equal(t, ErrNoSuchObject, err)
+	_, err = s.Put(ctx, "bad/bucket", "name", bytes.NewReader(nil), "", nil)
+	assert.Equal(t, ErrInvalidName, err)
+}
+
+func TestObjectStoreLargeObject(t *testing.T) {
+	dir := "objectstore_large_test"
+	defer os.RemoveAll(dir)
+	ctx := context.Background()
+	s := newStore(t, dir, DefaultOptions())
+
+	content := make([]byte, 12<<20)
+	rand.Read(content)
+	obj, err := s.Put(ctx, "artifacts", "large.bin", bytes.NewReader(content), "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(content)), obj.Size)
+
+	_, r, err := s.Get(ctx, "artifacts", "large.bin", LatestVersion)
+	assert.NoError(t, err)
+	data, err := ioutil.ReadAll(r)
+	assert.NoError(t, err)
+	assert.Equal(t, content, data)
+
+	_, err = New(s.client, DefaultOptions().WithChunkSize(4<<20)).Put(ctx, "artifacts", "large.bin", bytes.NewReader(content), "", nil)
+	assert.Equal(t, ErrChunkTooLarge, err)
+}
+
+func TestHandler(t *testing.T) {
+	dir := "objectstore_handler_test"
+	defer os.RemoveAll(dir)
+	h := NewHandler(newStore(t, dir, DefaultOptions().WithChunkSize(4).WithStreamBatch(2)))
+
+	req := httptest.NewRequest(http.MethodPut, "/sbom/app.json", strings.NewReader(`{"name":"app"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Amz-Meta-Commit", "abc123")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "true", rec.Header().Get(VerifiedHeader))
+	version := rec.Header().Get("X-Amz-Version-Id")
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/sbom/app.json?versionId="+version, nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `{"name":"app"}`, rec.Body.String())
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "abc123", rec.Header().Get("X-Amz-Meta-Commit"))
+	assert.Equal(t, "true", rec.Header().Get(VerifiedHeader))
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/sbom", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "<Key>app.json</Key>")
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/sbom?versions&prefix=app", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "<Key>app.json</Key>")
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/sbom/missing", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Contains(t, rec.Body.String(), "NoSuchKey")
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/sbom/app.json", nil))
+	assert.Equal(t, http.StatusNotImplemented, rec.Code)
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+// Options object store options
+type Options struct {
+	ChunkSize   int
+	StreamBatch int
+}
+
+// maxStreamMessageSize bounds the chunk bytes sent in each message of the upload stream, leaving room for the
+// encoding overhead below the 4 MiB gRPC servers accept by default
+const maxStreamMessageSize = 3 << 20
+
+// maxStreamCommitSize bounds the chunk bytes sent through a single upload stream, committed by the server in a single
+// transaction, below the batch size the default store options allow, see store.Store.MaxBatch
+const maxStreamCommitSize = 4 << 20
+
+// DefaultOptions ...
+func DefaultOptions() *Options {
+	return &Options{
+		ChunkSize:   512 << 10,
+		StreamBatch: 4,
+	}
+}
+
+// WithChunkSize sets the maximum size in bytes of the chunks objects are split into, it must not exceed 3 MiB
+func (o *Options) WithChunkSize(chunkSize int) *Options {
+	o.ChunkSize = chunkSize
+	return o
+}
+
+// WithStreamBatch sets the maximum number of chunks sent in each message of the upload stream, a message is sent
+// earlier when the chunks would exceed 3 MiB
+func (o *Options) WithStreamBatch(streamBatch int) *Options {
+	o.StreamBatch = streamBatch
+	return o
+}
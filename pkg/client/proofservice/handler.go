@@ -0,0 +1,103 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proofservice
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type handler struct {
+	service *Service
+}
+
+// NewHandler returns a JSON HTTP API over the service:
+//
+//	GET /state        the last verified state
+//	GET /entry?key=k  whether the current value of the key k is verified
+//	GET /index/n      whether the entry at index n is verified
+func NewHandler(service *Service) http.Handler {
+	return &handler{service: service}
+}
+
+func writeJSON(w http.ResponseWriter, httpStatus int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	httpStatus := http.StatusBadGateway
+	switch {
+	case err == ErrTampered:
+		httpStatus = http.StatusConflict
+	case err == ErrNotSynced:
+		httpStatus = http.StatusServiceUnavailable
+	case status.Code(err) == codes.NotFound:
+		httpStatus = http.StatusNotFound
+	case status.Code(err) == codes.InvalidArgument:
+		httpStatus = http.StatusBadRequest
+	}
+	writeJSON(w, httpStatus, map[string]string{"error": err.Error()})
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+		return
+	}
+	switch {
+	case r.URL.Path == "/state":
+		state := h.service.State()
+		if state == nil {
+			writeError(w, ErrNotSynced)
+			return
+		}
+		writeJSON(w, http.StatusOK, state)
+	case r.URL.Path == "/entry":
+		key := r.URL.Query().Get("key")
+		if key == "" {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "missing key"})
+			return
+		}
+		result, err := h.service.Entry(r.Context(), []byte(key))
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, result)
+	case strings.HasPrefix(r.URL.Path, "/index/"):
+		index, err := strconv.ParseUint(strings.TrimPrefix(r.URL.Path, "/index/"), 10, 64)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid index"})
+			return
+		}
+		result, err := h.service.Index(r.Context(), index)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, result)
+	default:
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "not found"})
+	}
+}
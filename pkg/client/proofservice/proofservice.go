@@ -0,0 +1,191 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package proofservice verifies entries of an immudb server on behalf of thin clients which can't run the
+// verification themselves: it keeps the state of the server verified, checking that every new root is consistent
+// with the previous one, and answers whether an entry or an index is verified.
+package proofservice
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/golang/protobuf/ptypes/empty"
+)
+
+// ErrTampered is returned when the root of the server is not consistent with the last verified one
+var ErrTampered = errors.New("server root is not consistent with the last verified one")
+
+// ErrNotSynced is returned when entries are verified before the first Sync
+var ErrNotSynced = errors.New("state not synced yet")
+
+// State is the last verified root of the server
+type State struct {
+	Index      uint64    `json:"index"`
+	Root       string    `json:"root"`
+	VerifiedAt time.Time `json:"verifiedAt"`
+	// Tampered is set once a root not consistent with the verified one has been seen, the state is then frozen
+	Tampered bool `json:"tampered"`
+}
+
+// Result tells whether an entry is verified, the value itself is not returned, only its digest
+type Result struct {
+	Key         []byte `json:"key"`
+	Index       uint64 `json:"index"`
+	ValueSHA256 string `json:"valueSha256"`
+	Verified    bool   `json:"verified"`
+}
+
+// Service verifies entries through a client connected to the server
+type Service struct {
+	mu     sync.Mutex
+	client schema.ImmuServiceClient
+	state  *State
+}
+
+// New returns a service using c. The proofs are verified against the state kept by the service only, so c doesn't
+// need a root cache.
+func New(c schema.ImmuServiceClient) *Service {
+	return &Service{client: c}
+}
+
+// Sync fetches the current root of the server and, when it is consistent with the last verified one, makes it the
+// verified state. The first root is trusted.
+func (s *Service) Sync(ctx context.Context) (*State, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.state != nil && s.state.Tampered {
+		return s.currentState(), ErrTampered
+	}
+	if s.state == nil || s.state.Root == "" {
+		root, err := s.client.CurrentRoot(ctx, &empty.Empty{})
+		if err != nil {
+			return nil, err
+		}
+		s.state = &State{Index: root.GetIndex(), Root: hex.EncodeToString(root.GetRoot()), VerifiedAt: time.Now()}
+		return s.currentState(), nil
+	}
+	root, err := hex.DecodeString(s.state.Root)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := s.client.Consistency(ctx, &schema.Index{Index: s.state.Index})
+	if err != nil {
+		return nil, err
+	}
+	if !proof.Verify(schema.Root{Index: s.state.Index, Root: root}) {
+		s.state.Tampered = true
+		return s.currentState(), ErrTampered
+	}
+	s.state = &State{Index: proof.Second, Root: hex.EncodeToString(proof.SecondRoot), VerifiedAt: time.Now()}
+	return s.currentState(), nil
+}
+
+// State returns the last verified state, nil before the first Sync
+func (s *Service) State() *State {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.currentState()
+}
+
+func (s *Service) currentState() *State {
+	if s.state == nil {
+		return nil
+	}
+	state := *s.state
+	return &state
+}
+
+// Run syncs the state every interval until ctx is done. Errors don't stop the service, they are passed to onError
+// when it is not nil.
+func (s *Service) Run(ctx context.Context, interval time.Duration, onError func(error)) {
+	tick := time.NewTicker(interval)
+	defer tick.Stop()
+	for {
+		if _, err := s.Sync(ctx); err != nil && onError != nil {
+			onError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick.C:
+		}
+	}
+}
+
+// Entry verifies the current value of the key against the verified state
+func (s *Service) Entry(ctx context.Context, key []byte) (*Result, error) {
+	root, err := s.verifiedRoot()
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.client.SafeGet(ctx, &schema.SafeGetOptions{Key: key, RootIndex: &schema.Index{Index: root.Index}})
+	if err != nil {
+		return nil, err
+	}
+	return newResult(item, root)
+}
+
+// Index verifies the entry at the given index against the verified state
+func (s *Service) Index(ctx context.Context, index uint64) (*Result, error) {
+	root, err := s.verifiedRoot()
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.client.BySafeIndex(ctx, &schema.SafeIndexOptions{Index: index, RootIndex: &schema.Index{Index: root.Index}})
+	if err != nil {
+		return nil, err
+	}
+	return newResult(item, root)
+}
+
+// verifiedRoot returns the root the proofs are verified against, refusing to vouch for entries before the first
+// Sync and once the server has been found tampered
+func (s *Service) verifiedRoot() (*schema.Root, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.state == nil {
+		return nil, ErrNotSynced
+	}
+	if s.state.Tampered {
+		return nil, ErrTampered
+	}
+	root, err := hex.DecodeString(s.state.Root)
+	if err != nil {
+		return nil, err
+	}
+	return &schema.Root{Index: s.state.Index, Root: root}, nil
+}
+
+// newResult checks that the item is included in the tree of the server and that the tree is consistent with root
+func newResult(item *schema.SafeItem, root *schema.Root) (*Result, error) {
+	h, err := item.Hash()
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256(item.GetItem().GetValue())
+	return &Result{
+		Key:         item.GetItem().GetKey(),
+		Index:       item.GetItem().GetIndex(),
+		ValueSHA256: hex.EncodeToString(digest[:]),
+		Verified:    item.GetProof().Verify(h, *root),
+	}, nil
+}
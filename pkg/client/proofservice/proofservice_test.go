@@ -0,0 +1,149 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proofservice
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/client/cache"
+	"github.com/codenotary/immudb/pkg/client/timestamp"
+	"github.com/codenotary/immudb/pkg/logger"
+	"github.com/codenotary/immudb/pkg/server"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufSize = 1024 * 1024
+
+func newClient(t *testing.T, dir string) client.ImmuClient {
+	lis := bufconn.Listen(bufSize)
+	is := server.DefaultServer()
+	is = is.WithOptions(is.Options.
+		WithAuth(false).
+		WithMetricsServer(false).
+		WithCorruptionCheck(false).
+		WithDir(filepath.Join(dir, "data")).
+		WithListener(lis))
+	go is.Start()
+
+	dialOptions := []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+	}
+	ic := client.DefaultClient().WithOptions(client.DefaultOptions().WithAuth(false).WithDialOptions(&dialOptions))
+	conn, err := ic.Connect(context.Background())
+	assert.NoError(t, err)
+	ic.WithClientConn(conn)
+	serviceClient := schema.NewImmuServiceClient(conn)
+	ic.WithServiceClient(serviceClient)
+	ic.WithRootService(client.NewRootService(serviceClient, cache.NewFileCache(dir), logger.NewSimpleLogger("test", os.Stdout)))
+	ts, err := timestamp.NewTdefault()
+	assert.NoError(t, err)
+	ic.WithTimestampService(client.NewTimestampService(ts))
+	return ic
+}
+
+func get(t *testing.T, h http.Handler, path string, v interface{}) int {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	if v != nil {
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), v))
+	}
+	return rec.Code
+}
+
+func TestProofService(t *testing.T) {
+	dir := "proofservice_test"
+	defer os.RemoveAll(dir)
+	ctx := context.Background()
+	c := newClient(t, dir)
+
+	_, err := c.RawSafeSet(ctx, []byte("k1"), []byte("v1"))
+	assert.NoError(t, err)
+	s := New(*c.GetServiceClient())
+	h := NewHandler(s)
+	assert.Nil(t, s.State())
+	assert.Equal(t, http.StatusServiceUnavailable, get(t, h, "/state", nil))
+	_, err = s.Entry(ctx, []byte("k1"))
+	assert.Equal(t, ErrNotSynced, err)
+	_, err = s.Index(ctx, 0)
+	assert.Equal(t, ErrNotSynced, err)
+	assert.Equal(t, http.StatusServiceUnavailable, get(t, h, "/entry?key=k1", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, get(t, h, "/index/0", nil))
+
+	state, err := s.Sync(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), state.Index)
+
+	_, err = c.RawSafeSet(ctx, []byte("k2"), []byte("v2"))
+	assert.NoError(t, err)
+	state, err = s.Sync(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), state.Index)
+	var served State
+	assert.Equal(t, http.StatusOK, get(t, h, "/state", &served))
+	assert.Equal(t, state.Root, served.Root)
+
+	digest := sha256.Sum256([]byte("v2"))
+	var result Result
+	assert.Equal(t, http.StatusOK, get(t, h, "/entry?key=k2", &result))
+	assert.True(t, result.Verified)
+	assert.Equal(t, uint64(1), result.Index)
+	assert.Equal(t, hex.EncodeToString(digest[:]), result.ValueSHA256)
+
+	assert.Equal(t, http.StatusOK, get(t, h, "/index/0", &result))
+	assert.True(t, result.Verified)
+	assert.Equal(t, []byte("k1"), result.Key)
+
+	assert.Equal(t, http.StatusNotFound, get(t, h, "/entry?key=missing", nil))
+	assert.Equal(t, http.StatusBadRequest, get(t, h, "/entry", nil))
+	assert.Equal(t, http.StatusBadRequest, get(t, h, "/index/x", nil))
+
+	// the proofs are checked against the verified state, not against a root the service hasn't verified
+	_, err = c.RawSafeSet(ctx, []byte("k3"), []byte("v3"))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, get(t, h, "/entry?key=k3", &result))
+	assert.True(t, result.Verified)
+	verified := s.state.Root
+	s.state.Root = hex.EncodeToString(make([]byte, sha256.Size))
+	assert.Equal(t, http.StatusOK, get(t, h, "/entry?key=k3", &result))
+	assert.False(t, result.Verified)
+	s.state.Root = verified
+
+	// a root not consistent with the verified one freezes the service
+	s.state.Root = hex.EncodeToString(make([]byte, sha256.Size))
+	_, err = s.Sync(ctx)
+	assert.Equal(t, ErrTampered, err)
+	assert.True(t, s.State().Tampered)
+	_, err = s.Sync(ctx)
+	assert.Equal(t, ErrTampered, err)
+	assert.Equal(t, http.StatusConflict, get(t, h, "/entry?key=k1", nil))
+}
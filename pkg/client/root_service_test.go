@@ -78,6 +78,27 @@ func (m *immuServiceClientMock) UpdateMTLSConfig(ctx context.Context, in *schema
 func (m *immuServiceClientMock) PrintTree(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*schema.Tree, error) {
 	return &schema.Tree{}, nil
 }
+func (m *immuServiceClientMock) BulkLoad(ctx context.Context, in *schema.BulkLoadRequest, opts ...grpc.CallOption) (*schema.BulkLoadReply, error) {
+	return &schema.BulkLoadReply{}, nil
+}
+func (m *immuServiceClientMock) Usage(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*schema.UsageList, error) {
+	return &schema.UsageList{}, nil
+}
+func (m *immuServiceClientMock) OnBehalfOfRecords(ctx context.Context, in *schema.OnBehalfOfRequest, opts ...grpc.CallOption) (*schema.OnBehalfOfList, error) {
+	return &schema.OnBehalfOfList{}, nil
+}
+
+func (m *immuServiceClientMock) StartupProgress(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*schema.StartupProgress, error) {
+	return &schema.StartupProgress{}, nil
+}
+
+func (m *immuServiceClientMock) Logs(ctx context.Context, in *schema.LogRequest, opts ...grpc.CallOption) (schema.ImmuService_LogsClient, error) {
+	return nil, nil
+}
+
+func (m *immuServiceClientMock) Report(ctx context.Context, in *schema.ReportOptions, opts ...grpc.CallOption) (*schema.StoreReport, error) {
+	return &schema.StoreReport{}, nil
+}
 func (m *immuServiceClientMock) Login(ctx context.Context, in *schema.LoginRequest, opts ...grpc.CallOption) (*schema.LoginResponse, error) {
 	return &schema.LoginResponse{}, nil
 }
@@ -102,6 +123,9 @@ func (m *immuServiceClientMock) Get(ctx context.Context, in *schema.Key, opts ..
 func (m *immuServiceClientMock) GetSV(ctx context.Context, in *schema.Key, opts ...grpc.CallOption) (*schema.StructuredItem, error) {
 	return &schema.StructuredItem{}, nil
 }
+func (m *immuServiceClientMock) GetCodecs(ctx context.Context, in *schema.Key, opts ...grpc.CallOption) (*schema.Codecs, error) {
+	return &schema.Codecs{}, nil
+}
 func (m *immuServiceClientMock) SafeGet(ctx context.Context, in *schema.SafeGetOptions, opts ...grpc.CallOption) (*schema.SafeItem, error) {
 	return &schema.SafeItem{}, nil
 }
@@ -114,6 +138,9 @@ func (m *immuServiceClientMock) SetBatch(ctx context.Context, in *schema.KVList,
 func (m *immuServiceClientMock) SetBatchSV(ctx context.Context, in *schema.SKVList, opts ...grpc.CallOption) (*schema.Index, error) {
 	return &schema.Index{}, nil
 }
+func (m *immuServiceClientMock) SetBatchStream(ctx context.Context, opts ...grpc.CallOption) (schema.ImmuService_SetBatchStreamClient, error) {
+	return nil, nil
+}
 func (m *immuServiceClientMock) GetBatch(ctx context.Context, in *schema.KeyList, opts ...grpc.CallOption) (*schema.ItemList, error) {
 	return &schema.ItemList{}, nil
 }
@@ -186,6 +213,21 @@ func (m *immuServiceClientMock) Dump(ctx context.Context, in *empty.Empty, opts
 func (m *immuServiceClientMock) CreateDatabase(ctx context.Context, in *schema.Database, opts ...grpc.CallOption) (*schema.CreateDatabaseReply, error) {
 	return &schema.CreateDatabaseReply{}, nil
 }
+func (m *immuServiceClientMock) CreateDatabaseFromTemplate(ctx context.Context, in *schema.DatabaseTemplateRequest, opts ...grpc.CallOption) (*schema.CreateDatabaseReply, error) {
+	return &schema.CreateDatabaseReply{}, nil
+}
+func (m *immuServiceClientMock) DeleteDatabase(ctx context.Context, in *schema.Database, opts ...grpc.CallOption) (*schema.DeletedDatabase, error) {
+	return &schema.DeletedDatabase{}, nil
+}
+func (m *immuServiceClientMock) RestoreDatabase(ctx context.Context, in *schema.Database, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return &empty.Empty{}, nil
+}
+func (m *immuServiceClientMock) PurgeDatabase(ctx context.Context, in *schema.Database, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return &empty.Empty{}, nil
+}
+func (m *immuServiceClientMock) DeletedDatabaseList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*schema.DeletedDatabaseList, error) {
+	return &schema.DeletedDatabaseList{}, nil
+}
 func (m *immuServiceClientMock) UseDatabase(ctx context.Context, in *schema.Database, opts ...grpc.CallOption) (*schema.UseDatabaseReply, error) {
 	return &schema.UseDatabaseReply{}, nil
 }
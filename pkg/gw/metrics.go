@@ -36,6 +36,7 @@ import (
 type LastAuditResult struct {
 	ServerID               string
 	ServerAddress          string
+	Database               string
 	HasRunConsistencyCheck bool
 	HasError               bool
 	ConsistencyCheckResult bool
@@ -56,6 +57,10 @@ type MetricsCollection struct {
 	AuditCurrRootPerServer *prometheus.GaugeVec
 	AuditRunAtPerServer    *prometheus.GaugeVec
 
+	AuditRunsPerServer                 *prometheus.CounterVec
+	AuditVerificationFailuresPerServer *prometheus.CounterVec
+	AuditVerifiedRootPerDatabase       *prometheus.GaugeVec
+
 	UptimeCounter prometheus.CounterFunc
 }
 
@@ -77,6 +82,7 @@ func (mc *MetricsCollection) WithUptimeCounter(f func() float64) {
 func (mc *MetricsCollection) UpdateAuditResult(
 	serverID string,
 	serverAddress string,
+	database string,
 	checked bool,
 	withError bool,
 	result bool,
@@ -112,11 +118,22 @@ func (mc *MetricsCollection) UpdateAuditResult(
 		WithLabelValues(serverID, serverAddress).Set(currRootIndex)
 	mc.AuditRunAtPerServer.
 		WithLabelValues(serverID, serverAddress).SetToCurrentTime()
+	mc.AuditRunsPerServer.
+		WithLabelValues(serverID, serverAddress).Inc()
+	if checked && !result {
+		mc.AuditVerificationFailuresPerServer.
+			WithLabelValues(serverID, serverAddress).Inc()
+	}
+	if checked && result && !withError {
+		mc.AuditVerifiedRootPerDatabase.
+			WithLabelValues(serverID, serverAddress, database).Set(currRootIndex)
+	}
 
 	mc.lastAuditResult.Lock()
 	defer mc.lastAuditResult.Unlock()
 	mc.lastAuditResult.ServerID = serverID
 	mc.lastAuditResult.ServerAddress = serverAddress
+	mc.lastAuditResult.Database = database
 	mc.lastAuditResult.HasRunConsistencyCheck = checked
 	mc.lastAuditResult.HasError = withError
 	mc.lastAuditResult.ConsistencyCheckResult = checked && !withError && result
@@ -138,6 +155,17 @@ func newAuditGaugeVec(name string, help string) *prometheus.GaugeVec {
 	)
 }
 
+func newAuditCounterVec(name string, help string) *prometheus.CounterVec {
+	return promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      name,
+			Help:      help,
+		},
+		[]string{"server_id", "server_address"},
+	)
+}
+
 // Metrics gateway metrics collection
 var Metrics = MetricsCollection{
 	lastAuditResult: &LastAuditResult{},
@@ -157,6 +185,22 @@ var Metrics = MetricsCollection{
 		"audit_run_at_per_server",
 		"Timestamp in unix seconds at which latest audit run.",
 	),
+	AuditRunsPerServer: newAuditCounterVec(
+		"audit_runs_per_server",
+		"Number of audits run.",
+	),
+	AuditVerificationFailuresPerServer: newAuditCounterVec(
+		"audit_verification_failures_per_server",
+		"Number of audits whose consistency proof failed to verify, any of them is a tamper signal.",
+	),
+	AuditVerifiedRootPerDatabase: promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "audit_verified_root_per_database",
+			Help:      "Root index last verified as consistent by the audits of a database.",
+		},
+		[]string{"server_id", "server_address", "database"},
+	),
 }
 
 // StartMetrics listens and servers the HTTP metrics server in a new goroutine.
@@ -0,0 +1,40 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gw
+
+import (
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateAuditResult(t *testing.T) {
+	serverID, serverAddress := "metrics-test", "127.0.0.1:3322"
+	prevRoot := &schema.Root{Index: 1, Root: []byte{1}}
+	currRoot := &schema.Root{Index: 5, Root: []byte{5}}
+
+	Metrics.UpdateAuditResult(serverID, serverAddress, "db1", true, false, true, prevRoot, currRoot)
+	Metrics.UpdateAuditResult(serverID, serverAddress, "db2", true, false, false, prevRoot, currRoot)
+
+	assert.Equal(t, 2., testutil.ToFloat64(Metrics.AuditRunsPerServer.WithLabelValues(serverID, serverAddress)))
+	assert.Equal(t, 1., testutil.ToFloat64(Metrics.AuditVerificationFailuresPerServer.WithLabelValues(serverID, serverAddress)))
+	assert.Equal(t, 5., testutil.ToFloat64(Metrics.AuditVerifiedRootPerDatabase.WithLabelValues(serverID, serverAddress, "db1")))
+	assert.Equal(t, 0., testutil.ToFloat64(Metrics.AuditVerifiedRootPerDatabase.WithLabelValues(serverID, serverAddress, "db2")))
+	assert.Equal(t, "db2", Metrics.lastAuditResult.Database)
+}
@@ -36,32 +36,41 @@ type Options struct {
 	AuditInterval time.Duration
 	AuditUsername string
 	AuditPassword string `json:"-"`
-	Detached      bool
-	MTLs          bool
-	MTLsOptions   client.MTLsOptions
-	Config        string
-	Pidfile       string
-	Logfile       string
+	ProofService  bool
+	// ProofServiceInterval is the interval at which the proof service syncs the verified state
+	ProofServiceInterval time.Duration
+	ProofServiceUsername string
+	ProofServicePassword string `json:"-"`
+	Detached             bool
+	MTLs                 bool
+	MTLsOptions          client.MTLsOptions
+	Config               string
+	Pidfile              string
+	Logfile              string
 }
 
 // DefaultOptions ...
 func DefaultOptions() Options {
 	return Options{
-		Dir:           ".",
-		Address:       "127.0.0.1",
-		Port:          3323,
-		MetricsPort:   9476,
-		ImmudbAddress: "127.0.0.1",
-		ImmudbPort:    3322,
-		Audit:         false,
-		AuditInterval: 5 * time.Minute,
-		AuditUsername: "immugwauditor",
-		AuditPassword: "",
-		Detached:      false,
-		MTLs:          false,
-		Config:        "configs/immugw.toml",
-		Pidfile:       "",
-		Logfile:       "",
+		Dir:                  ".",
+		Address:              "127.0.0.1",
+		Port:                 3323,
+		MetricsPort:          9476,
+		ImmudbAddress:        "127.0.0.1",
+		ImmudbPort:           3322,
+		Audit:                false,
+		AuditInterval:        5 * time.Minute,
+		AuditUsername:        "immugwauditor",
+		AuditPassword:        "",
+		ProofService:         false,
+		ProofServiceInterval: time.Minute,
+		ProofServiceUsername: "",
+		ProofServicePassword: "",
+		Detached:             false,
+		MTLs:                 false,
+		Config:               "configs/immugw.toml",
+		Pidfile:              "",
+		Logfile:              "",
 	}
 }
 
@@ -119,6 +128,30 @@ func (o Options) WithAuditPassword(auditPassword string) Options {
 	return o
 }
 
+// WithProofService sets ProofService
+func (o Options) WithProofService(proofService bool) Options {
+	o.ProofService = proofService
+	return o
+}
+
+// WithProofServiceInterval sets ProofServiceInterval
+func (o Options) WithProofServiceInterval(proofServiceInterval time.Duration) Options {
+	o.ProofServiceInterval = proofServiceInterval
+	return o
+}
+
+// WithProofServiceUsername sets ProofServiceUsername
+func (o Options) WithProofServiceUsername(proofServiceUsername string) Options {
+	o.ProofServiceUsername = proofServiceUsername
+	return o
+}
+
+// WithProofServicePassword sets ProofServicePassword
+func (o Options) WithProofServicePassword(proofServicePassword string) Options {
+	o.ProofServicePassword = proofServicePassword
+	return o
+}
+
 // WithMTLs sets MTLs
 func (o Options) WithMTLs(MTLs bool) Options {
 	o.MTLs = MTLs
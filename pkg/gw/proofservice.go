@@ -0,0 +1,58 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gw
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	immuclient "github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/client/proofservice"
+	"google.golang.org/grpc"
+)
+
+// newProofService returns a proof service on its own connection to immudb, logged in with the proof service
+// credentials when they are set
+func (s *ImmuGwServer) newProofService(ctx context.Context, ic immuclient.ImmuClient, dialOptions []grpc.DialOption) (*proofservice.Service, *grpc.ClientConn, error) {
+	if s.Options.ProofServiceUsername != "" {
+		password, err := auth.DecodeBase64Password(s.Options.ProofServicePassword)
+		if err != nil {
+			return nil, nil, err
+		}
+		resp, err := ic.Login(ctx, []byte(s.Options.ProofServiceUsername), []byte(password))
+		if err != nil {
+			return nil, nil, err
+		}
+		dialOptions = append(dialOptions, grpc.WithUnaryInterceptor(auth.ClientUnaryInterceptor(string(resp.GetToken()))))
+	}
+	conn, err := grpc.Dial(fmt.Sprintf("%s:%d", s.Options.ImmudbAddress, s.Options.ImmudbPort), dialOptions...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return proofservice.New(schema.NewImmuServiceClient(conn)), conn, nil
+}
+
+// withProofService serves the proof service API under /proof/ and everything else with handler
+func withProofService(handler http.Handler, service *proofservice.Service) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/proof/", http.StripPrefix("/proof", proofservice.NewHandler(service)))
+	mux.Handle("/", handler)
+	return mux
+}
@@ -112,6 +112,19 @@ func (s *ImmuGwServer) Start() error {
 		defer func() { <-auditorDone }()
 	}
 
+	if s.Options.ProofService {
+		proofs, conn, err := s.newProofService(ctx, ic, *cliOpts.DialOptions)
+		if err != nil {
+			s.Logger.Errorf("unable to create proof service: %s", err)
+			return err
+		}
+		defer conn.Close()
+		go proofs.Run(ctx, s.Options.ProofServiceInterval, func(err error) {
+			s.Logger.Warningf("proof service: %s", err)
+		})
+		handler = withProofService(handler, proofs)
+	}
+
 	go func() {
 		if err = http.ListenAndServe(s.Options.Address+":"+strconv.Itoa(s.Options.Port), handler); err != nil && err != http.ErrServerClosed {
 			s.Logger.Errorf("unable to launch immugw: %+s", err)
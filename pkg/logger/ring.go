@@ -0,0 +1,189 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// followBuffer is the number of lines a follower can lag behind before the following ones are dropped for it
+const followBuffer = 256
+
+// Line is a line logged through a RingLogger
+type Line struct {
+	Time    time.Time
+	Level   LogLevel
+	Message string
+}
+
+// String returns the name of the level
+func (l LogLevel) String() string {
+	switch l {
+	case LogDebug:
+		return "debug"
+	case LogInfo:
+		return "info"
+	case LogWarn:
+		return "warn"
+	case LogError:
+		return "error"
+	}
+	return fmt.Sprintf("level(%d)", l)
+}
+
+// ParseLogLevel returns the level with the given name, debug, info, warn or error
+func ParseLogLevel(name string) (LogLevel, error) {
+	switch strings.ToLower(name) {
+	case "debug":
+		return LogDebug, nil
+	case "info":
+		return LogInfo, nil
+	case "warn", "warning":
+		return LogWarn, nil
+	case "error":
+		return LogError, nil
+	}
+	return LogInfo, fmt.Errorf("unknown log level %s", name)
+}
+
+type ring struct {
+	sync.Mutex
+	lines     []Line
+	next      int
+	full      bool
+	followers map[chan Line]struct{}
+}
+
+// RingLogger forwards to a Logger and keeps the most recent lines in memory, so that they can be read back and
+// followed without access to the host where they are written
+type RingLogger struct {
+	Logger
+	level LogLevel
+	ring  *ring
+}
+
+// NewRingLogger returns a logger forwarding to l and keeping the last size lines
+func NewRingLogger(l Logger, size int) *RingLogger {
+	return NewRingLoggerWithLevel(l, size, logLevelFromEnvironment())
+}
+
+// NewRingLoggerWithLevel returns a logger forwarding to l and keeping the last size lines having at least the level
+func NewRingLoggerWithLevel(l Logger, size int, level LogLevel) *RingLogger {
+	if size < 1 {
+		size = 1
+	}
+	return &RingLogger{
+		Logger: l,
+		level:  level,
+		ring:   &ring{lines: make([]Line, size), followers: map[chan Line]struct{}{}},
+	}
+}
+
+// CloneWithLevel returns a logger with a different level sharing the same lines
+func (l *RingLogger) CloneWithLevel(level LogLevel) Logger {
+	return &RingLogger{Logger: l.Logger.CloneWithLevel(level), level: level, ring: l.ring}
+}
+
+// Errorf ...
+func (l *RingLogger) Errorf(f string, v ...interface{}) {
+	l.Logger.Errorf(f, v...)
+	l.record(LogError, f, v)
+}
+
+// Warningf ...
+func (l *RingLogger) Warningf(f string, v ...interface{}) {
+	l.Logger.Warningf(f, v...)
+	l.record(LogWarn, f, v)
+}
+
+// Infof ...
+func (l *RingLogger) Infof(f string, v ...interface{}) {
+	l.Logger.Infof(f, v...)
+	l.record(LogInfo, f, v)
+}
+
+// Debugf ...
+func (l *RingLogger) Debugf(f string, v ...interface{}) {
+	l.Logger.Debugf(f, v...)
+	l.record(LogDebug, f, v)
+}
+
+func (l *RingLogger) record(level LogLevel, f string, v []interface{}) {
+	if level < l.level {
+		return
+	}
+	line := Line{Time: time.Now(), Level: level, Message: strings.TrimRight(fmt.Sprintf(f, v...), "\n")}
+	r := l.ring
+	r.Lock()
+	defer r.Unlock()
+	r.lines[r.next] = line
+	r.next = (r.next + 1) % len(r.lines)
+	if r.next == 0 {
+		r.full = true
+	}
+	for c := range r.followers {
+		select {
+		case c <- line:
+		default:
+		}
+	}
+}
+
+// Lines returns the last n lines having at least the given level, oldest first, all of them when n is 0
+func (l *RingLogger) Lines(n int, level LogLevel) []Line {
+	r := l.ring
+	r.Lock()
+	defer r.Unlock()
+	return r.tail(n, level)
+}
+
+func (r *ring) tail(n int, level LogLevel) []Line {
+	var lines []Line
+	if r.full {
+		lines = append(lines, r.lines[r.next:]...)
+	}
+	lines = append(lines, r.lines[:r.next]...)
+	filtered := lines[:0]
+	for _, line := range lines {
+		if line.Level >= level {
+			filtered = append(filtered, line)
+		}
+	}
+	if n > 0 && len(filtered) > n {
+		filtered = filtered[len(filtered)-n:]
+	}
+	return filtered
+}
+
+// Follow returns the last n lines, as Lines does, and a channel receiving all the lines logged from then on. Lines
+// are dropped when the receiver falls behind. The returned function must be called to stop following.
+func (l *RingLogger) Follow(n int, level LogLevel) ([]Line, <-chan Line, func()) {
+	r := l.ring
+	r.Lock()
+	defer r.Unlock()
+	c := make(chan Line, followBuffer)
+	r.followers[c] = struct{}{}
+	stop := func() {
+		r.Lock()
+		defer r.Unlock()
+		delete(r.followers, c)
+	}
+	return r.tail(n, level), c, stop
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrAdminMethodOnDataPort is returned when an administrative method is invoked on the data port while an admin port is configured
+var ErrAdminMethodOnDataPort = status.New(codes.PermissionDenied, "administrative methods are only served on the admin port").Err()
+
+// ErrDataMethodOnAdminPort is returned when a data method is invoked on the admin port
+var ErrDataMethodOnAdminPort = status.New(codes.PermissionDenied, "data methods are not served on the admin port").Err()
+
+// adminMethods are served only on the admin port when one is configured
+var adminMethods = map[string]bool{
+	"ListUsers":                  true,
+	"GetUser":                    true,
+	"CreateUser":                 true,
+	"ChangePassword":             true,
+	"SetPermission":              true,
+	"DeactivateUser":             true,
+	"UpdateAuthConfig":           true,
+	"UpdateMTLSConfig":           true,
+	"PrintTree":                  true,
+	"Report":                     true,
+	"Usage":                      true,
+	"OnBehalfOfRecords":          true,
+	"StartupProgress":            true,
+	"Logs":                       true,
+	"BulkLoad":                   true,
+	"Dump":                       true,
+	"CreateDatabase":             true,
+	"CreateDatabaseFromTemplate": true,
+	"DeleteDatabase":             true,
+	"RestoreDatabase":            true,
+	"PurgeDatabase":              true,
+	"DeletedDatabaseList":        true,
+	"ChangePermission":           true,
+	"SetActiveUser":              true,
+}
+
+// sessionMethods are served on both ports so that clients can authenticate and select a database
+var sessionMethods = map[string]bool{
+	"Login":       true,
+	"Logout":      true,
+	"UseDatabase": true,
+	"Health":      true,
+}
+
+func methodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+// checkPort tells whether the method can be served on the admin port or on the data port
+func checkPort(fullMethod string, adminPort bool) error {
+	method := methodName(fullMethod)
+	if sessionMethods[method] {
+		return nil
+	}
+	if adminPort && !adminMethods[method] {
+		return ErrDataMethodOnAdminPort
+	}
+	if !adminPort && adminMethods[method] {
+		return ErrAdminMethodOnDataPort
+	}
+	return nil
+}
+
+func portInterceptors(adminPort bool) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	unary := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkPort(info.FullMethod, adminPort); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+	stream := func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkPort(info.FullMethod, adminPort); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+	return unary, stream
+}
+
+// startAdminServer serves the administrative methods on the admin port, with its own MTLS settings
+func (s *ImmuServer) startAdminServer(uis []grpc.UnaryServerInterceptor, sss []grpc.StreamServerInterceptor) error {
+	options := []grpc.ServerOption{}
+	if s.Options.AdminMTLs {
+		creds, err := s.mtlsServerOption(s.Options.AdminMTLsOptions)
+		if err != nil {
+			return err
+		}
+		options = append(options, creds)
+	}
+	sui, ssi := s.startupInterceptors(true)
+	ui, si := portInterceptors(true)
+	options = append(
+		options,
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(append(append([]grpc.UnaryServerInterceptor{sui}, uis...), ui)...)),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(append(append([]grpc.StreamServerInterceptor{ssi}, sss...), si)...)),
+	)
+
+	listener, err := net.Listen(s.Options.Network, s.Options.AdminBind())
+	if err != nil {
+		s.Logger.Errorf("Immudb unable to listen on the admin port: %s", err)
+		return err
+	}
+	s.AdminGrpcServer = grpc.NewServer(options...)
+	schema.RegisterImmuServiceServer(s.AdminGrpcServer, s)
+	grpc_prometheus.Register(s.AdminGrpcServer)
+	go func() {
+		if err := s.AdminGrpcServer.Serve(listener); err != nil {
+			s.Logger.Errorf("Admin server error: %s", err)
+		}
+	}()
+	return nil
+}
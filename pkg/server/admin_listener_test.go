@@ -0,0 +1,37 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckPort(t *testing.T) {
+	assert.NoError(t, checkPort("/immudb.schema.ImmuService/Set", false))
+	assert.Equal(t, ErrDataMethodOnAdminPort, checkPort("/immudb.schema.ImmuService/Set", true))
+
+	assert.NoError(t, checkPort("/immudb.schema.ImmuService/CreateUser", true))
+	assert.Equal(t, ErrAdminMethodOnDataPort, checkPort("/immudb.schema.ImmuService/CreateUser", false))
+
+	// clients list the databases they can use on the data port
+	assert.NoError(t, checkPort("/immudb.schema.ImmuService/DatabaseList", false))
+
+	assert.NoError(t, checkPort("/immudb.schema.ImmuService/Login", true))
+	assert.NoError(t, checkPort("/immudb.schema.ImmuService/Login", false))
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// minClientAPIVersion is the oldest client API version still served
+const minClientAPIVersion uint32 = 1
+
+// checkClientAPIVersion rejects clients whose API version is not served, 0 is sent by clients predating the handshake
+func checkClientAPIVersion(version uint32) error {
+	if version == 0 {
+		version = 1
+	}
+	if version < minClientAPIVersion {
+		return status.Errorf(codes.FailedPrecondition, "client API version %d is no longer supported, please upgrade the client to API version %d or later", version, minClientAPIVersion)
+	}
+	if version > schema.APIVersion {
+		return status.Errorf(codes.FailedPrecondition, "client API version %d is newer than the server API version %d, please upgrade the server", version, schema.APIVersion)
+	}
+	return nil
+}
+
+// checkContextAPIVersion checks the client API version sent in the request metadata, clients not sending it are
+// handled as those predating the handshake
+func checkContextAPIVersion(ctx context.Context) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+	v := md.Get(schema.APIVersionHeader)
+	if len(v) == 0 {
+		return nil
+	}
+	version, err := strconv.ParseUint(v[0], 10, 32)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid client API version %q", v[0])
+	}
+	return checkClientAPIVersion(uint32(version))
+}
+
+// capabilities returns the features served with the current options
+func (s *ImmuServer) capabilities() []string {
+	capabilities := []string{
+		schema.CapabilityStreams,
+		schema.CapabilityStructuredValues,
+		schema.CapabilityMultiDatabase,
+		schema.CapabilityBulkLoad,
+		schema.CapabilityImpersonation,
+		schema.CapabilityUsage,
+		schema.CapabilityCodecs,
+		schema.CapabilityLabels,
+		schema.CapabilityDatabaseRestore,
+		schema.CapabilityStartupProgress,
+	}
+	if s.Options.GetAuth() {
+		capabilities = append(capabilities, schema.CapabilityAuth)
+	}
+	if s.Options.AdminPort > 0 {
+		capabilities = append(capabilities, schema.CapabilityAdminPort)
+	}
+	if s.Options.LogSinkPort > 0 || s.Options.LogSinkSyslogPort > 0 {
+		capabilities = append(capabilities, schema.CapabilityLogSink)
+	}
+	if s.Options.LogBufferLines > 0 {
+		capabilities = append(capabilities, schema.CapabilityLogs)
+	}
+	return capabilities
+}
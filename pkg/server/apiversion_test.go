@@ -0,0 +1,70 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAPIVersionHandshake(t *testing.T) {
+	s := newInmemoryAuthServer()
+	s.Options = s.Options.WithAdminPort(3323)
+
+	r, err := s.Login(context.Background(), &schema.LoginRequest{User: []byte(auth.SysAdminUsername), Password: []byte(auth.SysAdminPassword)})
+	assert.NoError(t, err)
+	assert.Equal(t, schema.APIVersion, r.ApiVersion)
+	assert.True(t, schema.HasCapability(r.Capabilities, schema.CapabilityAuth))
+	assert.True(t, schema.HasCapability(r.Capabilities, schema.CapabilityAdminPort))
+	assert.False(t, schema.HasCapability(r.Capabilities, schema.CapabilityLogSink))
+	assert.True(t, schema.HasCapability(r.Capabilities, schema.CapabilityCodecs))
+	assert.True(t, schema.HasCapability(r.Capabilities, schema.CapabilityLabels))
+	assert.True(t, schema.HasCapability(r.Capabilities, schema.CapabilityDatabaseRestore))
+	assert.True(t, schema.HasCapability(r.Capabilities, schema.CapabilityStartupProgress))
+	assert.True(t, schema.HasCapability(r.Capabilities, schema.CapabilityLogs))
+
+	_, err = s.Login(context.Background(), &schema.LoginRequest{User: []byte(auth.SysAdminUsername), Password: []byte(auth.SysAdminPassword), ApiVersion: schema.APIVersion})
+	assert.NoError(t, err)
+	_, err = s.Login(context.Background(), &schema.LoginRequest{User: []byte(auth.SysAdminUsername), Password: []byte(auth.SysAdminPassword), ApiVersion: schema.APIVersion + 1})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	health, err := s.Health(context.Background(), &empty.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, schema.APIVersion, health.ApiVersion)
+	assert.Equal(t, r.Capabilities, health.Capabilities)
+
+	health, err = s.Health(metadata.NewIncomingContext(context.Background(), metadata.Pairs(schema.APIVersionHeader, strconv.FormatUint(uint64(schema.APIVersion), 10))), &empty.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, schema.APIVersion, health.ApiVersion)
+	_, err = s.Health(metadata.NewIncomingContext(context.Background(), metadata.Pairs(schema.APIVersionHeader, strconv.FormatUint(uint64(schema.APIVersion+1), 10))), &empty.Empty{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = s.Health(metadata.NewIncomingContext(context.Background(), metadata.Pairs(schema.APIVersionHeader, "x")), &empty.Empty{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	s.Options = s.Options.WithLogBufferLines(0)
+	health, err = s.Health(context.Background(), &empty.Empty{})
+	assert.NoError(t, err)
+	assert.False(t, schema.HasCapability(health.Capabilities, schema.CapabilityLogs))
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrNotAuthorized is returned when the authorization hook denies an operation without giving a reason
+var ErrNotAuthorized = status.New(codes.PermissionDenied, "operation denied by the authorization policy").Err()
+
+// Authorizer is invoked on each operation, before the built-in permission checks, with the logged in user,
+// the gRPC method name, the database selected by the user, the end user the request is executed on behalf of,
+// if any, and the keys the operation addresses. Returning an error denies the operation.
+// User and database are empty for calls made without a valid token, e.g. Login and Health.
+type Authorizer interface {
+	Authorize(ctx context.Context, req *schema.AuthorizationRequest) error
+}
+
+type grpcAuthorizer struct {
+	client schema.ImmuAuthorizerClient
+}
+
+// NewGrpcAuthorizer returns an Authorizer delegating each decision to an external ImmuAuthorizer service
+func NewGrpcAuthorizer(conn *grpc.ClientConn) Authorizer {
+	return &grpcAuthorizer{client: schema.NewImmuAuthorizerClient(conn)}
+}
+
+func (a *grpcAuthorizer) Authorize(ctx context.Context, req *schema.AuthorizationRequest) error {
+	res, err := a.client.Authorize(ctx, req)
+	if err != nil {
+		return status.Errorf(codes.PermissionDenied, "authorization service unavailable: %v", err)
+	}
+	if !res.Allowed {
+		if res.Reason != "" {
+			return status.Error(codes.PermissionDenied, res.Reason)
+		}
+		return ErrNotAuthorized
+	}
+	return nil
+}
+
+// requestKeys returns the keys addressed by a unary request, nil for the requests that don't address keys
+func requestKeys(req interface{}) [][]byte {
+	switch r := req.(type) {
+	case *schema.Key:
+		return [][]byte{r.Key}
+	case *schema.KeyValue:
+		return [][]byte{r.Key}
+	case *schema.StructuredKeyValue:
+		return [][]byte{r.Key}
+	case *schema.SafeSetOptions:
+		return [][]byte{r.GetKv().GetKey()}
+	case *schema.SafeSetSVOptions:
+		return [][]byte{r.GetSkv().GetKey()}
+	case *schema.SafeGetOptions:
+		return [][]byte{r.Key}
+	case *schema.KVList:
+		keys := make([][]byte, 0, len(r.KVs))
+		for _, kv := range r.KVs {
+			keys = append(keys, kv.GetKey())
+		}
+		return keys
+	case *schema.SKVList:
+		keys := make([][]byte, 0, len(r.SKVs))
+		for _, skv := range r.SKVs {
+			keys = append(keys, skv.GetKey())
+		}
+		return keys
+	case *schema.KeyList:
+		keys := make([][]byte, 0, len(r.Keys))
+		for _, k := range r.Keys {
+			keys = append(keys, k.GetKey())
+		}
+		return keys
+	case *schema.ScanOptions:
+		return [][]byte{r.Prefix}
+	case *schema.KeyPrefix:
+		return [][]byte{r.Prefix}
+	case *schema.ReferenceOptions:
+		return [][]byte{r.Reference, r.Key}
+	case *schema.SafeReferenceOptions:
+		return [][]byte{r.GetRo().GetReference(), r.GetRo().GetKey()}
+	case *schema.ZAddOptions:
+		return [][]byte{r.Set, r.Key}
+	case *schema.SafeZAddOptions:
+		return [][]byte{r.GetZopts().GetSet(), r.GetZopts().GetKey()}
+	case *schema.ZScanOptions:
+		return [][]byte{r.Set}
+	}
+	return nil
+}
+
+func (s *ImmuServer) authorize(ctx context.Context, fullMethod string, keys [][]byte) error {
+	req := &schema.AuthorizationRequest{
+		Method:     fullMethod[strings.LastIndex(fullMethod, "/")+1:],
+		OnBehalfOf: auth.GetOnBehalfOf(ctx),
+		Keys:       keys,
+	}
+	if jsUser, err := auth.GetLoggedInUser(ctx); err == nil {
+		req.User = jsUser.Username
+		if jsUser.DatabaseIndex >= 0 && jsUser.DatabaseIndex < int64(s.dbList.Length()) {
+			req.Database = s.dbList.GetByIndex(jsUser.DatabaseIndex).options.GetDbName()
+		}
+	}
+	return s.Options.authorizer.Authorize(ctx, req)
+}
+
+func (s *ImmuServer) authorizerUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := s.authorize(ctx, info.FullMethod, requestKeys(req)); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (s *ImmuServer) authorizerStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := s.authorize(ss.Context(), info.FullMethod, nil); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
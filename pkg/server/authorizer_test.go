@@ -0,0 +1,105 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type recordingAuthorizer struct {
+	requests []*schema.AuthorizationRequest
+	deny     string
+}
+
+func (a *recordingAuthorizer) Authorize(ctx context.Context, req *schema.AuthorizationRequest) error {
+	a.requests = append(a.requests, req)
+	if req.Method == a.deny {
+		return ErrNotAuthorized
+	}
+	return nil
+}
+
+type fakeAuthorizerClient struct {
+	res *schema.AuthorizationResponse
+	err error
+}
+
+func (c *fakeAuthorizerClient) Authorize(ctx context.Context, in *schema.AuthorizationRequest, opts ...grpc.CallOption) (*schema.AuthorizationResponse, error) {
+	return c.res, c.err
+}
+
+func TestAuthorizerInterceptor(t *testing.T) {
+	authorizer := &recordingAuthorizer{deny: "Set"}
+	s := newInmemoryAuthServer()
+	s.Options = s.Options.WithAuthorizer(authorizer)
+	ctx, err := loginSysAdmin(s)
+	assert.NoError(t, err)
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "done", nil
+	}
+	res, err := s.authorizerUnaryInterceptor(ctx, &schema.Key{Key: []byte("k")}, &grpc.UnaryServerInfo{FullMethod: "/immudb.schema.ImmuService/Get"}, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "done", res)
+	res, err = s.authorizerUnaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/immudb.schema.ImmuService/Set"}, handler)
+	assert.Equal(t, ErrNotAuthorized, err)
+	assert.Nil(t, res)
+	_, err = s.authorizerUnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/immudb.schema.ImmuService/Login"}, handler)
+	assert.NoError(t, err)
+
+	assert.Len(t, authorizer.requests, 3)
+	assert.Equal(t, &schema.AuthorizationRequest{User: auth.SysAdminUsername, Method: "Get", Database: s.Options.GetDefaultDbName(), Keys: [][]byte{[]byte("k")}}, authorizer.requests[0])
+	assert.Equal(t, &schema.AuthorizationRequest{Method: "Login"}, authorizer.requests[2])
+}
+
+func TestRequestKeys(t *testing.T) {
+	assert.Equal(t, [][]byte{[]byte("k")}, requestKeys(&schema.SafeSetOptions{Kv: &schema.KeyValue{Key: []byte("k")}}))
+	assert.Equal(t, [][]byte{[]byte("k1"), []byte("k2")}, requestKeys(&schema.KVList{KVs: []*schema.KeyValue{{Key: []byte("k1")}, {Key: []byte("k2")}}}))
+	assert.Equal(t, [][]byte{[]byte("k1"), []byte("k2")}, requestKeys(&schema.KeyList{Keys: []*schema.Key{{Key: []byte("k1")}, {Key: []byte("k2")}}}))
+	assert.Equal(t, [][]byte{[]byte("p")}, requestKeys(&schema.ScanOptions{Prefix: []byte("p")}))
+	assert.Equal(t, [][]byte{[]byte("ref"), []byte("k")}, requestKeys(&schema.SafeReferenceOptions{Ro: &schema.ReferenceOptions{Reference: []byte("ref"), Key: []byte("k")}}))
+	assert.Equal(t, [][]byte{[]byte("set"), []byte("k")}, requestKeys(&schema.ZAddOptions{Set: []byte("set"), Key: []byte("k")}))
+	assert.Nil(t, requestKeys(&schema.Index{Index: 1}))
+	assert.Nil(t, requestKeys(nil))
+}
+
+func TestGrpcAuthorizer(t *testing.T) {
+	client := &fakeAuthorizerClient{res: &schema.AuthorizationResponse{Allowed: true}}
+	authorizer := &grpcAuthorizer{client: client}
+	req := &schema.AuthorizationRequest{User: "user", Method: "Set", Database: "db"}
+	assert.NoError(t, authorizer.Authorize(context.Background(), req))
+
+	client.res = &schema.AuthorizationResponse{}
+	assert.Equal(t, ErrNotAuthorized, authorizer.Authorize(context.Background(), req))
+
+	client.res = &schema.AuthorizationResponse{Reason: "read only user"}
+	err := authorizer.Authorize(context.Background(), req)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, "read only user", status.Convert(err).Message())
+
+	client.err = errors.New("connection refused")
+	assert.Equal(t, codes.PermissionDenied, status.Code(authorizer.Authorize(context.Background(), req)))
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrBulkLoadDisabled is returned by BulkLoad when no bulk load directory is configured
+var ErrBulkLoadDisabled = status.New(codes.FailedPrecondition, "bulk load is disabled, no bulk load directory is configured").Err()
+
+// ErrBulkLoadFile is returned by BulkLoad when the file is not a regular file inside the bulk load directory
+var ErrBulkLoadFile = status.New(codes.InvalidArgument, "bulk load file must be a regular file inside the bulk load directory").Err()
+
+// BulkLoad ingests a bulk load file found in the bulk load directory into the selected database. When a batch fails
+// to commit, the returned status carries a BulkLoadReply detail telling how many batches are committed, so that
+// the load can be resumed.
+func (s *ImmuServer) BulkLoad(ctx context.Context, req *schema.BulkLoadRequest) (*schema.BulkLoadReply, error) {
+	s.Logger.Debugf("BulkLoad %+v", req)
+	ind, err := s.getDbIndexFromCtx(ctx, "BulkLoad")
+	if err != nil {
+		return nil, err
+	}
+	f, err := openBulkLoadFile(s.Options.BulkLoadDir, req.Filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	reply, err := s.dbList.GetByIndex(ind).BulkLoad(f, req.ResumeAfterBatches)
+	if err != nil && reply != nil && reply.Batches > 0 {
+		st := status.Convert(err)
+		if detailed, derr := st.WithDetails(reply); derr == nil {
+			st = detailed
+		}
+		s.Logger.Errorf("bulk load of %s interrupted after %d batches: %v", req.Filename, reply.Batches, err)
+		return nil, st.Err()
+	}
+	return reply, err
+}
+
+// openBulkLoadFile opens the regular file named name inside dir. Absolute names, names leaving dir, also through a
+// symlinked directory, and anything but regular files, such as symlinks, devices and named pipes, are rejected.
+func openBulkLoadFile(dir, name string) (*os.File, error) {
+	if dir == "" {
+		return nil, ErrBulkLoadDisabled
+	}
+	clean := filepath.Clean(name)
+	if name == "" || filepath.IsAbs(name) || clean == "." || clean == ".." ||
+		strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return nil, ErrBulkLoadFile
+	}
+	path := filepath.Join(dir, clean)
+	if fi, err := os.Lstat(path); err != nil || !fi.Mode().IsRegular() {
+		return nil, ErrBulkLoadFile
+	}
+	// the directories along the path may be symlinks, the resolved path must still be inside the resolved dir
+	root, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return nil, ErrBulkLoadFile
+	}
+	if path, err = filepath.EvalSymlinks(path); err != nil {
+		return nil, ErrBulkLoadFile
+	}
+	if rel, err := filepath.Rel(root, path); err != nil || rel == ".." ||
+		strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return nil, ErrBulkLoadFile
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, ErrBulkLoadFile
+	}
+	if fi, err := f.Stat(); err != nil || !fi.Mode().IsRegular() {
+		f.Close()
+		return nil, ErrBulkLoadFile
+	}
+	return f, nil
+}
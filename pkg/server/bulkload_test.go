@@ -0,0 +1,63 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenBulkLoadFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bulkload")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "sub"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "sub", "data.bulk"), []byte(`data`), 0644))
+	outside := filepath.Join(filepath.Dir(dir), filepath.Base(dir)+".outside")
+	require.NoError(t, ioutil.WriteFile(outside, []byte(`secret`), 0644))
+	defer os.Remove(outside)
+	require.NoError(t, os.Symlink(outside, filepath.Join(dir, "link.bulk")))
+	require.NoError(t, os.Symlink(filepath.Dir(dir), filepath.Join(dir, "parent")))
+	require.NoError(t, os.Symlink(filepath.Join(dir, "sub"), filepath.Join(dir, "inside")))
+	linkedDir := dir + ".link"
+	require.NoError(t, os.Symlink(dir, linkedDir))
+	defer os.Remove(linkedDir)
+
+	_, err = openBulkLoadFile("", "sub/data.bulk")
+	assert.Equal(t, ErrBulkLoadDisabled, err)
+
+	f, err := openBulkLoadFile(dir, "sub/../sub/data.bulk")
+	require.NoError(t, err)
+	f.Close()
+	f, err = openBulkLoadFile(dir, "inside/data.bulk")
+	require.NoError(t, err)
+	f.Close()
+	f, err = openBulkLoadFile(linkedDir, "sub/data.bulk")
+	require.NoError(t, err)
+	f.Close()
+
+	for _, name := range []string{"", ".", "sub", "missing.bulk", "link.bulk", outside,
+		"../" + filepath.Base(outside), "sub/../../" + filepath.Base(outside), "parent/" + filepath.Base(outside)} {
+		_, err = openBulkLoadFile(dir, name)
+		assert.Equal(t, ErrBulkLoadFile, err, name)
+	}
+}
@@ -95,6 +95,8 @@ func (s *corruptionChecker) checkLevel0(ctx context.Context) (err error) {
 	}
 	db := s.dbList.GetByIndex(int64(s.currentDbIndex))
 	s.currentDbIndex++
+	dbName := db.options.GetDbName()
+	Metrics.CorruptionChecksCounters.WithLabelValues(dbName).Inc()
 	var r *schema.Root
 	if r, err = db.Store.CurrentRoot(); err != nil {
 		s.Logger.Errorf("Error retrieving root: %s", err)
@@ -122,6 +124,7 @@ func (s *corruptionChecker) checkLevel0(ctx context.Context) (err error) {
 				},
 			}); err != nil {
 				if err == store.ErrInconsistentDigest {
+					Metrics.VerificationFailuresCounters.WithLabelValues(dbName).Inc()
 					auth.IsTampered = true
 					s.Logger.Errorf("insertion order index %d was tampered", id)
 					s.Wg.Done()
@@ -132,14 +135,17 @@ func (s *corruptionChecker) checkLevel0(ctx context.Context) (err error) {
 			verified := item.Proof.Verify(item.Proof.Leaf, *r)
 			s.Logger.Debugf("Item index %d, value %s, verified %t", item.Item.Index, item.Item.Value, verified)
 			if !verified {
+				Metrics.VerificationFailuresCounters.WithLabelValues(dbName).Inc()
 				s.Trusted = false
 				auth.IsTampered = true
 				s.Logger.Errorf(ErrConsistencyFail, item.Item.Index)
 				s.Wg.Done()
 				return
 			}
+			Metrics.VerifiedEntriesCounters.WithLabelValues(dbName).Inc()
 			time.Sleep(s.options.frequencySleepTime)
 		}
+		Metrics.LastVerifiedIndexGauges.WithLabelValues(dbName).Set(float64(r.Index))
 	}
 	s.Wg.Done()
 	s.sleep()
@@ -24,6 +24,7 @@ import (
 
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/logger"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -78,6 +79,32 @@ func TestCorruptionChecker(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestCorruptionCheckerMetrics(t *testing.T) {
+	dbList := NewDatabaseList()
+	db, closer := makeDb()
+	defer closer()
+	for i := 0; i < 3; i++ {
+		_, err := db.Set(&schema.KeyValue{Key: []byte(strconv.Itoa(i)), Value: []byte(strconv.Itoa(i))})
+		assert.NoError(t, err)
+	}
+	dbList.Append(db)
+
+	time.Sleep(500 * time.Millisecond)
+
+	cco := CCOptions{}
+	cco.iterationSleepTime = 1 * time.Millisecond
+	cco.frequencySleepTime = 1 * time.Millisecond
+	cco.singleiteration = true
+	cc := NewCorruptionChecker(cco, dbList, &mockLogger{})
+	assert.NoError(t, cc.Start(context.TODO()))
+
+	dbName := db.options.GetDbName()
+	assert.Equal(t, 1., testutil.ToFloat64(Metrics.CorruptionChecksCounters.WithLabelValues(dbName)))
+	assert.Equal(t, 3., testutil.ToFloat64(Metrics.VerifiedEntriesCounters.WithLabelValues(dbName)))
+	assert.Equal(t, 0., testutil.ToFloat64(Metrics.VerificationFailuresCounters.WithLabelValues(dbName)))
+	assert.Equal(t, 2., testutil.ToFloat64(Metrics.LastVerifiedIndexGauges.WithLabelValues(dbName)))
+}
+
 type mockLogger struct{}
 
 func (l *mockLogger) Errorf(f string, v ...interface{}) {}
@@ -17,23 +17,30 @@ limitations under the License.
 package server
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/codenotary/immudb/cmd/version"
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/logger"
 	"github.com/codenotary/immudb/pkg/store"
 	"github.com/dgraph-io/badger/v2/pb"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/empty"
 )
 
 //Db database instance
 type Db struct {
-	Store   *store.Store
-	Logger  logger.Logger
-	options *DbOptions
+	Store      *store.Store
+	Logger     logger.Logger
+	options    *DbOptions
+	tsGuard    *timestampGuard
+	deletionMu sync.RWMutex
+	deletion   *deletion
 }
 
 // OpenDb Opens an existing Database from disk
@@ -42,17 +49,20 @@ func OpenDb(op *DbOptions, log logger.Logger) (*Db, error) {
 	db := &Db{
 		Logger:  log,
 		options: op,
+		tsGuard: newTimestampGuard(op.GetTimeSource(), op.GetMaxTimestampSkew()),
 	}
 	dbDir := filepath.Join(op.GetDbRootPath(), op.GetDbName())
 	_, dbErr := os.Stat(dbDir)
 	if os.IsNotExist(dbErr) {
 		return nil, fmt.Errorf("Missing database directories")
 	}
-	db.Store, err = store.Open(store.DefaultOptions(dbDir, db.Logger))
+	storeOpts, badgerOpts := store.DefaultOptions(dbDir, db.Logger)
+	db.Store, err = store.Open(op.storeOptions(storeOpts), badgerOpts)
 	if err != nil {
 		db.Logger.Errorf("Unable to open store: %s", err)
 		return nil, err
 	}
+	db.tsGuard.load(db.Store)
 	return db, nil
 }
 
@@ -62,12 +72,13 @@ func NewDb(op *DbOptions, log logger.Logger) (*Db, error) {
 	db := &Db{
 		Logger:  log,
 		options: op,
+		tsGuard: newTimestampGuard(op.GetTimeSource(), op.GetMaxTimestampSkew()),
 	}
 	if op.GetInMemoryStore() {
 		db.Logger.Infof("Starting with in memory store")
 		storeOpts, badgerOpts := store.DefaultOptions("", db.Logger)
 		badgerOpts = badgerOpts.WithInMemory(true)
-		db.Store, err = store.Open(storeOpts, badgerOpts)
+		db.Store, err = store.Open(op.storeOptions(storeOpts), badgerOpts)
 		if err != nil {
 			db.Logger.Errorf("Unable to open store: %s", err)
 			return nil, err
@@ -81,7 +92,8 @@ func NewDb(op *DbOptions, log logger.Logger) (*Db, error) {
 			db.Logger.Errorf("Unable to create data folder: %s", err)
 			return nil, err
 		}
-		db.Store, err = store.Open(store.DefaultOptions(dbDir, db.Logger))
+		storeOpts, badgerOpts := store.DefaultOptions(dbDir, db.Logger)
+		db.Store, err = store.Open(op.storeOptions(storeOpts), badgerOpts)
 		if err != nil {
 			db.Logger.Errorf("Unable to open store: %s", err)
 			return nil, err
@@ -92,6 +104,9 @@ func NewDb(op *DbOptions, log logger.Logger) (*Db, error) {
 
 //Set ...
 func (d *Db) Set(kv *schema.KeyValue) (*schema.Index, error) {
+	if kv.SkipUnchanged {
+		return d.Store.Set(*kv, store.WithSkipUnchanged(bytes.Equal))
+	}
 	return d.Store.Set(*kv)
 }
 
@@ -106,6 +121,23 @@ func (d *Db) Get(k *schema.Key) (*schema.Item, error) {
 	return item, err
 }
 
+// GetCodecs returns the codecs the value of the key was encoded with when it was stored
+func (d *Db) GetCodecs(k *schema.Key) (*schema.Codecs, error) {
+	cs, err := d.Store.Codecs(*k)
+	if err != nil {
+		return nil, err
+	}
+	codecs := &schema.Codecs{}
+	for _, c := range cs {
+		codecs.Codecs = append(codecs.Codecs, &schema.Codec{
+			Id:   uint32(c.ID()),
+			Name: c.Name(),
+			Kind: c.Kind().String(),
+		})
+	}
+	return codecs, nil
+}
+
 // CurrentRoot ...
 func (d *Db) CurrentRoot(e *empty.Empty) (*schema.Root, error) {
 	root, err := d.Store.CurrentRoot()
@@ -121,7 +153,28 @@ func (d *Db) SetSV(skv *schema.StructuredKeyValue) (*schema.Index, error) {
 	if err != nil {
 		return nil, err
 	}
-	return d.Set(kv)
+	var index *schema.Index
+	err = d.tsGuard.write(func() (err error) {
+		if skv.SkipUnchanged {
+			index, err = d.Store.Set(*kv, store.WithSkipUnchanged(samePayload))
+		} else {
+			index, err = d.Set(kv)
+		}
+		return err
+	}, skv.GetValue().GetTimestamp())
+	if err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+// samePayload reports whether two structured values hold the same payload, regardless of their timestamps
+func samePayload(current, value []byte) bool {
+	var c, v schema.Content
+	if proto.Unmarshal(current, &c) != nil || proto.Unmarshal(value, &v) != nil {
+		return false
+	}
+	return bytes.Equal(c.Payload, v.Payload)
 }
 
 //GetSV ...
@@ -145,7 +198,7 @@ func (d *Db) SafeGet(opts *schema.SafeGetOptions) (*schema.SafeItem, error) {
 
 //SafeSetSV ...
 func (d *Db) SafeSetSV(sopts *schema.SafeSetSVOptions) (*schema.Proof, error) {
-	kv, err := sopts.Skv.ToKV()
+	kv, err := sopts.GetSkv().ToKV()
 	if err != nil {
 		return nil, err
 	}
@@ -153,7 +206,15 @@ func (d *Db) SafeSetSV(sopts *schema.SafeSetSVOptions) (*schema.Proof, error) {
 		Kv:        kv,
 		RootIndex: sopts.RootIndex,
 	}
-	return d.SafeSet(opts)
+	var proof *schema.Proof
+	err = d.tsGuard.write(func() (err error) {
+		proof, err = d.SafeSet(opts)
+		return err
+	}, sopts.GetSkv().GetValue().GetTimestamp())
+	if err != nil {
+		return nil, err
+	}
+	return proof, nil
 }
 
 //SafeGetSV ...
@@ -173,32 +234,36 @@ func (d *Db) SetBatch(kvl *schema.KVList) (*schema.Index, error) {
 
 //GetBatch ...
 func (d *Db) GetBatch(kl *schema.KeyList) (*schema.ItemList, error) {
-	list := &schema.ItemList{}
-	for _, key := range kl.Keys {
-		item, err := d.Store.Get(*key)
-		if err == nil || err == store.ErrKeyNotFound {
-			if item != nil {
-				list.Items = append(list.Items, item)
-			}
-		} else {
-			return nil, err
-		}
-	}
-	return list, nil
+	return d.Store.GetBatch(kl.Keys)
 }
 
 //SetBatchSV ...
 func (d *Db) SetBatchSV(skvl *schema.SKVList) (*schema.Index, error) {
+	timestamps := make([]uint64, len(skvl.GetSKVs()))
+	for i, skv := range skvl.GetSKVs() {
+		timestamps[i] = skv.GetValue().GetTimestamp()
+	}
 	kvl, err := skvl.ToKVList()
 	if err != nil {
 		return nil, err
 	}
-	return d.SetBatch(kvl)
+	var index *schema.Index
+	err = d.tsGuard.write(func() (err error) {
+		index, err = d.SetBatch(kvl)
+		return err
+	}, timestamps...)
+	if err != nil {
+		return nil, err
+	}
+	return index, nil
 }
 
 //GetBatchSV ...
 func (d *Db) GetBatchSV(kl *schema.KeyList) (*schema.StructuredItemList, error) {
 	list, err := d.GetBatch(kl)
+	if err != nil {
+		return nil, err
+	}
 	slist, err := list.ToSItemList()
 	if err != nil {
 		return nil, err
@@ -365,3 +430,18 @@ func (d *Db) Dump(in *empty.Empty, stream schema.ImmuService_DumpServer) error {
 func (d *Db) PrintTree() *schema.Tree {
 	return d.Store.GetTree()
 }
+
+// CopyFrom loads the whole content of src into the database, which must be empty
+func (d *Db) CopyFrom(src *Db) error {
+	return d.Store.CopyFrom(src.Store)
+}
+
+// Report ...
+func (d *Db) Report(opts *schema.ReportOptions) (*schema.StoreReport, error) {
+	return d.Store.Report(int(opts.PrefixLength))
+}
+
+// BulkLoad ...
+func (d *Db) BulkLoad(r io.ReadSeeker, skipBatches uint64) (*schema.BulkLoadReply, error) {
+	return d.Store.BulkLoad(r, skipBatches)
+}
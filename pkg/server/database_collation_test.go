@@ -0,0 +1,76 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/store"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/assert"
+)
+
+func scannedKeys(t *testing.T, s *ImmuServer, ctx context.Context) []string {
+	list, err := s.Scan(ctx, &schema.ScanOptions{Prefix: []byte("file")})
+	assert.NoError(t, err)
+	var keys []string
+	for _, item := range list.Items {
+		keys = append(keys, string(item.Key))
+	}
+	return keys
+}
+
+func TestDatabaseCollation(t *testing.T) {
+	dir := "collation_test"
+	defer os.RemoveAll(dir)
+	s := newDeletionServer(t, dir)
+	ctx, _ := loginSysAdmin(s)
+
+	_, err := s.CreateDatabase(ctx, &schema.Database{Databasename: "bad", Collation: "natural"})
+	assert.Equal(t, store.ErrInvalidCollation, err)
+	_, err = s.CreateDatabase(ctx, &schema.Database{Databasename: "files", Collation: "numeric"})
+	assert.NoError(t, err)
+	_, err = s.CreateDatabaseFromTemplate(ctx, &schema.DatabaseTemplateRequest{Databasename: "files2", Template: "files"})
+	assert.NoError(t, err)
+
+	filesCtx := useDatabase(t, s, ctx, "files")
+	for _, key := range []string{"file10", "file9", "file1"} {
+		_, err = s.Set(filesCtx, &schema.KeyValue{Key: []byte(key), Value: []byte(key)})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, []string{"file1", "file9", "file10"}, scannedKeys(t, s, filesCtx))
+
+	list, err := s.DatabaseList(ctx, &empty.Empty{})
+	assert.NoError(t, err)
+	collations := map[string]string{}
+	for _, db := range list.Databases {
+		collations[db.Databasename] = db.Collation
+	}
+	assert.Equal(t, "numeric", collations["files"])
+	assert.Equal(t, "numeric", collations["files2"])
+	assert.Equal(t, "binary", collations[s.Options.GetDefaultDbName()])
+
+	// the collation is read back from the store when the server restarts
+	s.CloseDatabases()
+	s = newDeletionServer(t, dir)
+	defer s.CloseDatabases()
+	ctx, _ = loginSysAdmin(s)
+	filesCtx = useDatabase(t, s, ctx, "files")
+	assert.Equal(t, []string{"file1", "file9", "file10"}, scannedKeys(t, s, filesCtx))
+}
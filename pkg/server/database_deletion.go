@@ -0,0 +1,267 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrDatabaseDeleted is returned to writes into a deleted database and when selecting it
+var ErrDatabaseDeleted = status.New(codes.FailedPrecondition, "database is deleted, restore it first").Err()
+
+// ErrDatabaseNotDeleted is returned when restoring or purging a database which is not deleted
+var ErrDatabaseNotDeleted = status.New(codes.FailedPrecondition, "database is not deleted").Err()
+
+// ErrDatabaseRetained is returned when purging a deleted database before the end of its restore window
+var ErrDatabaseRetained = status.New(codes.FailedPrecondition, "database can not be purged before the end of its restore window").Err()
+
+// ErrDatabasePurged is returned for every operation on a purged database, whose data is removed at the next start
+var ErrDatabasePurged = status.New(codes.NotFound, "database has been purged").Err()
+
+// ErrDatabaseNotDeletable is returned when deleting the default or the system database
+var ErrDatabaseNotDeletable = status.New(codes.InvalidArgument, "the default and system databases can not be deleted").Err()
+
+// deletionMarkerSuffix is appended to the database name to get the file, next to the database directory,
+// recording its deletion so that it survives restarts
+const deletionMarkerSuffix = ".deleted"
+
+// deletion records when a database was deleted and whether it has been purged
+type deletion struct {
+	DeletedAt time.Time `json:"deletedAt"`
+	Purged    bool      `json:"purged"`
+}
+
+func deletionMarker(op *DbOptions) string {
+	return filepath.Join(op.GetDbRootPath(), op.GetDbName()+deletionMarkerSuffix)
+}
+
+// readDeletion returns the deletion recorded for the database, nil if it is not deleted
+func readDeletion(op *DbOptions) (*deletion, error) {
+	data, err := ioutil.ReadFile(deletionMarker(op))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	del := &deletion{}
+	if err := json.Unmarshal(data, del); err != nil {
+		return nil, fmt.Errorf("corrupted deletion marker %s: %v", deletionMarker(op), err)
+	}
+	return del, nil
+}
+
+// getDeletion returns a copy of the deletion of the database, nil if it is not deleted
+func (d *Db) getDeletion() *deletion {
+	d.deletionMu.RLock()
+	defer d.deletionMu.RUnlock()
+	if d.deletion == nil {
+		return nil
+	}
+	del := *d.deletion
+	return &del
+}
+
+// setDeletion records the deletion of the database, nil restores it
+func (d *Db) setDeletion(del *deletion) error {
+	d.deletionMu.Lock()
+	defer d.deletionMu.Unlock()
+	if !d.options.GetInMemoryStore() {
+		if del == nil {
+			if err := os.Remove(deletionMarker(d.options)); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		} else {
+			data, err := json.Marshal(del)
+			if err != nil {
+				return err
+			}
+			if err := ioutil.WriteFile(deletionMarker(d.options), data, 0644); err != nil {
+				return err
+			}
+		}
+	}
+	d.deletion = del
+	return nil
+}
+
+// checkDeletion rejects writes into deleted databases and every operation on purged ones
+func (d *Db) checkDeletion(methodname string) error {
+	del := d.getDeletion()
+	switch {
+	case del == nil:
+		return nil
+	case del.Purged:
+		return ErrDatabasePurged
+	case writeMethods[methodname]:
+		return ErrDatabaseDeleted
+	}
+	return nil
+}
+
+// removePurgedDatabase removes the data of a purged database, it is called at startup before opening it
+func removePurgedDatabase(op *DbOptions) error {
+	if err := os.RemoveAll(filepath.Join(op.GetDbRootPath(), op.GetDbName())); err != nil {
+		return err
+	}
+	return os.Remove(deletionMarker(op))
+}
+
+func (s *ImmuServer) checkSysAdmin(ctx context.Context) error {
+	if !s.Options.GetAuth() {
+		return fmt.Errorf("this command is available only with authentication on")
+	}
+	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get loggedin user data")
+	}
+	if !user.IsSysAdmin {
+		return fmt.Errorf("Logged In user does not have permissions for this operation")
+	}
+	return nil
+}
+
+// deletableDb returns the user database with the given name
+func (s *ImmuServer) deletableDb(name string) (*Db, error) {
+	name = strings.ToLower(name)
+	if name == SystemdbName || name == s.Options.GetSystemAdminDbName() || name == s.Options.GetDefaultDbName() {
+		return nil, ErrDatabaseNotDeletable
+	}
+	ind, ok := s.databasenameToIndex[name]
+	if !ok {
+		return nil, fmt.Errorf("database %s does not exist", name)
+	}
+	return s.dbList.GetByIndex(ind), nil
+}
+
+func (s *ImmuServer) deletedDatabase(name string, del *deletion) *schema.DeletedDatabase {
+	return &schema.DeletedDatabase{
+		Databasename: name,
+		DeletedAt:    del.DeletedAt.Unix(),
+		PurgeableAt:  del.DeletedAt.Add(s.Options.DbRestoreWindow).Unix(),
+	}
+}
+
+// DeleteDatabase soft deletes a database: it is hidden from the database list, can not be selected and rejects
+// writes, while its data is retained until it is purged
+func (s *ImmuServer) DeleteDatabase(ctx context.Context, r *schema.Database) (*schema.DeletedDatabase, error) {
+	s.Logger.Debugf("DeleteDatabase %+v", r)
+	if err := s.checkSysAdmin(ctx); err != nil {
+		return nil, err
+	}
+	db, err := s.deletableDb(r.Databasename)
+	if err != nil {
+		return nil, err
+	}
+	if db.getDeletion() != nil {
+		return nil, fmt.Errorf("database %s is already deleted", db.options.GetDbName())
+	}
+	del := &deletion{DeletedAt: time.Now()}
+	if err = db.setDeletion(del); err != nil {
+		s.Logger.Errorf("unable to delete database %s: %v", db.options.GetDbName(), err)
+		return nil, err
+	}
+	s.Logger.Infof("database %s deleted", db.options.GetDbName())
+	return s.deletedDatabase(db.options.GetDbName(), del), nil
+}
+
+// RestoreDatabase restores a deleted database which has not been purged
+func (s *ImmuServer) RestoreDatabase(ctx context.Context, r *schema.Database) (*empty.Empty, error) {
+	s.Logger.Debugf("RestoreDatabase %+v", r)
+	if err := s.checkSysAdmin(ctx); err != nil {
+		return nil, err
+	}
+	db, err := s.deletableDb(r.Databasename)
+	if err != nil {
+		return nil, err
+	}
+	del := db.getDeletion()
+	if del == nil {
+		return nil, ErrDatabaseNotDeleted
+	}
+	if del.Purged {
+		return nil, ErrDatabasePurged
+	}
+	if err = db.setDeletion(nil); err != nil {
+		s.Logger.Errorf("unable to restore database %s: %v", db.options.GetDbName(), err)
+		return nil, err
+	}
+	s.Logger.Infof("database %s restored", db.options.GetDbName())
+	return &empty.Empty{}, nil
+}
+
+// PurgeDatabase permanently drops a deleted database once its restore window is over.
+// Its data is removed at the next start, the store is left open meanwhile as tokens and background tasks refer to
+// databases by index.
+func (s *ImmuServer) PurgeDatabase(ctx context.Context, r *schema.Database) (*empty.Empty, error) {
+	s.Logger.Debugf("PurgeDatabase %+v", r)
+	if err := s.checkSysAdmin(ctx); err != nil {
+		return nil, err
+	}
+	db, err := s.deletableDb(r.Databasename)
+	if err != nil {
+		return nil, err
+	}
+	del := db.getDeletion()
+	if del == nil {
+		return nil, ErrDatabaseNotDeleted
+	}
+	if del.Purged {
+		return nil, ErrDatabasePurged
+	}
+	if time.Since(del.DeletedAt) < s.Options.DbRestoreWindow {
+		return nil, ErrDatabaseRetained
+	}
+	del.Purged = true
+	if err = db.setDeletion(del); err != nil {
+		s.Logger.Errorf("unable to purge database %s: %v", db.options.GetDbName(), err)
+		return nil, err
+	}
+	s.Logger.Infof("database %s purged, its data will be removed at the next start", db.options.GetDbName())
+	return &empty.Empty{}, nil
+}
+
+// DeletedDatabaseList returns the deleted databases which can still be restored, sorted by name
+func (s *ImmuServer) DeletedDatabaseList(ctx context.Context, r *empty.Empty) (*schema.DeletedDatabaseList, error) {
+	s.Logger.Debugf("DeletedDatabaseList")
+	if err := s.checkSysAdmin(ctx); err != nil {
+		return nil, err
+	}
+	list := &schema.DeletedDatabaseList{}
+	for i := 0; i < s.dbList.Length(); i++ {
+		db := s.dbList.GetByIndex(int64(i))
+		if del := db.getDeletion(); del != nil && !del.Purged {
+			list.Databases = append(list.Databases, s.deletedDatabase(db.options.GetDbName(), del))
+		}
+	}
+	sort.Slice(list.Databases, func(i, j int) bool {
+		return list.Databases[i].Databasename < list.Databases[j].Databasename
+	})
+	return list, nil
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func newDeletionServer(t *testing.T, dir string) *ImmuServer {
+	s := DefaultServer()
+	s = s.WithOptions(s.Options.WithAuth(true).WithDir(dir).WithCorruptionCheck(false).WithDbRestoreWindow(time.Hour))
+	assert.NoError(t, s.loadDefaultDatabase(dir))
+	assert.NoError(t, s.loadSystemDatabase(dir))
+	assert.NoError(t, s.loadUserDatabases(dir))
+	return s
+}
+
+func useDatabase(t *testing.T, s *ImmuServer, ctx context.Context, name string) context.Context {
+	r, err := s.UseDatabase(ctx, &schema.Database{Databasename: name})
+	assert.NoError(t, err)
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+r.Token))
+}
+
+func databaseNames(t *testing.T, s *ImmuServer, ctx context.Context) []string {
+	list, err := s.DatabaseList(ctx, &empty.Empty{})
+	assert.NoError(t, err)
+	var names []string
+	for _, db := range list.Databases {
+		names = append(names, db.Databasename)
+	}
+	return names
+}
+
+func TestDatabaseDeletion(t *testing.T) {
+	dir := "deletion_test"
+	defer os.RemoveAll(dir)
+	s := newDeletionServer(t, dir)
+	ctx, _ := loginSysAdmin(s)
+
+	for _, name := range []string{"rome", "milan"} {
+		_, err := s.CreateDatabase(ctx, &schema.Database{Databasename: name})
+		assert.NoError(t, err)
+	}
+	romeCtx := useDatabase(t, s, ctx, "rome")
+	_, err := s.Set(romeCtx, &schema.KeyValue{Key: []byte("k"), Value: []byte("v")})
+	assert.NoError(t, err)
+
+	_, err = s.DeleteDatabase(ctx, &schema.Database{Databasename: s.Options.GetDefaultDbName()})
+	assert.Equal(t, ErrDatabaseNotDeletable, err)
+	_, err = s.RestoreDatabase(ctx, &schema.Database{Databasename: "rome"})
+	assert.Equal(t, ErrDatabaseNotDeleted, err)
+
+	deleted, err := s.DeleteDatabase(ctx, &schema.Database{Databasename: "rome"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(time.Hour/time.Second), deleted.PurgeableAt-deleted.DeletedAt)
+	assert.NotContains(t, databaseNames(t, s, ctx), "rome")
+	list, err := s.DeletedDatabaseList(ctx, &empty.Empty{})
+	assert.NoError(t, err)
+	assert.Len(t, list.Databases, 1)
+	_, err = s.UseDatabase(ctx, &schema.Database{Databasename: "rome"})
+	assert.Equal(t, ErrDatabaseDeleted, err)
+	_, err = s.Set(romeCtx, &schema.KeyValue{Key: []byte("k"), Value: []byte("v2")})
+	assert.Equal(t, ErrDatabaseDeleted, err)
+	item, err := s.Get(romeCtx, &schema.Key{Key: []byte("k")})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("v"), item.Value)
+
+	_, err = s.RestoreDatabase(ctx, &schema.Database{Databasename: "rome"})
+	assert.NoError(t, err)
+	assert.Contains(t, databaseNames(t, s, ctx), "rome")
+	_, err = s.Set(romeCtx, &schema.KeyValue{Key: []byte("k"), Value: []byte("v2")})
+	assert.NoError(t, err)
+
+	_, err = s.DeleteDatabase(ctx, &schema.Database{Databasename: "rome"})
+	assert.NoError(t, err)
+	_, err = s.PurgeDatabase(ctx, &schema.Database{Databasename: "rome"})
+	assert.Equal(t, ErrDatabaseRetained, err)
+	s.Options = s.Options.WithDbRestoreWindow(0)
+	_, err = s.PurgeDatabase(ctx, &schema.Database{Databasename: "rome"})
+	assert.NoError(t, err)
+	_, err = s.Get(romeCtx, &schema.Key{Key: []byte("k")})
+	assert.Equal(t, ErrDatabasePurged, err)
+	_, err = s.RestoreDatabase(ctx, &schema.Database{Databasename: "rome"})
+	assert.Equal(t, ErrDatabasePurged, err)
+
+	_, err = s.DeleteDatabase(ctx, &schema.Database{Databasename: "milan"})
+	assert.NoError(t, err)
+
+	// deletions survive restarts, purged databases are removed at startup
+	s.CloseDatabases()
+	s = newDeletionServer(t, dir)
+	ctx, _ = loginSysAdmin(s)
+	_, ok := s.databasenameToIndex["rome"]
+	assert.False(t, ok)
+	_, err = os.Stat(filepath.Join(dir, "rome"))
+	assert.True(t, os.IsNotExist(err))
+	list, err = s.DeletedDatabaseList(ctx, &empty.Empty{})
+	assert.NoError(t, err)
+	assert.Len(t, list.Databases, 1)
+	assert.Equal(t, "milan", list.Databases[0].Databasename)
+	assert.NotContains(t, databaseNames(t, s, ctx), "milan")
+	s.CloseDatabases()
+}
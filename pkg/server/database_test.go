@@ -18,6 +18,7 @@ package server
 
 import (
 	"bytes"
+	"io/ioutil"
 	"log"
 	"os"
 	"path"
@@ -737,3 +738,133 @@ func TestScan(t *testing.T) {
 		t.Errorf("Reference, expected %v, got %v", string(kv[0].Key), string(scanItem.Items[0].Value))
 	}
 }
+
+type fixedTimeSource time.Time
+
+func (f fixedTimeSource) Now() time.Time {
+	return time.Time(f)
+}
+
+func TestDbTimestampGuard(t *testing.T) {
+	now := time.Unix(1257894010, 0)
+	options := DefaultOption().WithDbName("EdithPiaf" + strconv.FormatInt(time.Now().UnixNano(), 10)).
+		WithInMemoryStore(true).WithCorruptionChecker(false).
+		WithMaxTimestampSkew(5 * time.Second).WithTimeSource(fixedTimeSource(now))
+	db, err := NewDb(options, logger.NewSimpleLogger("immudb ", os.Stderr))
+	if err != nil {
+		t.Fatalf("Error creating Db instance %s", err)
+	}
+	defer db.Store.Close()
+
+	skv := func(ts int64) *schema.StructuredKeyValue {
+		return &schema.StructuredKeyValue{Key: []byte("key"), Value: &schema.Content{Timestamp: uint64(ts), Payload: []byte("value")}}
+	}
+
+	if _, err = db.SetSV(skv(now.Unix())); err != nil {
+		t.Errorf("Error setting value with a valid timestamp: %s", err)
+	}
+	if _, err = db.SetSV(skv(now.Unix() - 3)); err != nil {
+		t.Errorf("Error setting value with a timestamp within the allowed skew: %s", err)
+	}
+	if _, err = db.SetSV(skv(now.Unix() - 10)); err != ErrTimestampTooOld {
+		t.Errorf("expected %v, got %v", ErrTimestampTooOld, err)
+	}
+	if _, err = db.SetSV(skv(now.Unix() + 10)); err != ErrTimestampInFuture {
+		t.Errorf("expected %v, got %v", ErrTimestampInFuture, err)
+	}
+	if _, err = db.SetSV(skv(0)); err != ErrTimestampNotSigned {
+		t.Errorf("expected %v, got %v", ErrTimestampNotSigned, err)
+	}
+	if _, err = db.SetBatchSV(Skv); err != ErrTimestampTooOld {
+		t.Errorf("expected %v, got %v", ErrTimestampTooOld, err)
+	}
+
+	// a write which fails doesn't move the guard forward
+	invalid := &schema.SKVList{SKVs: []*schema.StructuredKeyValue{
+		skv(now.Unix() + 4),
+		{Key: []byte{}, Value: &schema.Content{Timestamp: uint64(now.Unix() + 4), Payload: []byte("value")}},
+	}}
+	if _, err = db.SetBatchSV(invalid); err == nil {
+		t.Errorf("expected an error writing an empty key")
+	}
+	if _, err = db.SetSV(skv(now.Unix() - 3)); err != nil {
+		t.Errorf("Error setting value after a failed write: %s", err)
+	}
+}
+
+func TestDbTimestampGuardReopen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "immudb_tsguard")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	now := time.Unix(1257894010, 0)
+	options := DefaultOption().WithDbName("tsguard").WithDbRootPath(dir).WithCorruptionChecker(false).
+		WithMaxTimestampSkew(5 * time.Second).WithTimeSource(fixedTimeSource(now))
+	db, err := NewDb(options, logger.NewSimpleLogger("immudb ", os.Stderr))
+	if err != nil {
+		t.Fatalf("Error creating Db instance %s", err)
+	}
+	skv := &schema.StructuredKeyValue{Key: []byte("key"), Value: &schema.Content{Timestamp: uint64(now.Unix()), Payload: []byte("value")}}
+	if _, err = db.SetSV(skv); err != nil {
+		t.Fatalf("Error setting value: %s", err)
+	}
+	if _, err = db.Set(&schema.KeyValue{Key: []byte("raw"), Value: []byte("raw")}); err != nil {
+		t.Fatalf("Error setting value: %s", err)
+	}
+	db.Store.Close()
+
+	// the last committed timestamp is found again behind the entries which are not structured values
+	db, err = OpenDb(options, logger.NewSimpleLogger("immudb ", os.Stderr))
+	if err != nil {
+		t.Fatalf("Error opening Db instance %s", err)
+	}
+	defer db.Store.Close()
+	skv.Value.Timestamp = uint64(now.Unix() - 10)
+	if _, err = db.SetSV(skv); err != ErrTimestampTooOld {
+		t.Errorf("expected %v, got %v", ErrTimestampTooOld, err)
+	}
+}
+
+func TestDbTimestampGuardConcurrent(t *testing.T) {
+	now := time.Unix(1257894010, 0)
+	options := DefaultOption().WithDbName("EdithPiaf" + strconv.FormatInt(time.Now().UnixNano(), 10)).
+		WithInMemoryStore(true).WithCorruptionChecker(false).
+		WithMaxTimestampSkew(5 * time.Second).WithTimeSource(fixedTimeSource(now))
+	db, err := NewDb(options, logger.NewSimpleLogger("immudb ", os.Stderr))
+	if err != nil {
+		t.Fatalf("Error creating Db instance %s", err)
+	}
+	defer db.Store.Close()
+
+	// the first writer is held in the middle of its write while a second writer with an older timestamp comes in
+	writing := make(chan struct{})
+	release := make(chan struct{})
+	first := make(chan error)
+	go func() {
+		first <- db.tsGuard.write(func() error {
+			close(writing)
+			<-release
+			_, err := db.Set(&schema.KeyValue{Key: []byte("first"), Value: []byte("first")})
+			return err
+		}, uint64(now.Unix()))
+	}()
+	<-writing
+	second := make(chan error)
+	go func() {
+		_, err := db.SetSV(&schema.StructuredKeyValue{Key: []byte("second"), Value: &schema.Content{Timestamp: uint64(now.Unix() - 10), Payload: []byte("second")}})
+		second <- err
+	}()
+	select {
+	case err = <-second:
+		t.Fatalf("second writer was not held by the guard, got %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(release)
+	if err = <-first; err != nil {
+		t.Errorf("Error writing the first value: %s", err)
+	}
+	if err = <-second; err != ErrTimestampTooOld {
+		t.Errorf("expected %v, got %v", ErrTimestampTooOld, err)
+	}
+}
@@ -16,13 +16,29 @@ limitations under the License.
 
 package server
 
+import (
+	"time"
+
+	"github.com/codenotary/immudb/pkg/store"
+)
+
 //DbOptions database instance options
 type DbOptions struct {
 	//	dbDir             string
-	dbName            string
-	dbRootPath        string
-	corruptionChecker bool
-	inMemoryStore     bool
+	dbName             string
+	dbRootPath         string
+	corruptionChecker  bool
+	inMemoryStore      bool
+	maxResultItems     int
+	maxResultBytes     int
+	maxExecutionMemory int
+	maxTimestampSkew   time.Duration
+	timeSource         TimeSource
+	collation          store.Collation
+	writeValidator     WriteValidator
+	validatorPrefixes  []string
+	storeProfile       string
+	replayProgress     func(done, total uint64)
 }
 
 // DefaultOption Initialise Db Optionts to default values
@@ -79,3 +95,120 @@ func (o *DbOptions) WithInMemoryStore(inmemory bool) *DbOptions {
 func (o *DbOptions) GetInMemoryStore() bool {
 	return o.inMemoryStore
 }
+
+// WithMaxResultItems sets the maximum number of items a single query can return, 0 means no limit
+func (o *DbOptions) WithMaxResultItems(max int) *DbOptions {
+	o.maxResultItems = max
+	return o
+}
+
+// GetMaxResultItems returns the maximum number of items a single query can return
+func (o *DbOptions) GetMaxResultItems() int {
+	return o.maxResultItems
+}
+
+// WithMaxResultBytes sets the maximum size in bytes of a single query result, 0 means no limit
+func (o *DbOptions) WithMaxResultBytes(max int) *DbOptions {
+	o.maxResultBytes = max
+	return o
+}
+
+// GetMaxResultBytes returns the maximum size in bytes of a single query result
+func (o *DbOptions) GetMaxResultBytes() int {
+	return o.maxResultBytes
+}
+
+// WithMaxExecutionMemory sets the maximum memory in bytes a single query can hold while it runs, 0 means no limit
+func (o *DbOptions) WithMaxExecutionMemory(max int) *DbOptions {
+	o.maxExecutionMemory = max
+	return o
+}
+
+// GetMaxExecutionMemory returns the maximum memory in bytes a single query can hold while it runs
+func (o *DbOptions) GetMaxExecutionMemory() int {
+	return o.maxExecutionMemory
+}
+
+// WithMaxTimestampSkew sets how far the timestamp of a structured value can deviate from the time source
+// and from the previously committed ones, 0 disables the check
+func (o *DbOptions) WithMaxTimestampSkew(skew time.Duration) *DbOptions {
+	o.maxTimestampSkew = skew
+	return o
+}
+
+// GetMaxTimestampSkew returns the allowed timestamp skew
+func (o *DbOptions) GetMaxTimestampSkew() time.Duration {
+	return o.maxTimestampSkew
+}
+
+// WithTimeSource sets the trusted time source used to validate timestamps, nil means the system clock
+func (o *DbOptions) WithTimeSource(ts TimeSource) *DbOptions {
+	o.timeSource = ts
+	return o
+}
+
+// GetTimeSource returns the trusted time source used to validate timestamps
+func (o *DbOptions) GetTimeSource() TimeSource {
+	return o.timeSource
+}
+
+// WithCollation sets the key collation used by scans, it is recorded when the database is created
+func (o *DbOptions) WithCollation(collation store.Collation) *DbOptions {
+	o.collation = collation
+	return o
+}
+
+// GetCollation returns the requested key collation
+func (o *DbOptions) GetCollation() store.Collation {
+	return o.collation
+}
+
+// WithWriteValidator sets the validator consulted before committing the writes having at least one key with one of
+// the prefixes, all the writes when no prefix is given
+func (o *DbOptions) WithWriteValidator(validator WriteValidator, prefixes []string) *DbOptions {
+	o.writeValidator = validator
+	o.validatorPrefixes = prefixes
+	return o
+}
+
+// GetWriteValidator returns the validator consulted before committing writes
+func (o *DbOptions) GetWriteValidator() WriteValidator {
+	return o.writeValidator
+}
+
+// WithStoreProfile sets the named preset the store is tuned with, see store.Profiles
+func (o *DbOptions) WithStoreProfile(profile string) *DbOptions {
+	o.storeProfile = profile
+	return o
+}
+
+// GetStoreProfile returns the named preset the store is tuned with
+func (o *DbOptions) GetStoreProfile() string {
+	return o.storeProfile
+}
+
+// WithReplayProgress sets the function called while the tree entries not yet flushed are replayed when the
+// database is opened
+func (o *DbOptions) WithReplayProgress(progress func(done, total uint64)) *DbOptions {
+	o.replayProgress = progress
+	return o
+}
+
+// storeOptions applies the database level settings to the underlying store options
+func (o *DbOptions) storeOptions(opts store.Options) store.Options {
+	opts = opts.
+		WithMaxResultItems(o.maxResultItems).
+		WithMaxResultBytes(o.maxResultBytes).
+		WithMaxExecutionMemory(o.maxExecutionMemory).
+		WithCollation(o.collation).
+		WithProfile(o.storeProfile).
+		WithReplayProgress(o.replayProgress)
+	if o.writeValidator != nil {
+		prefixes := make([][]byte, len(o.validatorPrefixes))
+		for i, prefix := range o.validatorPrefixes {
+			prefixes[i] = []byte(prefix)
+		}
+		opts = opts.WithWriteValidator(newDbValidator(o.writeValidator, o.dbName), prefixes...)
+	}
+	return opts
+}
@@ -34,11 +34,15 @@ func TestDefaultOptions(t *testing.T) {
 	if op.GetInMemoryStore() {
 		t.Errorf("default in memory store not what expected")
 	}
+	if op.GetMaxResultItems() != 0 || op.GetMaxResultBytes() != 0 || op.GetMaxExecutionMemory() != 0 {
+		t.Errorf("default result limits not what expected")
+	}
 
 	DbName := "Charles_Aznavour"
 	rootpath := "rootpath"
 	op = DefaultOption().WithDbName(DbName).
-		WithDbRootPath(rootpath).WithCorruptionChecker(false).WithInMemoryStore(true)
+		WithDbRootPath(rootpath).WithCorruptionChecker(false).WithInMemoryStore(true).
+		WithMaxResultItems(10).WithMaxResultBytes(1024).WithMaxExecutionMemory(4096).WithStoreProfile("low-memory")
 	if op.GetDbName() != DbName {
 		t.Errorf("db name not set correctly , expected %s got %s", DbName, op.GetDbName())
 	}
@@ -52,4 +56,13 @@ func TestDefaultOptions(t *testing.T) {
 	if !op.GetInMemoryStore() {
 		t.Errorf("in  memory store not set correctly , expected %v got %v", false, op.GetInMemoryStore())
 	}
+	if op.GetMaxResultItems() != 10 || op.GetMaxResultBytes() != 1024 {
+		t.Errorf("result limits not set correctly , expected %d/%d got %d/%d", 10, 1024, op.GetMaxResultItems(), op.GetMaxResultBytes())
+	}
+	if op.GetMaxExecutionMemory() != 4096 {
+		t.Errorf("execution memory limit not set correctly , expected %d got %d", 4096, op.GetMaxExecutionMemory())
+	}
+	if op.GetStoreProfile() != "low-memory" {
+		t.Errorf("store profile not set correctly , expected %s got %s", "low-memory", op.GetStoreProfile())
+	}
 }
@@ -0,0 +1,172 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"encoding/binary"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/store/sysstore"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrImpersonationNotAllowed is returned when a user without admin permission on the selected database
+// tries to act on behalf of another user
+var ErrImpersonationNotAllowed = status.New(codes.PermissionDenied, "acting on behalf of another user requires admin permission on the selected database").Err()
+
+// checkImpersonation lets system admins and admins of the selected database execute a request on behalf of an
+// end user. Every impersonated request is logged with both the caller and the end user, and the record of the request
+// is returned so that writes can be persisted with recordOnBehalfOf. The record is nil when the request is not
+// impersonated.
+func (s *ImmuServer) checkImpersonation(ctx context.Context, fullMethod string) (*schema.OnBehalfOfRecord, error) {
+	onBehalfOf := auth.GetOnBehalfOf(ctx)
+	if onBehalfOf == "" {
+		return nil, nil
+	}
+	ind, usr, err := s.getLoggedInUserdataFromCtx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "please login first")
+	}
+	var database string
+	if ind >= 0 && ind < int64(s.dbList.Length()) {
+		database = s.dbList.GetByIndex(ind).options.GetDbName()
+	}
+	if !usr.IsSysAdmin && (database == "" || !usr.HasPermission(database, auth.PermissionAdmin)) {
+		return nil, ErrImpersonationNotAllowed
+	}
+	method := methodName(fullMethod)
+	s.Logger.Infof("%s calling %s on behalf of %s", usr.Username, method, onBehalfOf)
+	return &schema.OnBehalfOfRecord{
+		Database:   database,
+		Method:     method,
+		User:       usr.Username,
+		OnBehalfOf: onBehalfOf,
+	}, nil
+}
+
+// writtenIndex returns the index of the last entry written by a successful write
+func writtenIndex(res interface{}) (uint64, bool) {
+	switch r := res.(type) {
+	case *schema.Index:
+		return r.GetIndex(), r != nil
+	case *schema.Proof:
+		return r.GetIndex(), r != nil
+	case *schema.BulkLoadReply:
+		return r.GetIndex(), r.GetEntries() > 0
+	}
+	return 0, false
+}
+
+// onBehalfOfKey is the system database key of the record of the write ending at index in database
+func onBehalfOfKey(database string, index uint64) []byte {
+	key := make([]byte, len(database)+1+8)
+	copy(key, database)
+	binary.BigEndian.PutUint64(key[len(database)+1:], index)
+	return sysstore.AddKeyPrefix(key, sysstore.KeyPrefixOnBehalfOf)
+}
+
+// recordOnBehalfOf persists into the system database the record of a write executed on behalf of an end user.
+// Failures are logged only, since the write has already been committed.
+func (s *ImmuServer) recordOnBehalfOf(record *schema.OnBehalfOfRecord, res interface{}) {
+	index, ok := writtenIndex(res)
+	if !ok || s.dbList.Length() <= SystemDbIndex {
+		return
+	}
+	record.Index = index
+	record.Timestamp = time.Now().Unix()
+	value, err := proto.Marshal(record)
+	if err == nil {
+		_, err = s.dbList.GetByIndex(SystemDbIndex).Store.Set(schema.KeyValue{Key: onBehalfOfKey(record.Database, index), Value: value})
+	}
+	if err != nil {
+		s.Logger.Errorf("Unable to record %s calling %s on behalf of %s: %v", record.User, record.Method, record.OnBehalfOf, err)
+	}
+}
+
+func (s *ImmuServer) impersonationUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	record, err := s.checkImpersonation(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	res, err := handler(ctx, req)
+	if record != nil && err == nil && writeMethods[record.Method] {
+		s.recordOnBehalfOf(record, res)
+	}
+	return res, err
+}
+
+// replyServerStream keeps the last message sent, the reply of client streaming methods
+type replyServerStream struct {
+	grpc.ServerStream
+	reply interface{}
+}
+
+func (r *replyServerStream) SendMsg(m interface{}) error {
+	r.reply = m
+	return r.ServerStream.SendMsg(m)
+}
+
+func (s *ImmuServer) impersonationStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	record, err := s.checkImpersonation(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	if record == nil || !writeMethods[record.Method] {
+		return handler(srv, ss)
+	}
+	rs := &replyServerStream{ServerStream: ss}
+	if err = handler(srv, rs); err == nil {
+		s.recordOnBehalfOf(record, rs.reply)
+	}
+	return err
+}
+
+// OnBehalfOfRecords ...
+func (s *ImmuServer) OnBehalfOfRecords(ctx context.Context, req *schema.OnBehalfOfRequest) (*schema.OnBehalfOfList, error) {
+	s.Logger.Debugf("OnBehalfOfRecords")
+	if _, err := s.getDbIndexFromCtx(ctx, "OnBehalfOfRecords"); err != nil {
+		return nil, err
+	}
+	list := &schema.OnBehalfOfList{}
+	if s.dbList.Length() <= SystemDbIndex {
+		return list, nil
+	}
+	prefix := []byte{sysstore.KeyPrefixOnBehalfOf}
+	if req.GetDatabase() != "" {
+		prefix = append(append(prefix, req.GetDatabase()...), 0)
+	}
+	items, err := s.dbList.GetByIndex(SystemDbIndex).Store.Scan(schema.ScanOptions{Prefix: prefix})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items.Items {
+		record := &schema.OnBehalfOfRecord{}
+		if err := proto.Unmarshal(item.Value, record); err != nil {
+			return nil, err
+		}
+		if req.GetOnBehalfOf() == "" || req.GetOnBehalfOf() == record.OnBehalfOf {
+			list.Records = append(list.Records, record)
+		}
+	}
+	return list, nil
+}
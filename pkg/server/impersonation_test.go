@@ -0,0 +1,123 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func loginAs(t *testing.T, s *ImmuServer, adminCtx context.Context, username string, permission uint32) context.Context {
+	password := []byte("Service1!")
+	_, err := s.CreateUser(adminCtx, &schema.CreateUserRequest{
+		User:       []byte(username),
+		Password:   password,
+		Database:   s.Options.GetDefaultDbName(),
+		Permission: permission,
+	})
+	assert.NoError(t, err)
+	l, err := s.Login(context.Background(), &schema.LoginRequest{User: []byte(username), Password: password})
+	assert.NoError(t, err)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+string(l.Token)))
+	db, err := s.UseDatabase(ctx, &schema.Database{Databasename: s.Options.GetDefaultDbName()})
+	assert.NoError(t, err)
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+string(db.Token)))
+}
+
+func onBehalfOf(ctx context.Context, username string) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return metadata.NewIncomingContext(ctx, metadata.Join(md, metadata.Pairs(auth.OnBehalfOfHeader, username)))
+}
+
+func TestCheckImpersonation(t *testing.T) {
+	s := newInmemoryAuthServer()
+	ctx, err := loginSysAdmin(s)
+	assert.NoError(t, err)
+	method := "/immudb.schema.ImmuService/Set"
+
+	record, err := s.checkImpersonation(ctx, method)
+	assert.NoError(t, err)
+	assert.Nil(t, record)
+	record, err = s.checkImpersonation(onBehalfOf(ctx, "enduser"), method)
+	assert.NoError(t, err)
+	assert.Equal(t, &schema.OnBehalfOfRecord{Database: s.Options.GetDefaultDbName(), Method: "Set", User: auth.SysAdminUsername, OnBehalfOf: "enduser"}, record)
+
+	rwCtx := loginAs(t, s, ctx, "rwservice", auth.PermissionRW)
+	_, err = s.checkImpersonation(rwCtx, method)
+	assert.NoError(t, err)
+	_, err = s.checkImpersonation(onBehalfOf(rwCtx, "enduser"), method)
+	assert.Equal(t, ErrImpersonationNotAllowed, err)
+
+	adminCtx := loginAs(t, s, ctx, "adminservice", auth.PermissionAdmin)
+	_, err = s.checkImpersonation(onBehalfOf(adminCtx, "enduser"), method)
+	assert.NoError(t, err)
+
+	_, err = s.checkImpersonation(onBehalfOf(context.Background(), "enduser"), method)
+	assert.Error(t, err)
+}
+
+func TestOnBehalfOfRecords(t *testing.T) {
+	s := newInmemoryAuthServer()
+	ctx, err := loginSysAdmin(s)
+	assert.NoError(t, err)
+	adminCtx := loginAs(t, s, ctx, "adminservice", auth.PermissionAdmin)
+
+	set := &grpc.UnaryServerInfo{FullMethod: "/immudb.schema.ImmuService/Set"}
+	get := &grpc.UnaryServerInfo{FullMethod: "/immudb.schema.ImmuService/Get"}
+	setHandler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.Set(ctx, req.(*schema.KeyValue))
+	}
+	getHandler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.Get(ctx, req.(*schema.Key))
+	}
+	// only the impersonated writes are recorded
+	_, err = s.impersonationUnaryInterceptor(adminCtx, &schema.KeyValue{Key: []byte("k1"), Value: []byte("v1")}, set, setHandler)
+	assert.NoError(t, err)
+	res, err := s.impersonationUnaryInterceptor(onBehalfOf(adminCtx, "alice"), &schema.KeyValue{Key: []byte("k2"), Value: []byte("v2")}, set, setHandler)
+	assert.NoError(t, err)
+	_, err = s.impersonationUnaryInterceptor(onBehalfOf(adminCtx, "alice"), &schema.Key{Key: []byte("k2")}, get, getHandler)
+	assert.NoError(t, err)
+	_, err = s.impersonationUnaryInterceptor(onBehalfOf(ctx, "bob"), &schema.KeyValue{Key: []byte("k3"), Value: []byte("v3")}, set, setHandler)
+	assert.NoError(t, err)
+
+	_, err = s.OnBehalfOfRecords(adminCtx, &schema.OnBehalfOfRequest{})
+	assert.Error(t, err)
+	list, err := s.OnBehalfOfRecords(ctx, &schema.OnBehalfOfRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, list.Records, 2)
+	alice := list.Records[0]
+	assert.Equal(t, s.Options.GetDefaultDbName(), alice.Database)
+	assert.Equal(t, "Set", alice.Method)
+	assert.Equal(t, "adminservice", alice.User)
+	assert.Equal(t, "alice", alice.OnBehalfOf)
+	assert.Equal(t, res.(*schema.Index).Index, alice.Index)
+	assert.NotZero(t, alice.Timestamp)
+	assert.Equal(t, auth.SysAdminUsername, list.Records[1].User)
+
+	list, err = s.OnBehalfOfRecords(ctx, &schema.OnBehalfOfRequest{OnBehalfOf: "bob"})
+	assert.NoError(t, err)
+	assert.Len(t, list.Records, 1)
+	list, err = s.OnBehalfOfRecords(ctx, &schema.OnBehalfOfRequest{Database: "other"})
+	assert.NoError(t, err)
+	assert.Empty(t, list.Records)
+}
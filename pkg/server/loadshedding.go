@@ -0,0 +1,123 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrOverloaded is returned to low priority requests while the server is overloaded
+var ErrOverloaded = status.New(codes.ResourceExhausted, "server overloaded, retry later").Err()
+
+// loadCheckInterval is how long an overload check is reused before the load is measured again
+const loadCheckInterval = 250 * time.Millisecond
+
+// lowPriorityMethods are the expensive reads that are rejected first when the server is overloaded.
+// Writes, point reads, proofs, authentication and administrative methods are always served.
+var lowPriorityMethods = map[string]bool{
+	"Scan":       true,
+	"ScanSV":     true,
+	"ZScan":      true,
+	"ZScanSV":    true,
+	"IScan":      true,
+	"IScanSV":    true,
+	"History":    true,
+	"HistorySV":  true,
+	"Count":      true,
+	"GetBatch":   true,
+	"GetBatchSV": true,
+	"Dump":       true,
+	"Report":     true,
+	"PrintTree":  true,
+}
+
+// loadShedder measures the server load and tells whether low priority requests have to be rejected
+type loadShedder struct {
+	sync.Mutex
+	maxPendingEntries uint64
+	maxHeapBytes      uint64
+	pendingEntries    func() uint64
+	heapBytes         func() uint64
+	checkedAt         time.Time
+	overloaded        bool
+}
+
+func heapBytes() uint64 {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	return m.HeapAlloc
+}
+
+func (l *loadShedder) isOverloaded() bool {
+	l.Lock()
+	defer l.Unlock()
+	if time.Since(l.checkedAt) < loadCheckInterval {
+		return l.overloaded
+	}
+	l.checkedAt = time.Now()
+	l.overloaded = (l.maxPendingEntries > 0 && l.pendingEntries() >= l.maxPendingEntries) ||
+		(l.maxHeapBytes > 0 && l.heapBytes() >= l.maxHeapBytes)
+	return l.overloaded
+}
+
+// pendingEntries returns the entries of all the databases still waiting to be added into their merkle tree
+func (s *ImmuServer) pendingEntries() uint64 {
+	var pending uint64
+	for i := 0; i < s.dbList.Length(); i++ {
+		pending += s.dbList.GetByIndex(int64(i)).Store.PendingEntries()
+	}
+	return pending
+}
+
+func (s *ImmuServer) newLoadShedder() *loadShedder {
+	return &loadShedder{
+		maxPendingEntries: s.Options.ShedPendingEntries,
+		maxHeapBytes:      s.Options.ShedHeapBytes,
+		pendingEntries:    s.pendingEntries,
+		heapBytes:         heapBytes,
+	}
+}
+
+func (s *ImmuServer) shed(fullMethod string) error {
+	method := methodName(fullMethod)
+	if !lowPriorityMethods[method] || !s.loadShedder.isOverloaded() {
+		return nil
+	}
+	Metrics.ShedRequestsCounters.WithLabelValues(method).Inc()
+	return ErrOverloaded
+}
+
+func (s *ImmuServer) loadSheddingUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := s.shed(info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (s *ImmuServer) loadSheddingStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := s.shed(info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestLoadShedding(t *testing.T) {
+	s := newInmemoryAuthServer()
+	s.Options = s.Options.WithShedPendingEntries(10)
+	s.loadShedder = s.newLoadShedder()
+	assert.Equal(t, uint64(0), s.pendingEntries())
+
+	var pending uint64
+	s.loadShedder.pendingEntries = func() uint64 { return pending }
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &empty.Empty{}, nil
+	}
+	scan := &grpc.UnaryServerInfo{FullMethod: "/immudb.schema.ImmuService/Scan"}
+	set := &grpc.UnaryServerInfo{FullMethod: "/immudb.schema.ImmuService/Set"}
+
+	_, err := s.loadSheddingUnaryInterceptor(context.Background(), nil, scan, handler)
+	assert.NoError(t, err)
+
+	pending = 10
+	s.loadShedder.checkedAt = time.Time{}
+	_, err = s.loadSheddingUnaryInterceptor(context.Background(), nil, scan, handler)
+	assert.Equal(t, ErrOverloaded, err)
+	_, err = s.loadSheddingUnaryInterceptor(context.Background(), nil, set, handler)
+	assert.NoError(t, err)
+
+	// the last check is reused until loadCheckInterval elapses
+	pending = 0
+	_, err = s.loadSheddingUnaryInterceptor(context.Background(), nil, scan, handler)
+	assert.Equal(t, ErrOverloaded, err)
+	s.loadShedder.checkedAt = time.Time{}
+	_, err = s.loadSheddingUnaryInterceptor(context.Background(), nil, scan, handler)
+	assert.NoError(t, err)
+
+	s.loadShedder.maxHeapBytes = 1
+	s.loadShedder.checkedAt = time.Time{}
+	_, err = s.loadSheddingUnaryInterceptor(context.Background(), nil, scan, handler)
+	assert.Equal(t, ErrOverloaded, err)
+}
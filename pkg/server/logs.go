@@ -0,0 +1,78 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrLogsNotRetained is returned by Logs when the server doesn't keep its recent log lines
+var ErrLogsNotRetained = status.New(codes.Unavailable, "log lines are not retained by the server").Err()
+
+// Logs streams the recent lines logged by the server and, when following, the new ones until the request is canceled
+func (s *ImmuServer) Logs(req *schema.LogRequest, stream schema.ImmuService_LogsServer) error {
+	if _, err := s.getDbIndexFromCtx(stream.Context(), "Logs"); err != nil {
+		return err
+	}
+	if s.logs == nil {
+		return ErrLogsNotRetained
+	}
+	level := logger.LogInfo
+	if req.Level != "" {
+		var err error
+		if level, err = logger.ParseLogLevel(req.Level); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if !req.Follow {
+		return sendLogLines(stream, s.logs.Lines(int(req.Lines), level))
+	}
+	lines, follow, stop := s.logs.Follow(int(req.Lines), level)
+	defer stop()
+	if err := sendLogLines(stream, lines); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case line := <-follow:
+			if line.Level < level {
+				continue
+			}
+			if err := sendLogLines(stream, []logger.Line{line}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func sendLogLines(stream schema.ImmuService_LogsServer, lines []logger.Line) error {
+	for _, line := range lines {
+		if err := stream.Send(&schema.LogLine{
+			LoggedAt: line.Time.UnixNano(),
+			Level:    line.Level.String(),
+			Message:  line.Message,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type logsStream struct {
+	mockServerStream
+	lines chan *schema.LogLine
+}
+
+func newLogsStream(ctx context.Context) *logsStream {
+	return &logsStream{mockServerStream: mockServerStream{ctx: ctx}, lines: make(chan *schema.LogLine, 16)}
+}
+
+func (s *logsStream) Send(line *schema.LogLine) error {
+	s.lines <- line
+	return nil
+}
+
+func (s *logsStream) messages() []string {
+	var messages []string
+	for len(s.lines) > 0 {
+		messages = append(messages, (<-s.lines).Message)
+	}
+	return messages
+}
+
+func TestServerLogs(t *testing.T) {
+	s := newInmemoryAuthServer()
+	ctx, err := loginSysAdmin(s)
+	assert.NoError(t, err)
+
+	stream := newLogsStream(ctx)
+	assert.Equal(t, ErrLogsNotRetained, s.Logs(&schema.LogRequest{}, stream))
+
+	s.logs = logger.NewRingLoggerWithLevel(&mockLogger{}, 3, logger.LogInfo)
+	s.logs.Infof("one")
+	s.logs.Debugf("debug")
+	s.logs.Warningf("two")
+	s.logs.Errorf("three")
+	s.logs.Infof("four")
+
+	assert.NoError(t, s.Logs(&schema.LogRequest{}, stream))
+	assert.Equal(t, []string{"two", "three", "four"}, stream.messages())
+
+	assert.NoError(t, s.Logs(&schema.LogRequest{Lines: 1, Level: "warn"}, stream))
+	assert.Equal(t, []string{"three"}, stream.messages())
+
+	err = s.Logs(&schema.LogRequest{Level: "verbose"}, stream)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	adminCtx := loginAs(t, s, ctx, "dbadmin", auth.PermissionAdmin)
+	assert.Error(t, s.Logs(&schema.LogRequest{}, newLogsStream(adminCtx)))
+}
+
+func TestServerLogsFollow(t *testing.T) {
+	s := newInmemoryAuthServer()
+	s.logs = logger.NewRingLoggerWithLevel(&mockLogger{}, 10, logger.LogInfo)
+	ctx, err := loginSysAdmin(s)
+	assert.NoError(t, err)
+	s.logs.Infof("before")
+
+	ctx, cancel := context.WithCancel(ctx)
+	stream := newLogsStream(ctx)
+	done := make(chan error)
+	go func() {
+		done <- s.Logs(&schema.LogRequest{Lines: 1, Level: "warn", Follow: true}, stream)
+	}()
+
+	receive := func() string {
+		select {
+		case line := <-stream.lines:
+			return line.Level + " " + line.Message
+		case <-time.After(5 * time.Second):
+			return "timeout"
+		}
+	}
+	s.logs.Infof("filtered")
+	s.logs.Warningf("after %d", 1)
+	assert.Equal(t, "warn after 1", receive())
+
+	cancel()
+	assert.NoError(t, <-done)
+}
@@ -0,0 +1,418 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/logger"
+	"github.com/golang/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/status"
+)
+
+// ErrLogSinkDatabase is returned at startup when the log sink database does not exist
+var ErrLogSinkDatabase = errors.New("log sink database does not exist")
+
+// ErrLogSinkToken is returned at startup when the log sink HTTP endpoint is exposed beyond the loopback interface
+// without a token
+var ErrLogSinkToken = errors.New("log sink token is required when the log sink address is not a loopback address")
+
+// errInvalidLogStream is returned for stream names which would not make a plain key segment
+var errInvalidLogStream = errors.New("log stream names can only contain letters, digits, '.', '_' and '-'")
+
+// logStreamName matches the stream names accepted by the HTTP endpoint
+var logStreamName = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// errInvalidLogEvent is returned for request bodies which are not JSON objects, arrays of objects or newline delimited objects
+var errInvalidLogEvent = errors.New("log events must be JSON objects")
+
+// logSinkFlushInterval is how long syslog messages are buffered before being written
+const logSinkFlushInterval = time.Second
+
+// maxLogRequestBytes is the maximum size of a log sink HTTP request body
+const maxLogRequestBytes = 32 << 20
+
+// maxSyslogMessageBytes is the maximum size of a syslog datagram
+const maxSyslogMessageBytes = 64 << 10
+
+// maxPendingSyslogMessages is how many syslog messages are kept while they can not be written, the oldest ones are
+// dropped beyond it
+const maxPendingSyslogMessages = 64 << 10
+
+// syslogStream is the stream syslog messages are written to
+const syslogStream = "syslog"
+
+// LogSinkUser is the user the log sink writes are authorized and accounted as
+const LogSinkUser = "logsink"
+
+// logSinkMethod is the method the log sink writes are checked as
+const logSinkMethod = "SetBatchSV"
+
+// logSinkWriter writes a batch of log events
+type logSinkWriter func(batch *schema.SKVList) (*schema.Index, error)
+
+// logSink writes the log events received over HTTP or syslog into a database, under
+// <prefix><stream>/<receive time in nanoseconds>-<sequence>, so that scanning a stream returns its events in order.
+// Each event is stored as a structured value holding its JSON encoding.
+type logSink struct {
+	sync.Mutex
+	writeBatch logSinkWriter
+	prefix     string
+	token      string
+	batchSize  int
+	seq        uint64
+	pending    []*schema.StructuredKeyValue
+	failed     bool
+	log        logger.Logger
+	httpServer *http.Server
+	syslogConn net.PacketConn
+	done       chan struct{}
+}
+
+type syslogEvent struct {
+	Facility *int   `json:"facility,omitempty"`
+	Severity *int   `json:"severity,omitempty"`
+	Remote   string `json:"remote"`
+	Message  string `json:"message"`
+}
+
+// newLogSink returns a sink writing its batches with writeBatch, the HTTP endpoint requiring the bearer token when it
+// is not empty
+func newLogSink(writeBatch logSinkWriter, prefix string, batchSize int, token string, log logger.Logger) *logSink {
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+	return &logSink{writeBatch: writeBatch, prefix: prefix, token: token, batchSize: batchSize, log: log, done: make(chan struct{})}
+}
+
+// logSinkWriter returns the writer of the log sink into db. The writes go through the same checks as the SetBatchSV
+// calls of the LogSinkUser: the deletion of the database, the authorization hook and the write quotas, while the
+// timestamp guard is enforced by the database.
+func (s *ImmuServer) logSinkWriter(db *Db) logSinkWriter {
+	return func(batch *schema.SKVList) (*schema.Index, error) {
+		if err := db.checkDeletion(logSinkMethod); err != nil {
+			return nil, err
+		}
+		if s.Options.authorizer != nil {
+			ctx, cancel := context.WithTimeout(context.Background(), validationTimeout)
+			defer cancel()
+			if err := s.Options.authorizer.Authorize(ctx, &schema.AuthorizationRequest{
+				Method:   logSinkMethod,
+				User:     LogSinkUser,
+				Database: db.options.GetDbName(),
+				Keys:     requestKeys(batch),
+			}); err != nil {
+				return nil, err
+			}
+		}
+		softExceeded, firstWarning, err := s.usage.check(LogSinkUser, true)
+		if err != nil {
+			return nil, err
+		}
+		if softExceeded && firstWarning {
+			s.Logger.Warningf("user %s exceeded the soft write quota of %d bytes", LogSinkUser, s.Options.QuotaSoftBytes)
+		}
+		index, err := db.SetBatchSV(batch)
+		if err != nil {
+			return nil, err
+		}
+		s.usage.written(LogSinkUser, uint64(proto.Size(batch)))
+		return index, nil
+	}
+}
+
+func isLoopback(address string) bool {
+	ip := net.ParseIP(address)
+	return address == "localhost" || (ip != nil && ip.IsLoopback())
+}
+
+// startLogSink starts the enabled log sink endpoints
+func (s *ImmuServer) startLogSink() error {
+	ind, ok := s.databasenameToIndex[s.Options.LogSinkDatabase]
+	if !ok {
+		s.Logger.Errorf("Log sink database %s does not exist", s.Options.LogSinkDatabase)
+		return ErrLogSinkDatabase
+	}
+	if s.Options.LogSinkPort > 0 && s.Options.LogSinkToken == "" && !isLoopback(s.Options.LogSinkAddress) {
+		s.Logger.Errorf("Log sink listening on %s requires a token", s.Options.LogSinkAddress)
+		return ErrLogSinkToken
+	}
+	sink := newLogSink(s.logSinkWriter(s.dbList.GetByIndex(ind)), s.Options.LogSinkPrefix, s.Options.LogSinkBatchSize, s.Options.LogSinkToken, s.Logger)
+	if s.Options.LogSinkSyslogPort > 0 {
+		conn, err := net.ListenPacket("udp", s.Options.LogSinkSyslogBind())
+		if err != nil {
+			s.Logger.Errorf("Log sink unable to listen for syslog messages: %s", err)
+			return err
+		}
+		sink.syslogConn = conn
+		go sink.serveSyslog()
+		go sink.flushPeriodically()
+	}
+	if s.Options.LogSinkPort > 0 {
+		listener, err := net.Listen("tcp", s.Options.LogSinkBind())
+		if err != nil {
+			s.Logger.Errorf("Log sink unable to listen: %s", err)
+			sink.stop()
+			return err
+		}
+		sink.httpServer = &http.Server{Handler: sink}
+		go func() {
+			if err := sink.httpServer.Serve(listener); err != nil && err != http.ErrServerClosed {
+				s.Logger.Errorf("Log sink http error: %s", err)
+			}
+		}()
+	}
+	s.logSink = sink
+	return nil
+}
+
+// stop closes the endpoints and writes the buffered syslog messages
+func (l *logSink) stop() {
+	if l.httpServer != nil {
+		l.httpServer.Close()
+	}
+	if l.syslogConn != nil {
+		close(l.done)
+		l.syslogConn.Close()
+		l.flush()
+	}
+}
+
+func (l *logSink) key(stream string, now time.Time) []byte {
+	l.seq++
+	return []byte(fmt.Sprintf("%s%s/%020d-%010d", l.prefix, stream, now.UnixNano(), l.seq))
+}
+
+// write stores the events of a stream in batches of at most batchSize, returning the index of the last one
+func (l *logSink) write(stream string, events [][]byte) (*schema.Index, error) {
+	l.Lock()
+	defer l.Unlock()
+	now := time.Now()
+	skvs := make([]*schema.StructuredKeyValue, len(events))
+	for i, event := range events {
+		skvs[i] = &schema.StructuredKeyValue{Key: l.key(stream, now), Value: &schema.Content{Payload: event}}
+	}
+	index, _, err := l.writeBatches(skvs)
+	return index, err
+}
+
+// writeBatches writes the values in batches of at most batchSize, timestamped when written, and returns the index of
+// the last one and how many were written before an error
+func (l *logSink) writeBatches(skvs []*schema.StructuredKeyValue) (index *schema.Index, written int, err error) {
+	for written < len(skvs) {
+		n := l.batchSize
+		if n > len(skvs)-written {
+			n = len(skvs) - written
+		}
+		batch := &schema.SKVList{SKVs: skvs[written : written+n]}
+		now := uint64(time.Now().Unix())
+		for _, skv := range batch.SKVs {
+			skv.Value.Timestamp = now
+		}
+		if index, err = l.writeBatch(batch); err != nil {
+			return nil, written, err
+		}
+		written += n
+	}
+	return index, written, nil
+}
+
+// ServeHTTP accepts POST /<stream> requests whose body is a JSON object, an array of objects or newline delimited
+// objects, and answers once all the events are written with their count and the index of the last one. When the
+// sink has a token, requests must carry it in an "Authorization: Bearer <token>" header.
+func (l *logSink) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if l.token != "" && subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte("Bearer "+l.token)) != 1 {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	stream := strings.Trim(r.URL.Path, "/")
+	if stream == "" {
+		stream = "http"
+	}
+	if !logStreamName.MatchString(stream) {
+		http.Error(w, errInvalidLogStream.Error(), http.StatusBadRequest)
+		return
+	}
+	events, err := decodeLogEvents(http.MaxBytesReader(w, r.Body, maxLogRequestBytes))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(events) == 0 {
+		http.Error(w, errInvalidLogEvent.Error(), http.StatusBadRequest)
+		return
+	}
+	index, err := l.write(stream, events)
+	if err != nil {
+		l.log.Errorf("Log sink unable to write %d events: %s", len(events), err)
+		http.Error(w, err.Error(), runtime.HTTPStatusFromCode(status.Code(err)))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprintf(w, "{\"events\":%d,\"index\":%d}\n", len(events), index.Index)
+}
+
+func decodeLogEvents(r io.Reader) ([][]byte, error) {
+	var events [][]byte
+	add := func(raw json.RawMessage) error {
+		if len(raw) == 0 || raw[0] != '{' {
+			return errInvalidLogEvent
+		}
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, raw); err != nil {
+			return err
+		}
+		events = append(events, buf.Bytes())
+		return nil
+	}
+	dec := json.NewDecoder(r)
+	for {
+		var raw json.RawMessage
+		err := dec.Decode(&raw)
+		if err == io.EOF {
+			return events, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if raw[0] != '[' {
+			if err := add(raw); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		var list []json.RawMessage
+		if err := json.Unmarshal(raw, &list); err != nil {
+			return nil, err
+		}
+		for _, item := range list {
+			if err := add(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// parseSyslog extracts the facility and severity from the <PRI> header shared by RFC 3164 and RFC 5424 messages,
+// the rest of the message is kept as is
+func parseSyslog(msg string, remote string) *syslogEvent {
+	event := &syslogEvent{Remote: remote, Message: strings.TrimRight(msg, "\r\n\x00")}
+	if !strings.HasPrefix(event.Message, "<") {
+		return event
+	}
+	end := strings.IndexByte(event.Message, '>')
+	if end < 2 || end > 4 {
+		return event
+	}
+	pri, err := strconv.Atoi(event.Message[1:end])
+	if err != nil || pri > 191 {
+		return event
+	}
+	facility, severity := pri/8, pri%8
+	event.Facility, event.Severity = &facility, &severity
+	event.Message = event.Message[end+1:]
+	return event
+}
+
+// receive buffers a syslog message, writing the buffer once it holds batchSize messages. After a failed write the
+// buffer is only retried by the periodic flush.
+func (l *logSink) receive(event *syslogEvent) {
+	value, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+	l.Lock()
+	if len(l.pending) >= maxPendingSyslogMessages {
+		l.log.Errorf("Log sink dropping the oldest syslog message, %d messages are waiting to be written", len(l.pending))
+		l.pending = l.pending[1:]
+	}
+	l.pending = append(l.pending, &schema.StructuredKeyValue{
+		Key:   l.key(syslogStream, time.Now()),
+		Value: &schema.Content{Payload: value},
+	})
+	full := len(l.pending) >= l.batchSize && !l.failed
+	l.Unlock()
+	if full {
+		l.flush()
+	}
+}
+
+// flush writes the buffered syslog messages, those which could not be written are kept to be retried
+func (l *logSink) flush() {
+	l.Lock()
+	defer l.Unlock()
+	if len(l.pending) == 0 {
+		return
+	}
+	_, written, err := l.writeBatches(l.pending)
+	l.pending = l.pending[written:]
+	l.failed = err != nil
+	if err != nil {
+		l.log.Errorf("Log sink unable to write %d syslog messages, they will be retried: %s", len(l.pending), err)
+	}
+	if len(l.pending) == 0 {
+		l.pending = nil
+	}
+}
+
+func (l *logSink) flushPeriodically() {
+	ticker := time.NewTicker(logSinkFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.done:
+			return
+		case <-ticker.C:
+			l.flush()
+		}
+	}
+}
+
+func (l *logSink) serveSyslog() {
+	buf := make([]byte, maxSyslogMessageBytes)
+	for {
+		n, addr, err := l.syslogConn.ReadFrom(buf)
+		if err != nil {
+			select {
+			case <-l.done:
+			default:
+				l.log.Errorf("Log sink syslog error: %s", err)
+			}
+			return
+		}
+		l.receive(parseSyslog(string(buf[:n]), addr.String()))
+	}
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogSinkHTTP(t *testing.T) {
+	s := newInmemoryAuthServer()
+	db := s.dbList.GetByIndex(DefaultDbIndex)
+	sink := newLogSink(s.logSinkWriter(db), "_logs/", 2, "", s.Logger)
+
+	body := "{\"level\":\"info\",\"msg\":\"one\"}\n{\"level\":\"warn\", \"msg\":\"two\"}\n[{\"msg\":\"three\"}]"
+	rec := httptest.NewRecorder()
+	sink.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/app", strings.NewReader(body)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\"events\":3")
+
+	list, err := db.ScanSV(&schema.ScanOptions{Prefix: []byte("_logs/app/")})
+	assert.NoError(t, err)
+	assert.Len(t, list.Items, 3)
+	assert.Equal(t, "{\"level\":\"info\",\"msg\":\"one\"}", string(list.Items[0].Value.Payload))
+	assert.Equal(t, "{\"level\":\"warn\",\"msg\":\"two\"}", string(list.Items[1].Value.Payload))
+	assert.Equal(t, "{\"msg\":\"three\"}", string(list.Items[2].Value.Payload))
+
+	for _, body := range []string{"", "\"text\"", "[1]", "{\"msg\":"} {
+		rec = httptest.NewRecorder()
+		sink.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/app", strings.NewReader(body)))
+		assert.Equal(t, http.StatusBadRequest, rec.Code, body)
+	}
+	for _, stream := range []string{"app/sub", "app%20x", "../app"} {
+		rec = httptest.NewRecorder()
+		sink.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/"+stream, strings.NewReader("{}")))
+		assert.Equal(t, http.StatusBadRequest, rec.Code, stream)
+	}
+	rec = httptest.NewRecorder()
+	sink.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/app", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestLogSinkHTTPToken(t *testing.T) {
+	s := newInmemoryAuthServer()
+	sink := newLogSink(s.logSinkWriter(s.dbList.GetByIndex(DefaultDbIndex)), "_logs/", 2, "secret", s.Logger)
+
+	for _, header := range []string{"", "Bearer wrong", "secret"} {
+		req := httptest.NewRequest(http.MethodPost, "/app", strings.NewReader("{}"))
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		rec := httptest.NewRecorder()
+		sink.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code, header)
+	}
+	req := httptest.NewRequest(http.MethodPost, "/app", strings.NewReader("{}"))
+	req.Header.Set("Authorization", "Bearer secret")
+	rec := httptest.NewRecorder()
+	sink.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestStartLogSinkRequiresToken(t *testing.T) {
+	s := newInmemoryAuthServer()
+	s.Options = s.Options.WithLogSinkAddress("0.0.0.0").WithLogSinkPort(3390)
+	assert.Equal(t, ErrLogSinkToken, s.startLogSink())
+}
+
+func TestLogSinkSyslog(t *testing.T) {
+	s := newInmemoryAuthServer()
+	db := s.dbList.GetByIndex(DefaultDbIndex)
+	sink := newLogSink(s.logSinkWriter(db), "_logs/", 2, "", s.Logger)
+
+	sink.receive(parseSyslog("<34>Oct 11 22:14:15 mymachine su: 'su root' failed\n", "10.0.0.1:514"))
+	list, err := db.ScanSV(&schema.ScanOptions{Prefix: []byte("_logs/syslog/")})
+	assert.NoError(t, err)
+	assert.Len(t, list.Items, 0)
+
+	sink.receive(parseSyslog("no priority", "10.0.0.2:514"))
+	sink.receive(parseSyslog("<13>1 2003-10-11T22:14:15.003Z host app - - - started", "10.0.0.3:514"))
+	sink.flush()
+	list, err = db.ScanSV(&schema.ScanOptions{Prefix: []byte("_logs/syslog/")})
+	assert.NoError(t, err)
+	assert.Len(t, list.Items, 3)
+
+	event := &syslogEvent{}
+	assert.NoError(t, json.Unmarshal(list.Items[0].Value.Payload, event))
+	assert.Equal(t, 4, *event.Facility)
+	assert.Equal(t, 2, *event.Severity)
+	assert.Equal(t, "10.0.0.1:514", event.Remote)
+	assert.Equal(t, "Oct 11 22:14:15 mymachine su: 'su root' failed", event.Message)
+
+	event = &syslogEvent{}
+	assert.NoError(t, json.Unmarshal(list.Items[1].Value.Payload, event))
+	assert.Nil(t, event.Facility)
+	assert.Equal(t, "no priority", event.Message)
+}
+
+func TestStartLogSinkUnknownDatabase(t *testing.T) {
+	s := newInmemoryAuthServer()
+	s.Options = s.Options.WithLogSinkDatabase("missing")
+	assert.Equal(t, ErrLogSinkDatabase, s.startLogSink())
+}
+
+func TestLogSinkChecks(t *testing.T) {
+	s := newInmemoryAuthServer()
+	ctx, err := loginSysAdmin(s)
+	assert.NoError(t, err)
+	authorizer := &recordingAuthorizer{}
+	s.Options = s.Options.WithAuthorizer(authorizer)
+	s.usage = newUsageTracker(0, 1)
+	_, err = s.CreateDatabase(ctx, &schema.Database{Databasename: "logs"})
+	assert.NoError(t, err)
+	sink := newLogSink(s.logSinkWriter(s.dbList.GetByIndex(s.databasenameToIndex["logs"])), "_logs/", 2, "", s.Logger)
+	post := func() int {
+		rec := httptest.NewRecorder()
+		sink.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/app", strings.NewReader("{}")))
+		return rec.Code
+	}
+
+	// the first write is accounted, then the hard quota of the log sink user rejects the next ones
+	assert.Equal(t, http.StatusOK, post())
+	assert.Len(t, authorizer.requests, 1)
+	assert.Equal(t, &schema.AuthorizationRequest{Method: "SetBatchSV", User: LogSinkUser, Database: "logs",
+		Keys: authorizer.requests[0].Keys}, authorizer.requests[0])
+	assert.Len(t, authorizer.requests[0].Keys, 1)
+	assert.Equal(t, http.StatusTooManyRequests, post())
+	assert.Equal(t, uint64(1), s.usage.list().Usages[0].WriteOperations)
+
+	s.usage = newUsageTracker(0, 0)
+	authorizer.deny = "SetBatchSV"
+	assert.Equal(t, http.StatusForbidden, post())
+
+	authorizer.deny = ""
+	_, err = s.DeleteDatabase(ctx, &schema.Database{Databasename: "logs"})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, post())
+}
+
+func TestLogSinkSyslogRetry(t *testing.T) {
+	s := newInmemoryAuthServer()
+	db := s.dbList.GetByIndex(DefaultDbIndex)
+	fail := true
+	write := s.logSinkWriter(db)
+	sink := newLogSink(func(batch *schema.SKVList) (*schema.Index, error) {
+		if fail {
+			return nil, errors.New("unavailable")
+		}
+		return write(batch)
+	}, "_logs/", 2, "", s.Logger)
+
+	for i := 0; i < 3; i++ {
+		sink.receive(parseSyslog("message", "10.0.0.1:514"))
+	}
+	assert.Len(t, sink.pending, 3)
+
+	fail = false
+	sink.flush()
+	assert.Empty(t, sink.pending)
+	list, err := db.ScanSV(&schema.ScanOptions{Prefix: []byte("_logs/syslog/")})
+	assert.NoError(t, err)
+	assert.Len(t, list.Items, 3)
+	for _, item := range list.Items {
+		assert.NotZero(t, item.Value.Timestamp)
+	}
+}
@@ -36,6 +36,11 @@ type MetricsCollection struct {
 	UptimeCounter                prometheus.CounterFunc
 	RPCsPerClientCounters        *prometheus.CounterVec
 	LastMessageAtPerClientGauges *prometheus.GaugeVec
+	ShedRequestsCounters         *prometheus.CounterVec
+	CorruptionChecksCounters     *prometheus.CounterVec
+	VerifiedEntriesCounters      *prometheus.CounterVec
+	VerificationFailuresCounters *prometheus.CounterVec
+	LastVerifiedIndexGauges      *prometheus.GaugeVec
 }
 
 var metricsNamespace = "immudb"
@@ -94,6 +99,46 @@ var Metrics = MetricsCollection{
 		},
 		[]string{"ip"},
 	),
+	ShedRequestsCounters: promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "number_of_shed_requests",
+			Help:      "Number of low priority requests rejected while the server was overloaded.",
+		},
+		[]string{"method"},
+	),
+	CorruptionChecksCounters: promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "number_of_corruption_checks",
+			Help:      "Number of corruption checker runs per database.",
+		},
+		[]string{"database"},
+	),
+	VerifiedEntriesCounters: promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "number_of_verified_entries",
+			Help:      "Number of entries whose inclusion proof has been verified by the corruption checker per database.",
+		},
+		[]string{"database"},
+	),
+	VerificationFailuresCounters: promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "number_of_verification_failures",
+			Help:      "Number of entries the corruption checker failed to verify per database, any of them is a tamper signal.",
+		},
+		[]string{"database"},
+	),
+	LastVerifiedIndexGauges: promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "last_verified_index",
+			Help:      "Root index up to which the corruption checker last verified all the entries of a database.",
+		},
+		[]string{"database"},
+	),
 }
 
 func init() {
@@ -116,23 +161,28 @@ func init() {
 	prometheus.MustRegister(expvarCollector)
 }
 
-// StartMetrics listens and servers the HTTP metrics server in a new goroutine.
-// The server is then returned and can be stopped using Close().
-func StartMetrics(
-	addr string,
-	l logger.Logger,
-	recordsCounter func() float64,
-	uptimeCounter func() float64,
-) *http.Server {
-	Metrics.WithRecordsCounter(recordsCounter)
-	Metrics.WithUptimeCounter(uptimeCounter)
+func metricsMux(startup http.Handler) *http.ServeMux {
 	// expvar package adds a handler in to the default HTTP server (which has to be started explicitly),
 	// and serves up the metrics at the /debug/vars endpoint.
 	// Here we're registering both expvar and promhttp handlers in our custom server.
 	mux := http.NewServeMux()
 	mux.Handle("/metrics", promhttp.Handler())
 	mux.Handle("/debug/vars", expvar.Handler())
-	server := &http.Server{Addr: addr, Handler: mux}
+	if startup != nil {
+		mux.Handle("/startup", startup)
+	}
+	return mux
+}
+
+// StartMetrics listens and servers the HTTP metrics server in a new goroutine.
+// The server is then returned and can be stopped using Close().
+// The counters are registered separately, once the databases they read from are loaded.
+func StartMetrics(
+	addr string,
+	l logger.Logger,
+	startup http.Handler,
+) *http.Server {
+	server := &http.Server{Addr: addr, Handler: metricsMux(startup)}
 	go func() {
 		if err := server.ListenAndServe(); err != nil {
 			if err == http.ErrServerClosed {
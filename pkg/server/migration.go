@@ -0,0 +1,185 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrFormatTooNew is returned at startup when the data directory has been written by a newer immudb release
+var ErrFormatTooNew = errors.New("data directory format is newer than the one supported by this immudb version, please upgrade immudb")
+
+// formatVersionFilename is the file, in the data directory, holding the version of its on-disk layout
+const formatVersionFilename = "format_version"
+
+// migration upgrades the data directory layout to the given version
+type migration struct {
+	version     uint32
+	description string
+	// apply rewrites the data directory, nil for migrations which only record the version
+	apply func(dataDir string) error
+}
+
+// migrations are the upgrades of the data directory layout, in version order.
+// A release changing the layout appends a migration and the data directory is upgraded in place at startup.
+var migrations = []migration{
+	{version: 1, description: "record the format version of data directories created before versioning"},
+	// entries written from version 2 on may be prefixed with codec and label headers, flagged in their user metadata,
+	// that older releases would return as part of the value, and their tree leaves are committed with them and replayed
+	// at open, which older releases would not do. Existing entries and trees are read as they are.
+	{version: 2, description: "allow codec and label headers in the stored values and journal the tree leaves"},
+}
+
+// formatVersion is the version of the on-disk layout written by this release
+func formatVersion() uint32 {
+	return migrations[len(migrations)-1].version
+}
+
+// readFormatVersion returns the format version of the data directory, 0 for directories created before
+// versioning, and whether the directory holds any data
+func readFormatVersion(dataDir string) (version uint32, exists bool, err error) {
+	data, err := ioutil.ReadFile(filepath.Join(dataDir, formatVersionFilename))
+	if err == nil {
+		v, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 32)
+		if err != nil {
+			return 0, true, fmt.Errorf("invalid format version in %s: %v", filepath.Join(dataDir, formatVersionFilename), err)
+		}
+		return uint32(v), true, nil
+	}
+	if !os.IsNotExist(err) {
+		return 0, false, err
+	}
+	entries, err := ioutil.ReadDir(dataDir)
+	if os.IsNotExist(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return 0, len(entries) > 0, nil
+}
+
+func writeFormatVersion(dataDir string, version uint32) error {
+	tmp := filepath.Join(dataDir, formatVersionFilename+".tmp")
+	if err := ioutil.WriteFile(tmp, []byte(strconv.FormatUint(uint64(version), 10)+"\n"), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(dataDir, formatVersionFilename))
+}
+
+// migrateDataDir upgrades the layout of the data directory to the current format version.
+// The data directory is copied aside before running the migrations which rewrite it, and the version is recorded
+// after each migration so that a failed upgrade resumes from the last completed one.
+func (s *ImmuServer) migrateDataDir(dataDir string) error {
+	if s.Options.GetInMemoryStore() {
+		return nil
+	}
+	version, exists, err := readFormatVersion(dataDir)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if err = os.MkdirAll(dataDir, os.ModePerm); err != nil {
+			return err
+		}
+		return writeFormatVersion(dataDir, formatVersion())
+	}
+	if version > formatVersion() {
+		s.Logger.Errorf("Data directory %s has format version %d, this immudb version supports up to %d", dataDir, version, formatVersion())
+		return ErrFormatTooNew
+	}
+	var pending []migration
+	rewrites := false
+	for _, m := range migrations {
+		if m.version > version {
+			pending = append(pending, m)
+			rewrites = rewrites || m.apply != nil
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	if rewrites {
+		backup := fmt.Sprintf("%s_backup_v%d_%s", filepath.Clean(dataDir), version, time.Now().Format("20060102150405"))
+		s.Logger.Infof("Backing up data directory %s to %s before upgrading it", dataDir, backup)
+		if err = copyDir(dataDir, backup); err != nil {
+			s.Logger.Errorf("Unable to back up data directory: %v", err)
+			return err
+		}
+	}
+	for _, m := range pending {
+		s.Logger.Infof("Upgrading data directory to format version %d: %s", m.version, m.description)
+		if m.apply != nil {
+			if err = m.apply(dataDir); err != nil {
+				s.Logger.Errorf("Unable to upgrade data directory to format version %d: %v", m.version, err)
+				return err
+			}
+		}
+		if err = writeFormatVersion(dataDir, m.version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyDir recursively copies the files of src into dst, which must not exist
+func copyDir(src, dst string) error {
+	if _, err := os.Stat(dst); err == nil {
+		return fmt.Errorf("%s already exists", dst)
+	}
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode()|0700)
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		return copyFile(path, target, info.Mode())
+	})
+}
+
+func copyFile(src, dst string, mode os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigrateDataDir(t *testing.T) {
+	dir := "migration_test"
+	defer os.RemoveAll(dir)
+	s := DefaultServer()
+
+	// a new data directory gets the current version
+	assert.NoError(t, s.migrateDataDir(dir))
+	version, exists, err := readFormatVersion(dir)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, formatVersion(), version)
+	assert.NoError(t, os.RemoveAll(dir))
+
+	// a version 1 directory is upgraded without being copied, the existing entries are read as they are
+	assert.NoError(t, os.MkdirAll(dir, 0755))
+	assert.NoError(t, writeFormatVersion(dir, 1))
+	assert.NoError(t, s.migrateDataDir(dir))
+	version, _, _ = readFormatVersion(dir)
+	assert.Equal(t, uint32(2), version)
+	backups, err := filepath.Glob(dir + "_backup_v1_*")
+	assert.NoError(t, err)
+	assert.Empty(t, backups)
+	assert.NoError(t, os.RemoveAll(dir))
+
+	// a data directory created before versioning is upgraded, and copied aside before being rewritten
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "defaultdb"), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "defaultdb", "old"), []byte("data"), 0644))
+	defer func(m []migration) { migrations = m }(migrations)
+	failing := true
+	migrations = []migration{
+		{version: 1, description: "record the version"},
+		{version: 2, description: "rename", apply: func(dataDir string) error {
+			return os.Rename(filepath.Join(dataDir, "defaultdb", "old"), filepath.Join(dataDir, "defaultdb", "new"))
+		}},
+		{version: 3, description: "fail once", apply: func(dataDir string) error {
+			if failing {
+				failing = false
+				return errors.New("failed")
+			}
+			return nil
+		}},
+	}
+	assert.Error(t, s.migrateDataDir(dir))
+	version, _, err = readFormatVersion(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(2), version)
+	backups, err = filepath.Glob(dir + "_backup_v0_*")
+	assert.NoError(t, err)
+	assert.Len(t, backups, 1)
+	defer os.RemoveAll(backups[0])
+	data, err := ioutil.ReadFile(filepath.Join(backups[0], "defaultdb", "old"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("data"), data)
+	_, err = os.Stat(filepath.Join(dir, "defaultdb", "new"))
+	assert.NoError(t, err)
+
+	// the upgrade resumes from the last completed migration
+	assert.NoError(t, s.migrateDataDir(dir))
+	version, _, _ = readFormatVersion(dir)
+	assert.Equal(t, uint32(3), version)
+	backups, _ = filepath.Glob(dir + "_backup_v2_*")
+	assert.Len(t, backups, 1)
+	defer os.RemoveAll(backups[0])
+
+	// directories written by newer releases are refused
+	assert.NoError(t, writeFormatVersion(dir, 4))
+	assert.Equal(t, ErrFormatTooNew, s.migrateDataDir(dir))
+}
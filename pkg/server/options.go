@@ -21,6 +21,7 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/codenotary/immudb/pkg/auth"
 )
@@ -53,6 +54,37 @@ type Options struct {
 	listener            net.Listener
 	usingCustomListener bool
 	maintenance         bool
+	MaxResultItems      int
+	MaxResultBytes      int
+	MaxExecutionMemory  int
+	MaxTimestampSkew    time.Duration
+	timeSource          TimeSource
+	AuthorizerAddress   string
+	authorizer          Authorizer
+	QuotaSoftBytes      uint64
+	QuotaHardBytes      uint64
+	SinglePort          bool
+	ShedPendingEntries  uint64
+	ShedHeapBytes       uint64
+	AdminPort           int
+	AdminMTLs           bool
+	AdminMTLsOptions    MTLsOptions
+	LogSinkAddress      string
+	LogSinkPort         int
+	LogSinkToken        string
+	LogSinkSyslogPort   int
+	LogSinkDatabase     string
+	LogSinkPrefix       string
+	LogSinkBatchSize    int
+	DbRestoreWindow     time.Duration
+	ValidatorAddress    string
+	ValidatorPrefixes   []string
+	validator           WriteValidator
+	HooksMTLs           bool
+	HooksMTLsOptions    MTLsOptions
+	LogBufferLines      int
+	StoreProfile        string
+	BulkLoadDir         string
 }
 
 // DefaultOptions returns default server options
@@ -79,6 +111,29 @@ func DefaultOptions() Options {
 		inMemoryStore:       false,
 		usingCustomListener: false,
 		maintenance:         false,
+		MaxResultItems:      0,
+		MaxResultBytes:      0,
+		MaxExecutionMemory:  0,
+		MaxTimestampSkew:    0,
+		AuthorizerAddress:   "",
+		QuotaSoftBytes:      0,
+		QuotaHardBytes:      0,
+		SinglePort:          false,
+		ShedPendingEntries:  0,
+		ShedHeapBytes:       0,
+		AdminPort:           0,
+		AdminMTLs:           false,
+		LogSinkAddress:      "127.0.0.1",
+		LogSinkPort:         0,
+		LogSinkToken:        "",
+		LogSinkSyslogPort:   0,
+		LogSinkDatabase:     DefaultdbName,
+		LogSinkPrefix:       "_logs/",
+		LogSinkBatchSize:    100,
+		DbRestoreWindow:     24 * time.Hour,
+		ValidatorAddress:    "",
+		HooksMTLs:           false,
+		LogBufferLines:      1000,
 	}
 }
 
@@ -173,6 +228,21 @@ func (o Options) Bind() string {
 	return o.Address + ":" + strconv.Itoa(o.Port)
 }
 
+// AdminBind returns the admin bind address
+func (o Options) AdminBind() string {
+	return o.Address + ":" + strconv.Itoa(o.AdminPort)
+}
+
+// LogSinkBind returns the bind address of the log sink HTTP endpoint
+func (o Options) LogSinkBind() string {
+	return o.LogSinkAddress + ":" + strconv.Itoa(o.LogSinkPort)
+}
+
+// LogSinkSyslogBind returns the bind address of the log sink syslog endpoint
+func (o Options) LogSinkSyslogBind() string {
+	return o.LogSinkAddress + ":" + strconv.Itoa(o.LogSinkSyslogPort)
+}
+
 // MetricsBind return metrics bind address
 func (o Options) MetricsBind() string {
 	return o.Address + ":" + strconv.Itoa(o.MetricsPort)
@@ -199,6 +269,56 @@ func (o Options) String() string {
 	if o.Logfile != "" {
 		opts = append(opts, rightPad("Log file", o.Logfile))
 	}
+	if o.AuthorizerAddress != "" {
+		opts = append(opts, rightPad("Authorizer", o.AuthorizerAddress))
+	}
+	if o.ValidatorAddress != "" {
+		opts = append(opts, rightPad("Validator", o.ValidatorAddress))
+	}
+	if len(o.ValidatorPrefixes) > 0 {
+		opts = append(opts, rightPad("Validated prefixes", strings.Join(o.ValidatorPrefixes, ", ")))
+	}
+	if o.AuthorizerAddress != "" || o.ValidatorAddress != "" {
+		opts = append(opts, rightPad("Hooks MTLS enabled", o.HooksMTLs))
+	}
+	if o.QuotaSoftBytes > 0 {
+		opts = append(opts, rightPad("Soft write quota", o.QuotaSoftBytes))
+	}
+	if o.QuotaHardBytes > 0 {
+		opts = append(opts, rightPad("Hard write quota", o.QuotaHardBytes))
+	}
+	if o.AdminPort > 0 {
+		opts = append(opts, rightPad("Admin port", o.AdminPort))
+		opts = append(opts, rightPad("Admin MTLS enabled", o.AdminMTLs))
+	}
+	if o.ShedPendingEntries > 0 {
+		opts = append(opts, rightPad("Shed pending entries", o.ShedPendingEntries))
+	}
+	if o.ShedHeapBytes > 0 {
+		opts = append(opts, rightPad("Shed heap bytes", o.ShedHeapBytes))
+	}
+	if o.SinglePort {
+		opts = append(opts, rightPad("Single port", o.SinglePort))
+	}
+	if o.LogSinkPort > 0 || o.LogSinkSyslogPort > 0 {
+		opts = append(opts, rightPad("Log sink address", o.LogSinkAddress))
+	}
+	if o.LogSinkPort > 0 {
+		opts = append(opts, rightPad("Log sink port", o.LogSinkPort))
+	}
+	if o.LogSinkSyslogPort > 0 {
+		opts = append(opts, rightPad("Log sink syslog", o.LogSinkSyslogPort))
+	}
+	if o.LogSinkPort > 0 || o.LogSinkSyslogPort > 0 {
+		opts = append(opts, rightPad("Log sink target", o.LogSinkDatabase+"/"+o.LogSinkPrefix))
+	}
+	if o.StoreProfile != "" {
+		opts = append(opts, rightPad("Store profile", o.StoreProfile))
+	}
+	if o.BulkLoadDir != "" {
+		opts = append(opts, rightPad("Bulk load dir", o.BulkLoadDir))
+	}
+	opts = append(opts, rightPad("Restore window", o.DbRestoreWindow))
 	opts = append(opts, rightPad("MTLS enabled", o.MTLs))
 	opts = append(opts, rightPad("Auth enabled", o.auth))
 	opts = append(opts, rightPad("Dev mode", o.DevMode))
@@ -268,3 +388,210 @@ func (o Options) WithMaintenance(m bool) Options {
 func (o Options) GetMaintenance() bool {
 	return o.maintenance
 }
+
+// WithMaxResultItems sets the maximum number of items a single query can return, 0 means no limit
+func (o Options) WithMaxResultItems(max int) Options {
+	o.MaxResultItems = max
+	return o
+}
+
+// WithMaxResultBytes sets the maximum size in bytes of a single query result, 0 means no limit
+func (o Options) WithMaxResultBytes(max int) Options {
+	o.MaxResultBytes = max
+	return o
+}
+
+// WithMaxExecutionMemory sets the maximum memory in bytes a single query can hold while it runs, 0 means no limit
+func (o Options) WithMaxExecutionMemory(max int) Options {
+	o.MaxExecutionMemory = max
+	return o
+}
+
+// WithMaxTimestampSkew sets how far the timestamp of a structured value can deviate from the time source
+// and from the previously committed ones, 0 disables the check
+func (o Options) WithMaxTimestampSkew(skew time.Duration) Options {
+	o.MaxTimestampSkew = skew
+	return o
+}
+
+// WithTimeSource sets the trusted time source used to validate timestamps, by default the system clock is used
+func (o Options) WithTimeSource(ts TimeSource) Options {
+	o.timeSource = ts
+	return o
+}
+
+// GetTimeSource returns the trusted time source used to validate timestamps
+func (o Options) GetTimeSource() TimeSource {
+	return o.timeSource
+}
+
+// WithAuthorizerAddress sets the address of an external ImmuAuthorizer gRPC service consulted on each operation
+func (o Options) WithAuthorizerAddress(address string) Options {
+	o.AuthorizerAddress = address
+	return o
+}
+
+// WithAuthorizer sets the authorization hook consulted on each operation, it takes precedence over AuthorizerAddress
+func (o Options) WithAuthorizer(authorizer Authorizer) Options {
+	o.authorizer = authorizer
+	return o
+}
+
+// GetAuthorizer returns the authorization hook consulted on each operation
+func (o Options) GetAuthorizer() Authorizer {
+	return o.authorizer
+}
+
+// WithSinglePort sets whether the REST gateway, metrics and health endpoints are served on the gRPC port
+func (o Options) WithSinglePort(singlePort bool) Options {
+	o.SinglePort = singlePort
+	return o
+}
+
+// WithAdminPort sets the port on which administrative methods are served, 0 serves them on the data port
+func (o Options) WithAdminPort(port int) Options {
+	o.AdminPort = port
+	return o
+}
+
+// WithAdminMTLs sets whether the admin port requires mutual TLS
+func (o Options) WithAdminMTLs(mtls bool) Options {
+	o.AdminMTLs = mtls
+	return o
+}
+
+// WithAdminMTLsOptions sets the mutual TLS settings of the admin port
+func (o Options) WithAdminMTLsOptions(options MTLsOptions) Options {
+	o.AdminMTLsOptions = options
+	return o
+}
+
+// WithShedPendingEntries sets the number of entries waiting to be added into the merkle tree above which
+// low priority requests are rejected, 0 disables it
+func (o Options) WithShedPendingEntries(entries uint64) Options {
+	o.ShedPendingEntries = entries
+	return o
+}
+
+// WithShedHeapBytes sets the heap size above which low priority requests are rejected, 0 disables it
+func (o Options) WithShedHeapBytes(bytes uint64) Options {
+	o.ShedHeapBytes = bytes
+	return o
+}
+
+// WithQuotaSoftBytes sets the bytes a user can write before warnings are logged and returned, 0 disables it
+func (o Options) WithQuotaSoftBytes(bytes uint64) Options {
+	o.QuotaSoftBytes = bytes
+	return o
+}
+
+// WithQuotaHardBytes sets the bytes a user can write before further writes are rejected, 0 disables it
+func (o Options) WithQuotaHardBytes(bytes uint64) Options {
+	o.QuotaHardBytes = bytes
+	return o
+}
+
+// WithLogSinkAddress sets the address the log sink endpoints listen on. Syslog messages are not authenticated, and
+// the HTTP endpoint requires a token unless the address is a loopback one.
+func (o Options) WithLogSinkAddress(address string) Options {
+	o.LogSinkAddress = address
+	return o
+}
+
+// WithLogSinkToken sets the bearer token the log sink HTTP endpoint requires, empty disables the check
+func (o Options) WithLogSinkToken(token string) Options {
+	o.LogSinkToken = token
+	return o
+}
+
+// WithLogSinkPort sets the port of the HTTP endpoint accepting JSON log events, 0 disables it
+func (o Options) WithLogSinkPort(port int) Options {
+	o.LogSinkPort = port
+	return o
+}
+
+// WithLogSinkSyslogPort sets the UDP port accepting syslog messages, 0 disables it
+func (o Options) WithLogSinkSyslogPort(port int) Options {
+	o.LogSinkSyslogPort = port
+	return o
+}
+
+// WithLogSinkDatabase sets the database the log sink writes to, as the LogSinkUser for the quotas and the authorization hook
+func (o Options) WithLogSinkDatabase(database string) Options {
+	o.LogSinkDatabase = database
+	return o
+}
+
+// WithLogSinkPrefix sets the prefix of the keys the log sink writes
+func (o Options) WithLogSinkPrefix(prefix string) Options {
+	o.LogSinkPrefix = prefix
+	return o
+}
+
+// WithLogSinkBatchSize sets the maximum number of log events written in a single batch
+func (o Options) WithLogSinkBatchSize(size int) Options {
+	o.LogSinkBatchSize = size
+	return o
+}
+
+// WithDbRestoreWindow sets how long a deleted database is retained before it can be purged
+func (o Options) WithDbRestoreWindow(window time.Duration) Options {
+	o.DbRestoreWindow = window
+	return o
+}
+
+// WithValidatorAddress sets the address of an external ImmuValidator gRPC service consulted before committing writes
+func (o Options) WithValidatorAddress(address string) Options {
+	o.ValidatorAddress = address
+	return o
+}
+
+// WithValidatorPrefixes restricts the write validation to the writes having at least one key with one of the
+// prefixes, by default all the writes are validated
+func (o Options) WithValidatorPrefixes(prefixes []string) Options {
+	o.ValidatorPrefixes = prefixes
+	return o
+}
+
+// WithStoreProfile sets the named preset the stores of all the databases are tuned with: embedded-small,
+// server-balanced, bulk-ingest or low-memory, the default tuning when empty
+func (o Options) WithStoreProfile(profile string) Options {
+	o.StoreProfile = profile
+	return o
+}
+
+// WithLogBufferLines sets how many recent log lines are kept in memory to be read through the Logs RPC, 0 disables it
+func (o Options) WithLogBufferLines(lines int) Options {
+	o.LogBufferLines = lines
+	return o
+}
+
+// WithHooksMTLs sets whether the connections to the authorizer and validator services use mutual TLS
+func (o Options) WithHooksMTLs(mtls bool) Options {
+	o.HooksMTLs = mtls
+	return o
+}
+
+// WithHooksMTLsOptions sets the client certificate presented to the authorizer and validator services and the
+// certificate authorities their certificates are verified against
+func (o Options) WithHooksMTLsOptions(options MTLsOptions) Options {
+	o.HooksMTLsOptions = options
+	return o
+}
+
+// WithWriteValidator sets the validator consulted before committing writes, it takes precedence over ValidatorAddress
+func (o Options) WithWriteValidator(validator WriteValidator) Options {
+	o.validator = validator
+	return o
+}
+
+// GetWriteValidator returns the validator consulted before committing writes
+func (o Options) GetWriteValidator() WriteValidator {
+	return o.validator
+}
+
+// WithBulkLoadDir sets the directory bulk load files are read from, bulk loads are disabled when empty
+func (o Options) WithBulkLoadDir(dir string) Options {
+	o.BulkLoadDir = dir
+	return o
+}
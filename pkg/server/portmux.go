@@ -0,0 +1,174 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+)
+
+// http2PrefaceMethod starts the preface sent first by every HTTP/2 client, gRPC ones included. No HTTP/1 request
+// uses the PRI method, so it is enough to tell the two protocols apart.
+var http2PrefaceMethod = []byte("PRI ")
+
+// portMuxSniffTimeout bounds the time a new connection has to send the first bytes telling its protocol
+const portMuxSniffTimeout = 10 * time.Second
+
+// ErrPortMuxClosed is returned by the listeners of a closed portMux
+var ErrPortMuxClosed = errors.New("port multiplexer closed")
+
+// ErrSinglePortWithMTLs is returned when single port mode is enabled together with MTLS
+var ErrSinglePortWithMTLs = errors.New("single port mode is not supported with MTLS")
+
+// portMux splits the connections accepted on a single listener: HTTP/2 ones go to the gRPC listener and
+// all the others to the HTTP listener, which serves the REST gateway, metrics and health endpoints
+type portMux struct {
+	root      net.Listener
+	grpc      *muxListener
+	http      *muxListener
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newPortMux(root net.Listener) *portMux {
+	m := &portMux{root: root, done: make(chan struct{})}
+	m.grpc = &muxListener{mux: m, conns: make(chan net.Conn)}
+	m.http = &muxListener{mux: m, conns: make(chan net.Conn)}
+	return m
+}
+
+// Serve accepts connections on the root listener until it is closed
+func (m *portMux) Serve() error {
+	for {
+		conn, err := m.root.Accept()
+		if err != nil {
+			m.Close()
+			return err
+		}
+		go m.dispatch(conn)
+	}
+}
+
+// dispatch peeks at the first bytes of the connection and hands it over as soon as they can only be HTTP/2 or HTTP/1,
+// so that short or slow HTTP/1 requests are not held waiting for a full HTTP/2 preface
+func (m *portMux) dispatch(conn net.Conn) {
+	conn.SetReadDeadline(time.Now().Add(portMuxSniffTimeout))
+	prefix := make([]byte, 0, len(http2PrefaceMethod))
+	for len(prefix) < len(http2PrefaceMethod) && bytes.HasPrefix(http2PrefaceMethod, prefix) {
+		n, err := conn.Read(prefix[len(prefix):cap(prefix)])
+		prefix = prefix[:len(prefix)+n]
+		if err != nil {
+			break
+		}
+	}
+	if len(prefix) == 0 {
+		conn.Close()
+		return
+	}
+	conn.SetReadDeadline(time.Time{})
+
+	target := m.http
+	if bytes.Equal(prefix, http2PrefaceMethod) {
+		target = m.grpc
+	}
+	select {
+	case target.conns <- &sniffedConn{Conn: conn, prefix: prefix}:
+	case <-m.done:
+		conn.Close()
+	}
+}
+
+// Close stops the multiplexing and closes the root listener
+func (m *portMux) Close() error {
+	var err error
+	m.closeOnce.Do(func() {
+		close(m.done)
+		err = m.root.Close()
+	})
+	return err
+}
+
+type muxListener struct {
+	mux   *portMux
+	conns chan net.Conn
+}
+
+func (l *muxListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.mux.done:
+		return nil, ErrPortMuxClosed
+	}
+}
+
+func (l *muxListener) Close() error {
+	return l.mux.Close()
+}
+
+func (l *muxListener) Addr() net.Addr {
+	return l.mux.root.Addr()
+}
+
+// sniffedConn replays the bytes read to pick the listener before reading from the connection
+type sniffedConn struct {
+	net.Conn
+	prefix []byte
+}
+
+func (c *sniffedConn) Read(b []byte) (int, error) {
+	if len(c.prefix) > 0 {
+		n := copy(b, c.prefix)
+		c.prefix = c.prefix[n:]
+		return n, nil
+	}
+	return c.Conn.Read(b)
+}
+
+// startSinglePortHTTP serves the REST gateway, the startup progress and, when enabled, the metrics on the HTTP side of
+// the port multiplexer.
+// The gateway reaches the gRPC API through the same port, its connection is closed when ctx is done.
+func (s *ImmuServer) startSinglePortHTTP(ctx context.Context, m *portMux) (*http.Server, error) {
+	gwmux := runtime.NewServeMux()
+	err := schema.RegisterImmuServiceHandlerFromEndpoint(ctx, gwmux, m.root.Addr().String(), []grpc.DialOption{grpc.WithInsecure()})
+	if err != nil {
+		return nil, err
+	}
+	mux := http.NewServeMux()
+	if s.Options.MetricsServer {
+		mux = metricsMux(s.startup)
+	} else {
+		mux.Handle("/startup", s.startup)
+	}
+	mux.Handle("/", gwmux)
+	server := &http.Server{Handler: mux}
+	go func() {
+		if err := server.Serve(m.http); err != nil && err != http.ErrServerClosed && err != ErrPortMuxClosed {
+			s.Logger.Errorf("Single port http error: %s", err)
+		}
+	}()
+	return server, nil
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"bufio"
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestPortMux(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	m := newPortMux(listener)
+	defer m.Close()
+
+	grpcServer := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(grpcServer, health.NewServer())
+	go grpcServer.Serve(m.grpc)
+	defer grpcServer.Stop()
+
+	httpServer := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`http`))
+	})}
+	go httpServer.Serve(m.http)
+	defer httpServer.Close()
+
+	go m.Serve()
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	assert.NoError(t, err)
+	defer conn.Close()
+	res, err := grpc_health_v1.NewHealthClient(conn).Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, res.Status)
+
+	resp, err := http.Get("http://" + listener.Addr().String() + "/metrics")
+	assert.NoError(t, err)
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(`http`), body)
+
+	// a request shorter than the HTTP/2 preface is served without waiting for more bytes
+	raw, err := net.Dial("tcp", listener.Addr().String())
+	assert.NoError(t, err)
+	defer raw.Close()
+	_, err = raw.Write([]byte("GET / HTTP/1.0\r\n\r\n"))
+	assert.NoError(t, err)
+	raw.SetReadDeadline(time.Now().Add(portMuxSniffTimeout / 2))
+	resp, err = http.ReadResponse(bufio.NewReader(raw), nil)
+	assert.NoError(t, err)
+	body, err = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(`http`), body)
+}
+
+func TestSinglePortHTTP(t *testing.T) {
+	for _, metrics := range []bool{false, true} {
+		s := newInmemoryAuthServer()
+		s.Options.MetricsServer = metrics
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		assert.NoError(t, err)
+		m := newPortMux(listener)
+		ctx, cancel := context.WithCancel(context.Background())
+		httpServer, err := s.startSinglePortHTTP(ctx, m)
+		assert.NoError(t, err)
+		go m.Serve()
+
+		resp, err := http.Get("http://" + listener.Addr().String() + "/startup")
+		assert.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+
+		resp, err = http.Get("http://" + listener.Addr().String() + "/metrics")
+		assert.NoError(t, err)
+		resp.Body.Close()
+		if metrics {
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+		} else {
+			assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		}
+
+		httpServer.Close()
+		cancel()
+		m.Close()
+	}
+}
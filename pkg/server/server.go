@@ -22,6 +22,7 @@ import (
 	"crypto/x509"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"os"
@@ -38,6 +39,7 @@ import (
 	"github.com/codenotary/immudb/cmd/helper"
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/logger"
 	"github.com/codenotary/immudb/pkg/store"
 	"github.com/codenotary/immudb/pkg/store/sysstore"
 	"github.com/golang/protobuf/ptypes/empty"
@@ -61,6 +63,10 @@ var immudbTextLogo = " _                               _ _     \n" +
 // Start starts the immudb server
 // Loads and starts the System DB, default db and user db
 func (s *ImmuServer) Start() error {
+	if s.Options.LogBufferLines > 0 {
+		s.logs = logger.NewRingLogger(s.Logger, s.Options.LogBufferLines)
+		s.Logger = s.logs
+	}
 	_, err := fmt.Fprintf(os.Stdout, "%s\n%s\n\n", immudbTextLogo, s.Options)
 	if err != nil {
 		s.Logger.Errorf("Error printing immudb config: %v", err)
@@ -68,59 +74,37 @@ func (s *ImmuServer) Start() error {
 	if s.Options.Logfile != "" {
 		s.Logger.Infof("\n%s\n%s\n\n", immudbTextLogo, s.Options)
 	}
-	dataDir := s.Options.Dir
-	if err := s.loadDefaultDatabase(dataDir); err != nil {
-		s.Logger.Errorf("Unable load default database %s", err)
-		return err
-	}
-	if err := s.loadSystemDatabase(dataDir); err != nil {
-		s.Logger.Errorf("Unable load system database %s", err)
-		return err
-	}
-
-	if err := s.loadUserDatabases(dataDir); err != nil {
-		s.Logger.Errorf("Unable load databases %s", err)
-		return err
-	}
-	s.multidbmode = s.mandatoryAuth()
-	if !s.Options.GetAuth() && s.multidbmode {
-		s.Logger.Infof("Authentication must be on.")
-		return fmt.Errorf("auth should be on")
-	}
-
-	options := []grpc.ServerOption{}
-	//----------TLS Setting-----------//
-	if s.Options.MTLs {
-		// credentials needed to communicate with client
-		certificate, err := tls.LoadX509KeyPair(
-			s.Options.MTLsOptions.Certificate,
-			s.Options.MTLsOptions.Pkey,
-		)
+	if s.Options.validator == nil && s.Options.ValidatorAddress != "" {
+		dialOption, err := s.hooksDialOption()
 		if err != nil {
-			s.Logger.Errorf("Failed to read server key pair: %s", err)
 			return err
 		}
-		certPool := x509.NewCertPool()
-		// Trusted store, contain the list of trusted certificates. client has to use one of this certificate to be trusted by this server
-		bs, err := ioutil.ReadFile(s.Options.MTLsOptions.ClientCAs)
+		conn, err := grpc.Dial(s.Options.ValidatorAddress, dialOption)
 		if err != nil {
-			s.Logger.Errorf("Failed to read client ca cert: %s", err)
+			s.Logger.Errorf("Unable to connect to the validator %s: %v", s.Options.ValidatorAddress, err)
 			return err
 		}
+		s.Options.validator = NewGrpcValidator(conn)
+	}
+	// the listeners are opened before the databases are loaded so that the startup progress can be followed
+	s.startup = newStartupProgress(s.Logger)
+	if s.Options.MetricsServer && !s.Options.SinglePort {
+		metricsServer := StartMetrics(s.Options.MetricsBind(), s.Logger, s.startup)
+		defer func() {
+			if err = metricsServer.Close(); err != nil {
+				s.Logger.Errorf("Failed to shutdown metric server: %s", err)
+			}
+		}()
+	}
 
-		ok := certPool.AppendCertsFromPEM(bs)
-		if !ok {
-			s.Logger.Errorf("Failed to append client certs")
+	options := []grpc.ServerOption{}
+	//----------TLS Setting-----------//
+	if s.Options.MTLs {
+		creds, err := s.mtlsServerOption(s.Options.MTLsOptions)
+		if err != nil {
 			return err
 		}
-
-		tlsConfig := &tls.Config{
-			ClientAuth:   tls.RequireAndVerifyClientCert,
-			Certificates: []tls.Certificate{certificate},
-			ClientCAs:    certPool,
-		}
-
-		options = []grpc.ServerOption{grpc.Creds(credentials.NewTLS(tlsConfig))}
+		options = []grpc.ServerOption{creds}
 	}
 
 	var listener net.Listener
@@ -134,12 +118,16 @@ func (s *ImmuServer) Start() error {
 			return err
 		}
 	}
-
-	systemDbRootDir := filepath.Join(dataDir, s.Options.GetDefaultDbName())
-	var uuid xid.ID
-	if uuid, err = getOrSetUuid(systemDbRootDir); err != nil {
-		return err
+	var portMux *portMux
+	if s.Options.SinglePort {
+		if s.Options.MTLs {
+			s.Logger.Errorf("Single port mode is not supported with MTLS")
+			return ErrSinglePortWithMTLs
+		}
+		portMux = newPortMux(listener)
+		listener = portMux.grpc
 	}
+
 	auth.AuthEnabled = s.Options.GetAuth()
 	auth.DevMode = s.Options.DevMode
 	adminPassword, err := auth.DecodeBase64Password(s.Options.AdminPassword)
@@ -150,32 +138,6 @@ func (s *ImmuServer) Start() error {
 	auth.SysAdminPassword = adminPassword
 	auth.UpdateMetrics = func(ctx context.Context) { Metrics.UpdateClientMetrics(ctx) }
 
-	if s.Options.MetricsServer {
-		metricsServer := StartMetrics(
-			s.Options.MetricsBind(),
-			s.Logger,
-			func() float64 { return float64(s.dbList.GetByIndex(DefaultDbIndex).Store.CountAll()) },
-			func() float64 { return time.Since(startedAt).Hours() },
-		)
-		defer func() {
-			if err = metricsServer.Close(); err != nil {
-				s.Logger.Errorf("Failed to shutdown metric server: %s", err)
-			}
-		}()
-	}
-	s.installShutdownHandler()
-
-	dbSize, _ := s.dbList.GetByIndex(DefaultDbIndex).Store.DbSize()
-	if dbSize <= 0 {
-		s.Logger.Infof("Started with an empty database")
-	}
-
-	if s.Options.Pidfile != "" {
-		if s.Pid, err = NewPid(s.Options.Pidfile); err != nil {
-			s.Logger.Errorf("Failed to write pidfile: %s", err)
-			return err
-		}
-	}
 	//===> !NOTE: See Histograms section here:
 	// https://github.com/grpc-ecosystem/go-grpc-prometheus
 	// TL;DR:
@@ -188,34 +150,224 @@ func (s *ImmuServer) Start() error {
 	}
 	//<===
 
-	uuidContext := NewUuidContext(uuid)
+	if s.Options.authorizer == nil && s.Options.AuthorizerAddress != "" {
+		dialOption, err := s.hooksDialOption()
+		if err != nil {
+			return err
+		}
+		conn, err := grpc.Dial(s.Options.AuthorizerAddress, dialOption)
+		if err != nil {
+			s.Logger.Errorf("Unable to connect to the authorizer %s: %v", s.Options.AuthorizerAddress, err)
+			return err
+		}
+		s.Options.authorizer = NewGrpcAuthorizer(conn)
+	}
+
+	// the identifier is stored with the default database, it is set once the databases are loaded
+	uuidContext := NewUuidContext(xid.ID{})
 
 	uis := []grpc.UnaryServerInterceptor{
 		uuidContext.UuidContextSetter,
 		grpc_prometheus.UnaryServerInterceptor,
 		auth.ServerUnaryInterceptor,
+		s.impersonationUnaryInterceptor,
+		s.usageUnaryInterceptor,
 	}
 	sss := []grpc.StreamServerInterceptor{
 		uuidContext.UuidStreamContextSetter,
 		grpc_prometheus.StreamServerInterceptor,
 		auth.ServerStreamInterceptor,
+		s.impersonationStreamInterceptor,
+		s.usageStreamInterceptor,
+	}
+	if s.Options.authorizer != nil {
+		uis = append(uis, s.authorizerUnaryInterceptor)
+		sss = append(sss, s.authorizerStreamInterceptor)
+	}
+	if s.Options.ShedPendingEntries > 0 || s.Options.ShedHeapBytes > 0 {
+		s.loadShedder = s.newLoadShedder()
+		uis = append(uis, s.loadSheddingUnaryInterceptor)
+		sss = append(sss, s.loadSheddingStreamInterceptor)
+	}
+	if s.Options.AdminPort > 0 {
+		if err = s.startAdminServer(uis, sss); err != nil {
+			return err
+		}
+		ui, si := portInterceptors(false)
+		uis = append(uis, ui)
+		sss = append(sss, si)
 	}
+	ui, si := s.startupInterceptors(false)
 	options = append(
 		options,
-		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(uis...)),
-		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(sss...)),
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(append([]grpc.UnaryServerInterceptor{ui}, uis...)...)),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(append([]grpc.StreamServerInterceptor{si}, sss...)...)),
 	)
 	s.GrpcServer = grpc.NewServer(options...)
 	schema.RegisterImmuServiceServer(s.GrpcServer, s)
 	grpc_prometheus.Register(s.GrpcServer)
+	if portMux != nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		httpServer, err := s.startSinglePortHTTP(ctx, portMux)
+		if err != nil {
+			s.Logger.Errorf("Unable to start the single port http server: %s", err)
+			return err
+		}
+		defer httpServer.Close()
+		go portMux.Serve()
+	}
+	served := make(chan error, 1)
+	go func() {
+		served <- s.GrpcServer.Serve(listener)
+	}()
+
+	if err = s.loadDatabases(&uuidContext); err != nil {
+		s.GrpcServer.Stop()
+		if s.AdminGrpcServer != nil {
+			s.AdminGrpcServer.Stop()
+		}
+		return err
+	}
+	s.installShutdownHandler()
+
+	dbSize, _ := s.dbList.GetByIndex(DefaultDbIndex).Store.DbSize()
+	if dbSize <= 0 {
+		s.Logger.Infof("Started with an empty database")
+	}
 	s.startCorruptionChecker()
 	go s.printUsageCallToAction()
 	startedAt = time.Now()
-	err = s.GrpcServer.Serve(listener)
+	s.startup.ready()
+	err = <-served
 	<-s.quit
 	return err
 }
 
+// loadDatabases migrates the data directory and loads the databases, the identifier of the server is then set into
+// uuidContext. The services reading from or writing into the databases are started at last.
+func (s *ImmuServer) loadDatabases(uuidContext *uuidContext) error {
+	dataDir := s.Options.Dir
+	s.startup.begin(startupPhaseMigrating, 0)
+	if err := s.migrateDataDir(dataDir); err != nil {
+		s.Logger.Errorf("Unable to upgrade the data directory %s", err)
+		return err
+	}
+	s.startup.begin(startupPhaseSystemDbs, 0)
+	if err := s.loadDefaultDatabase(dataDir); err != nil {
+		s.Logger.Errorf("Unable load default database %s", err)
+		return err
+	}
+	if err := s.loadSystemDatabase(dataDir); err != nil {
+		s.Logger.Errorf("Unable load system database %s", err)
+		return err
+	}
+
+	if err := s.loadUserDatabases(dataDir); err != nil {
+		s.Logger.Errorf("Unable load databases %s", err)
+		return err
+	}
+	s.multidbmode = s.mandatoryAuth()
+	if !s.Options.GetAuth() && s.multidbmode {
+		s.Logger.Infof("Authentication must be on.")
+		return fmt.Errorf("auth should be on")
+	}
+
+	systemDbRootDir := filepath.Join(dataDir, s.Options.GetDefaultDbName())
+	uuid, err := getOrSetUuid(systemDbRootDir)
+	if err != nil {
+		return err
+	}
+	uuidContext.Uuid = uuid
+
+	if s.Options.MetricsServer {
+		Metrics.WithRecordsCounter(func() float64 { return float64(s.dbList.GetByIndex(DefaultDbIndex).Store.CountAll()) })
+		Metrics.WithUptimeCounter(func() float64 { return time.Since(startedAt).Hours() })
+	}
+
+	if s.Options.Pidfile != "" {
+		if s.Pid, err = NewPid(s.Options.Pidfile); err != nil {
+			s.Logger.Errorf("Failed to write pidfile: %s", err)
+			return err
+		}
+	}
+
+	s.usage.softBytes = s.Options.QuotaSoftBytes
+	s.usage.hardBytes = s.Options.QuotaHardBytes
+	if err = s.startUsageFlusher(); err != nil {
+		s.Logger.Errorf("Unable to restore the usage: %v", err)
+		return err
+	}
+	if s.Options.LogSinkPort > 0 || s.Options.LogSinkSyslogPort > 0 {
+		if err = s.startLogSink(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mtlsServerOption returns the credentials requiring clients to present a certificate signed by one of the trusted CAs
+func (s *ImmuServer) mtlsServerOption(o MTLsOptions) (grpc.ServerOption, error) {
+	// credentials needed to communicate with client
+	certificate, err := tls.LoadX509KeyPair(
+		o.Certificate,
+		o.Pkey,
+	)
+	if err != nil {
+		s.Logger.Errorf("Failed to read server key pair: %s", err)
+		return nil, err
+	}
+	certPool := x509.NewCertPool()
+	// Trusted store, contain the list of trusted certificates. client has to use one of this certificate to be trusted by this server
+	bs, err := ioutil.ReadFile(o.ClientCAs)
+	if err != nil {
+		s.Logger.Errorf("Failed to read client ca cert: %s", err)
+		return nil, err
+	}
+
+	ok := certPool.AppendCertsFromPEM(bs)
+	if !ok {
+		s.Logger.Errorf("Failed to append client certs")
+		return nil, fmt.Errorf("failed to append client certs from %s", o.ClientCAs)
+	}
+
+	tlsConfig := &tls.Config{
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		Certificates: []tls.Certificate{certificate},
+		ClientCAs:    certPool,
+	}
+
+	return grpc.Creds(credentials.NewTLS(tlsConfig)), nil
+}
+
+// hooksDialOption returns the transport credentials of the connections to the authorizer and validator services.
+// The server name is taken from the dialed address
+func (s *ImmuServer) hooksDialOption() (grpc.DialOption, error) {
+	if !s.Options.HooksMTLs {
+		return grpc.WithInsecure(), nil
+	}
+	o := s.Options.HooksMTLsOptions
+	certificate, err := tls.LoadX509KeyPair(o.Certificate, o.Pkey)
+	if err != nil {
+		s.Logger.Errorf("Failed to read hooks client key pair: %s", err)
+		return nil, err
+	}
+	certPool := x509.NewCertPool()
+	bs, err := ioutil.ReadFile(o.ClientCAs)
+	if err != nil {
+		s.Logger.Errorf("Failed to read hooks ca cert: %s", err)
+		return nil, err
+	}
+	if ok := certPool.AppendCertsFromPEM(bs); !ok {
+		s.Logger.Errorf("Failed to append hooks ca certs")
+		return nil, fmt.Errorf("failed to append ca certs from %s", o.ClientCAs)
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		RootCAs:      certPool,
+	})), nil
+}
+
 func (s *ImmuServer) printUsageCallToAction() {
 	time.Sleep(200 * time.Millisecond)
 	immuadminCLI := helper.Blue + "immuadmin" + helper.Green
@@ -266,7 +418,8 @@ func (s *ImmuServer) loadSystemDatabase(dataDir string) error {
 		op := DefaultOption().
 			WithDbName(s.Options.GetSystemAdminDbName()).
 			WithDbRootPath(dataDir).
-			WithCorruptionChecker(s.Options.CorruptionCheck).WithDbRootPath(s.Options.Dir)
+			WithCorruptionChecker(s.Options.CorruptionCheck).
+			WithReplayProgress(s.startup.replay(s.Options.GetSystemAdminDbName())).WithDbRootPath(s.Options.Dir)
 		db, err := OpenDb(op, s.Logger)
 		if err != nil {
 			return err
@@ -292,6 +445,13 @@ func (s *ImmuServer) loadDefaultDatabase(dataDir string) error {
 			WithDbName(s.Options.GetDefaultDbName()).
 			WithDbRootPath(dataDir).
 			WithCorruptionChecker(s.Options.CorruptionCheck).
+			WithMaxResultItems(s.Options.MaxResultItems).
+			WithMaxResultBytes(s.Options.MaxResultBytes).
+			WithMaxExecutionMemory(s.Options.MaxExecutionMemory).
+			WithMaxTimestampSkew(s.Options.MaxTimestampSkew).
+			WithTimeSource(s.Options.GetTimeSource()).
+			WithWriteValidator(s.Options.validator, s.Options.ValidatorPrefixes).
+			WithStoreProfile(s.Options.StoreProfile).
 			WithInMemoryStore(s.Options.GetInMemoryStore()).WithDbRootPath(s.Options.Dir)
 		db, err := NewDb(op, s.Logger)
 		if err != nil {
@@ -303,7 +463,15 @@ func (s *ImmuServer) loadDefaultDatabase(dataDir string) error {
 		op := DefaultOption().
 			WithDbName(s.Options.GetDefaultDbName()).
 			WithDbRootPath(dataDir).
-			WithCorruptionChecker(s.Options.CorruptionCheck).WithDbRootPath(s.Options.Dir)
+			WithCorruptionChecker(s.Options.CorruptionCheck).
+			WithMaxResultItems(s.Options.MaxResultItems).
+			WithMaxResultBytes(s.Options.MaxResultBytes).
+			WithMaxExecutionMemory(s.Options.MaxExecutionMemory).
+			WithMaxTimestampSkew(s.Options.MaxTimestampSkew).
+			WithTimeSource(s.Options.GetTimeSource()).
+			WithWriteValidator(s.Options.validator, s.Options.ValidatorPrefixes).
+			WithStoreProfile(s.Options.StoreProfile).
+			WithReplayProgress(s.startup.replay(s.Options.GetDefaultDbName())).WithDbRootPath(s.Options.Dir)
 		db, err := OpenDb(op, s.Logger)
 		if err != nil {
 			return err
@@ -338,21 +506,45 @@ func (s *ImmuServer) loadUserDatabases(dataDir string) error {
 	if err != nil {
 		return err
 	}
+	s.startup.begin(startupPhaseDbs, len(dirs))
 	//load databases that are inside each directory
 	for _, val := range dirs {
 		//dbname is the directory name where it is stored
 		//path iteration above stores the directories as data/db_name
 		pathparts := strings.Split(val, "/")
 		dbname := pathparts[len(pathparts)-1]
-		op := DefaultOption().WithDbName(dbname).WithCorruptionChecker(s.Options.CorruptionCheck).WithDbRootPath(s.Options.Dir)
+		op := DefaultOption().WithDbName(dbname).WithCorruptionChecker(s.Options.CorruptionCheck).
+			WithMaxResultItems(s.Options.MaxResultItems).
+			WithMaxResultBytes(s.Options.MaxResultBytes).
+			WithMaxExecutionMemory(s.Options.MaxExecutionMemory).
+			WithMaxTimestampSkew(s.Options.MaxTimestampSkew).
+			WithTimeSource(s.Options.GetTimeSource()).
+			WithWriteValidator(s.Options.validator, s.Options.ValidatorPrefixes).
+			WithStoreProfile(s.Options.StoreProfile).
+			WithReplayProgress(s.startup.replay(dbname)).WithDbRootPath(s.Options.Dir)
+		del, err := readDeletion(op)
+		if err != nil {
+			return err
+		}
+		if del != nil && del.Purged {
+			if err = removePurgedDatabase(op); err != nil {
+				s.Logger.Errorf("Unable to remove purged database %s: %v", dbname, err)
+				return err
+			}
+			s.Logger.Infof("Removed purged database %s", dbname)
+			s.startup.step(dbname)
+			continue
+		}
 		db, err := OpenDb(op, s.Logger)
 		if err != nil {
 			return err
 		}
+		db.deletion = del
 
 		//associate this database name to it's index in the array
 		s.databasenameToIndex[dbname] = int64(s.dbList.Length())
 		s.dbList.Append(db)
+		s.startup.step(dbname)
 	}
 	return nil
 }
@@ -363,6 +555,15 @@ func (s *ImmuServer) Stop() error {
 	defer func() { s.quit <- struct{}{} }()
 	s.GrpcServer.Stop()
 	defer func() { s.GrpcServer = nil }()
+	if s.AdminGrpcServer != nil {
+		s.AdminGrpcServer.Stop()
+		defer func() { s.AdminGrpcServer = nil }()
+	}
+	if s.logSink != nil {
+		s.logSink.stop()
+		s.logSink = nil
+	}
+	s.stopUsageFlusher()
 	s.CloseDatabases()
 	return nil
 }
@@ -402,6 +603,9 @@ func (s *ImmuServer) stopCorruptionChecker() error {
 
 // Login ...
 func (s *ImmuServer) Login(ctx context.Context, r *schema.LoginRequest) (*schema.LoginResponse, error) {
+	if err := checkClientAPIVersion(r.GetApiVersion()); err != nil {
+		return nil, err
+	}
 	if !s.Options.auth {
 		return nil, fmt.Errorf("server is running with authentication disabled, please enable authentication to login")
 	}
@@ -423,7 +627,11 @@ func (s *ImmuServer) Login(ctx context.Context, r *schema.LoginRequest) (*schema
 	if err != nil {
 		return nil, err
 	}
-	loginResponse := &schema.LoginResponse{Token: []byte(token)}
+	loginResponse := &schema.LoginResponse{
+		Token:        []byte(token),
+		ApiVersion:   schema.APIVersion,
+		Capabilities: s.capabilities(),
+	}
 	if u.Username == auth.SysAdminUsername && string(r.GetPassword()) == auth.SysAdminPassword {
 		loginResponse.Warning = []byte(auth.WarnDefaultAdminPassword)
 	}
@@ -555,11 +763,11 @@ func (s *ImmuServer) Set(ctx context.Context, kv *schema.KeyValue) (*schema.Inde
 // SetSV ...
 func (s *ImmuServer) SetSV(ctx context.Context, skv *schema.StructuredKeyValue) (*schema.Index, error) {
 	s.Logger.Debugf("SetSV %+v", skv)
-	kv, err := skv.ToKV()
+	ind, err := s.getDbIndexFromCtx(ctx, "SetSV")
 	if err != nil {
 		return nil, err
 	}
-	return s.Set(ctx, kv)
+	return s.dbList.GetByIndex(ind).SetSV(skv)
 }
 
 // SafeSet ...
@@ -575,15 +783,11 @@ func (s *ImmuServer) SafeSet(ctx context.Context, opts *schema.SafeSetOptions) (
 // SafeSetSV ...
 func (s *ImmuServer) SafeSetSV(ctx context.Context, sopts *schema.SafeSetSVOptions) (*schema.Proof, error) {
 	s.Logger.Debugf("SafeSetSV %+v", sopts)
-	kv, err := sopts.Skv.ToKV()
+	ind, err := s.getDbIndexFromCtx(ctx, "SafeSetSV")
 	if err != nil {
 		return nil, err
 	}
-	opts := &schema.SafeSetOptions{
-		Kv:        kv,
-		RootIndex: sopts.RootIndex,
-	}
-	return s.SafeSet(ctx, opts)
+	return s.dbList.GetByIndex(ind).SafeSetSV(sopts)
 }
 
 // SetBatch ...
@@ -603,11 +807,50 @@ func (s *ImmuServer) SetBatch(ctx context.Context, kvl *schema.KVList) (*schema.
 // SetBatchSV ...
 func (s *ImmuServer) SetBatchSV(ctx context.Context, skvl *schema.SKVList) (*schema.Index, error) {
 	s.Logger.Debugf("SetBatchSV %+v", skvl)
-	kvl, err := skvl.ToKVList()
+	ind, err := s.getDbIndexFromCtx(ctx, "SetBatchSV")
 	if err != nil {
 		return nil, err
 	}
-	return s.SetBatch(ctx, kvl)
+	return s.dbList.GetByIndex(ind).SetBatchSV(skvl)
+}
+
+// SetBatchStream collects the KV lists sent by the client and stores them as a single batch once the stream is closed.
+// Batches larger than a transaction are not supported: committing them in several transactions would make them
+// visible to the readers one chunk at a time, since reads are not bound to a committed version.
+func (s *ImmuServer) SetBatchStream(stream schema.ImmuService_SetBatchStreamServer) error {
+	ind, err := s.getDbIndexFromCtx(stream.Context(), "SetBatchStream")
+	if err != nil {
+		return err
+	}
+	db := s.dbList.GetByIndex(ind)
+	maxEntries, maxSize := db.Store.MaxBatch()
+	kvl := &schema.KVList{}
+	chunks := 0
+	var size int64
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		for _, kv := range chunk.GetKVs() {
+			size += int64(len(kv.Key) + len(kv.Value))
+		}
+		kvl.KVs = append(kvl.KVs, chunk.GetKVs()...)
+		chunks++
+		// the batch is committed in a single transaction, fail as soon as it can not fit instead of buffering it all
+		if int64(len(kvl.KVs)) >= maxEntries || size >= maxSize {
+			return store.ErrBatchTooLarge
+		}
+	}
+	s.Logger.Debugf("set batch stream: %d entries received in %d chunks", len(kvl.KVs), chunks)
+	index, err := db.SetBatch(kvl)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(index)
 }
 
 // Get ...
@@ -635,6 +878,15 @@ func (s *ImmuServer) GetSV(ctx context.Context, k *schema.Key) (*schema.Structur
 	return si, err
 }
 
+// GetCodecs returns the codecs the value of the key was encoded with when it was stored
+func (s *ImmuServer) GetCodecs(ctx context.Context, k *schema.Key) (*schema.Codecs, error) {
+	ind, err := s.getDbIndexFromCtx(ctx, "GetCodecs")
+	if err != nil {
+		return nil, err
+	}
+	return s.dbList.GetByIndex(ind).GetCodecs(k)
+}
+
 // SafeGet ...
 func (s *ImmuServer) SafeGet(ctx context.Context, opts *schema.SafeGetOptions) (*schema.SafeItem, error) {
 	s.Logger.Debugf("safeget %s", opts.Key)
@@ -656,22 +908,11 @@ func (s *ImmuServer) SafeGetSV(ctx context.Context, opts *schema.SafeGetOptions)
 
 // GetBatch ...
 func (s *ImmuServer) GetBatch(ctx context.Context, kl *schema.KeyList) (*schema.ItemList, error) {
-	list := &schema.ItemList{}
 	ind, err := s.getDbIndexFromCtx(ctx, "GetBatch")
 	if err != nil {
 		return nil, err
 	}
-	for _, key := range kl.Keys {
-		item, err := s.dbList.GetByIndex(ind).Get(key)
-		if err == nil || err == store.ErrKeyNotFound {
-			if item != nil {
-				list.Items = append(list.Items, item)
-			}
-		} else {
-			return nil, err
-		}
-	}
-	return list, nil
+	return s.dbList.GetByIndex(ind).GetBatch(kl)
 }
 
 // GetBatchSV ...
@@ -791,12 +1032,22 @@ func (s *ImmuServer) HistorySV(ctx context.Context, key *schema.Key) (*schema.St
 
 // Health ...
 func (s *ImmuServer) Health(ctx context.Context, e *empty.Empty) (*schema.HealthResponse, error) {
+	if err := checkContextAPIVersion(ctx); err != nil {
+		return nil, err
+	}
 	ind, _ := s.getDbIndexFromCtx(ctx, "Health")
 
 	if ind < 0 { //probably immuclient hasn't logged in yet
-		return s.dbList.GetByIndex(DefaultDbIndex).Health(e)
+		ind = DefaultDbIndex
+	}
+	health, err := s.dbList.GetByIndex(ind).Health(e)
+	if err != nil {
+		return nil, err
 	}
-	return s.dbList.GetByIndex(ind).Health(e)
+	health.ApiVersion = schema.APIVersion
+	health.Capabilities = s.capabilities()
+	health.Startup = s.startup.report()
+	return health, nil
 }
 
 // Reference ...
@@ -998,6 +1249,25 @@ func (s *ImmuServer) ChangePassword(ctx context.Context, r *schema.ChangePasswor
 // CreateDatabase Create a new database instance
 func (s *ImmuServer) CreateDatabase(ctx context.Context, newdb *schema.Database) (*schema.CreateDatabaseReply, error) {
 	s.Logger.Debugf("createdatabase %+v", *newdb)
+	s.dbCreationMu.Lock()
+	defer s.dbCreationMu.Unlock()
+	db, err := s.newDatabase(ctx, newdb)
+	if err != nil {
+		return nil, err
+	}
+	s.databasenameToIndex[newdb.Databasename] = int64(s.dbList.Length())
+	s.dbList.Append(db)
+	return &schema.CreateDatabaseReply{
+		Error: &schema.Error{
+			Errorcode:    0,
+			Errormessage: fmt.Sprintf("Created Database: %s", newdb.Databasename),
+		},
+	}, nil
+}
+
+// newDatabase checks the request of the logged in user and creates the database, without registering it.
+// The caller must hold dbCreationMu until the database is registered.
+func (s *ImmuServer) newDatabase(ctx context.Context, newdb *schema.Database) (*Db, error) {
 	if !s.Options.GetAuth() {
 		return nil, fmt.Errorf("this command is available only with authentication on")
 	}
@@ -1020,6 +1290,10 @@ func (s *ImmuServer) CreateDatabase(ctx context.Context, newdb *schema.Database)
 	if _, ok := s.databasenameToIndex[newdb.GetDatabasename()]; ok {
 		return nil, fmt.Errorf("database %s already exists", newdb.GetDatabasename())
 	}
+	collation, err := store.ParseCollation(newdb.Collation)
+	if err != nil {
+		return nil, err
+	}
 
 	dataDir := s.Options.Dir
 
@@ -1027,18 +1301,62 @@ func (s *ImmuServer) CreateDatabase(ctx context.Context, newdb *schema.Database)
 		WithDbName(newdb.Databasename).
 		WithDbRootPath(dataDir).
 		WithCorruptionChecker(s.Options.CorruptionCheck).
+		WithMaxResultItems(s.Options.MaxResultItems).
+		WithMaxResultBytes(s.Options.MaxResultBytes).
+		WithMaxExecutionMemory(s.Options.MaxExecutionMemory).
+		WithMaxTimestampSkew(s.Options.MaxTimestampSkew).
+		WithTimeSource(s.Options.GetTimeSource()).
+		WithWriteValidator(s.Options.validator, s.Options.ValidatorPrefixes).
+		WithCollation(collation).
+		WithStoreProfile(s.Options.StoreProfile).
 		WithInMemoryStore(s.Options.GetInMemoryStore()).WithDbRootPath(s.Options.Dir)
 	db, err := NewDb(op, s.Logger)
 	if err != nil {
 		s.Logger.Errorf(err.Error())
 		return nil, err
 	}
+	return db, nil
+}
+
+// CreateDatabaseFromTemplate creates a new database, optionally seeded with the whole content of the template database
+func (s *ImmuServer) CreateDatabaseFromTemplate(ctx context.Context, req *schema.DatabaseTemplateRequest) (*schema.CreateDatabaseReply, error) {
+	s.Logger.Debugf("CreateDatabaseFromTemplate %+v", *req)
+	s.dbCreationMu.Lock()
+	defer s.dbCreationMu.Unlock()
+	template := strings.ToLower(req.Template)
+	tmplInd, ok := s.databasenameToIndex[template]
+	if !ok || template == SystemdbName || template == s.Options.GetSystemAdminDbName() {
+		return nil, fmt.Errorf("template database %s does not exist", req.Template)
+	}
+	if s.dbList.GetByIndex(tmplInd).getDeletion() != nil {
+		return nil, ErrDatabaseDeleted
+	}
+	// the new database keeps the key order of its template
+	newdb := &schema.Database{
+		Databasename: req.Databasename,
+		Collation:    string(s.dbList.GetByIndex(tmplInd).Store.Collation()),
+	}
+	db, err := s.newDatabase(ctx, newdb)
+	if err != nil {
+		return nil, err
+	}
+	// the database is registered once seeded, so that it is never used half copied
+	if req.WithData {
+		if err = db.CopyFrom(s.dbList.GetByIndex(tmplInd)); err != nil {
+			s.Logger.Errorf("unable to copy data from template %s: %v", template, err)
+			db.Store.Close()
+			if !db.options.GetInMemoryStore() {
+				os.RemoveAll(filepath.Join(db.options.GetDbRootPath(), db.options.GetDbName()))
+			}
+			return nil, err
+		}
+	}
 	s.databasenameToIndex[newdb.Databasename] = int64(s.dbList.Length())
 	s.dbList.Append(db)
 	return &schema.CreateDatabaseReply{
 		Error: &schema.Error{
 			Errorcode:    0,
-			Errormessage: fmt.Sprintf("Created Database: %s", newdb.Databasename),
+			Errormessage: fmt.Sprintf("Created Database: %s from template %s", newdb.Databasename, template),
 		},
 	}, nil
 }
@@ -1248,12 +1566,13 @@ func (s *ImmuServer) DatabaseList(ctx context.Context, req *empty.Empty) (*schem
 	if loggedInuser.IsSysAdmin || s.Options.GetMaintenance() {
 		for i := 0; i < s.dbList.Length(); i++ {
 			val := s.dbList.GetByIndex(int64(i))
-			if val.options.dbName == SystemdbName {
-				//do not put sysemdb in the list
+			if val.options.dbName == SystemdbName || val.getDeletion() != nil {
+				//do not put sysemdb and deleted databases in the list
 				continue
 			}
 			db := &schema.Database{
 				Databasename: val.options.dbName,
+				Collation:    string(val.Store.Collation()),
 			}
 			dbList.Databases = append(dbList.Databases, db)
 		}
@@ -1262,6 +1581,12 @@ func (s *ImmuServer) DatabaseList(ctx context.Context, req *empty.Empty) (*schem
 			db := &schema.Database{
 				Databasename: val.Database,
 			}
+			if ind, ok := s.databasenameToIndex[val.Database]; ok {
+				if s.dbList.GetByIndex(ind).getDeletion() != nil {
+					continue
+				}
+				db.Collation = string(s.dbList.GetByIndex(ind).Store.Collation())
+			}
 			dbList.Databases = append(dbList.Databases, db)
 		}
 	}
@@ -1278,6 +1603,16 @@ func (s *ImmuServer) PrintTree(ctx context.Context, r *empty.Empty) (*schema.Tre
 	return s.dbList.GetByIndex(ind).PrintTree(), nil
 }
 
+// Report ...
+func (s *ImmuServer) Report(ctx context.Context, opts *schema.ReportOptions) (*schema.StoreReport, error) {
+	s.Logger.Debugf("Report %+v", opts)
+	ind, err := s.getDbIndexFromCtx(ctx, "Report")
+	if err != nil {
+		return nil, err
+	}
+	return s.dbList.GetByIndex(ind).Report(opts)
+}
+
 // UseDatabase ...
 func (s *ImmuServer) UseDatabase(ctx context.Context, db *schema.Database) (*schema.UseDatabaseReply, error) {
 	s.Logger.Debugf("UseDatabase %+v", db)
@@ -1326,6 +1661,12 @@ func (s *ImmuServer) UseDatabase(ctx context.Context, db *schema.Database) (*sch
 			Token: "",
 		}, fmt.Errorf("%s does not exist", db.Databasename)
 	}
+	if del := s.dbList.GetByIndex(ind).getDeletion(); del != nil {
+		if del.Purged {
+			return nil, ErrDatabasePurged
+		}
+		return nil, ErrDatabaseDeleted
+	}
 	token, err := auth.GenerateToken(*user, ind)
 	if err != nil {
 		return nil, err
@@ -1488,6 +1829,9 @@ func (s *ImmuServer) getDbIndexFromCtx(ctx context.Context, methodname string) (
 	if ind < 0 {
 		return 0, fmt.Errorf("please select a database first")
 	}
+	if err = s.dbList.GetByIndex(ind).checkDeletion(methodname); err != nil {
+		return 0, err
+	}
 	if usr.IsSysAdmin {
 		return ind, nil
 	}
@@ -19,6 +19,7 @@ package server
 import (
 	"bytes"
 	"context"
+	"io"
 	"log"
 	"os"
 	"path"
@@ -27,6 +28,8 @@ import (
 
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/store"
+	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/test/bufconn"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -181,6 +184,108 @@ func TestCreateDatabase(t *testing.T) {
 		t.Errorf("Createdatabase error %v", dbrepl)
 	}
 }
+func TestCreateDatabaseFromTemplate(t *testing.T) {
+	s := newInmemoryAuthServer()
+	ctx, err := loginSysAdmin(s)
+	if err != nil {
+		t.Errorf("Login error %v", err)
+	}
+	tmplInd := s.databasenameToIndex[s.Options.GetDefaultDbName()]
+	if _, err = s.dbList.GetByIndex(tmplInd).Set(kv[0]); err != nil {
+		t.Errorf("Set error %v", err)
+	}
+
+	_, err = s.CreateDatabaseFromTemplate(ctx, &schema.DatabaseTemplateRequest{
+		Databasename: "porto",
+		Template:     "nonexistent",
+	})
+	if err == nil {
+		t.Errorf("CreateDatabaseFromTemplate expected error on missing template")
+	}
+
+	dbrepl, err := s.CreateDatabaseFromTemplate(ctx, &schema.DatabaseTemplateRequest{
+		Databasename: "porto",
+		Template:     s.Options.GetDefaultDbName(),
+		WithData:     true,
+	})
+	if err != nil {
+		t.Fatalf("CreateDatabaseFromTemplate error %v", err)
+	}
+	if dbrepl.Error.Errorcode != schema.ErrorCodes_Ok {
+		t.Errorf("CreateDatabaseFromTemplate error %v", dbrepl)
+	}
+	item, err := s.dbList.GetByIndex(s.databasenameToIndex["porto"]).Get(&schema.Key{Key: kv[0].Key})
+	if err != nil {
+		t.Fatalf("Get error %v", err)
+	}
+	if !bytes.Equal(item.Value, kv[0].Value) {
+		t.Errorf("template data not copied, expected %s got %s", kv[0].Value, item.Value)
+	}
+
+	if _, err = s.CreateDatabaseFromTemplate(ctx, &schema.DatabaseTemplateRequest{
+		Databasename: "braga",
+		Template:     s.Options.GetDefaultDbName(),
+	}); err != nil {
+		t.Fatalf("CreateDatabaseFromTemplate error %v", err)
+	}
+	if _, err = s.dbList.GetByIndex(s.databasenameToIndex["braga"]).Get(&schema.Key{Key: kv[0].Key}); err == nil {
+		t.Errorf("template data copied without WithData")
+	}
+
+	for _, template := range []string{SystemdbName, s.Options.GetSystemAdminDbName()} {
+		if _, err = s.CreateDatabaseFromTemplate(ctx, &schema.DatabaseTemplateRequest{
+			Databasename: "coimbra",
+			Template:     template,
+		}); err == nil {
+			t.Errorf("CreateDatabaseFromTemplate expected error on system database template %s", template)
+		}
+	}
+	if _, err = s.DeleteDatabase(ctx, &schema.Database{Databasename: "braga"}); err != nil {
+		t.Fatalf("DeleteDatabase error %v", err)
+	}
+	if _, err = s.CreateDatabaseFromTemplate(ctx, &schema.DatabaseTemplateRequest{
+		Databasename: "coimbra",
+		Template:     "braga",
+	}); err != ErrDatabaseDeleted {
+		t.Errorf("expected %v on deleted database template, got %v", ErrDatabaseDeleted, err)
+	}
+}
+
+func TestCreateDatabaseConcurrent(t *testing.T) {
+	s := newInmemoryAuthServer()
+	ctx, err := loginSysAdmin(s)
+	if err != nil {
+		t.Errorf("Login error %v", err)
+	}
+	const creates = 8
+	errs := make(chan error, creates)
+	for i := 0; i < creates; i++ {
+		go func(i int) {
+			var err error
+			if i%2 == 0 {
+				_, err = s.CreateDatabase(ctx, &schema.Database{Databasename: "faro"})
+			} else {
+				_, err = s.CreateDatabaseFromTemplate(ctx, &schema.DatabaseTemplateRequest{
+					Databasename: "faro",
+					Template:     s.Options.GetDefaultDbName(),
+				})
+			}
+			errs <- err
+		}(i)
+	}
+	created := 0
+	for i := 0; i < creates; i++ {
+		if <-errs == nil {
+			created++
+		}
+	}
+	if created != 1 {
+		t.Errorf("expected the database to be created once, got %d", created)
+	}
+	if s.dbList.Length() != 3 {
+		t.Errorf("expected 3 databases, got %d", s.dbList.Length())
+	}
+}
 func TestLoaduserDatabase(t *testing.T) {
 	s := newAuthServer()
 	ctx, err := loginSysAdmin(s)
@@ -860,3 +965,86 @@ func TestServer(t *testing.T) {
 	go s.Start()
 	s.CloseDatabases()
 }
+
+type setBatchStream struct {
+	mockServerStream
+	chunks []*schema.KVList
+	index  *schema.Index
+}
+
+func (s *setBatchStream) Recv() (*schema.KVList, error) {
+	if len(s.chunks) == 0 {
+		return nil, io.EOF
+	}
+	chunk := s.chunks[0]
+	s.chunks = s.chunks[1:]
+	return chunk, nil
+}
+
+func (s *setBatchStream) SendAndClose(index *schema.Index) error {
+	s.index = index
+	return nil
+}
+
+func TestSetBatchStream(t *testing.T) {
+	s := newInmemoryAuthServer()
+	ctx, err := loginSysAdmin(s)
+	assert.NoError(t, err)
+
+	stream := &setBatchStream{mockServerStream: mockServerStream{ctx: ctx}, chunks: []*schema.KVList{
+		{KVs: []*schema.KeyValue{{Key: []byte(`k1`), Value: []byte(`v1`)}}},
+		{KVs: []*schema.KeyValue{{Key: []byte(`k2`), Value: []byte(`v2`)}}},
+	}}
+	assert.NoError(t, s.SetBatchStream(stream))
+	assert.Equal(t, uint64(1), stream.index.Index)
+
+	// a batch that can not fit in a transaction is rejected while it is received
+	_, maxSize := s.dbList.GetByIndex(DefaultDbIndex).Store.MaxBatch()
+	chunk := &schema.KVList{KVs: []*schema.KeyValue{{Key: []byte(`big`), Value: make([]byte, maxSize/2)}}}
+	stream = &setBatchStream{mockServerStream: mockServerStream{ctx: ctx}, chunks: []*schema.KVList{chunk, chunk, chunk}}
+	assert.Equal(t, store.ErrBatchTooLarge, s.SetBatchStream(stream))
+	assert.Len(t, stream.chunks, 1)
+}
+
+func TestHooksDialOption(t *testing.T) {
+	s := DefaultServer()
+	dialOption, err := s.hooksDialOption()
+	assert.NoError(t, err)
+	assert.NotNil(t, dialOption)
+
+	s.Options = s.Options.WithHooksMTLs(true).WithHooksMTLsOptions(MTLsOptions{
+		Certificate: "./missing.cert.pem",
+		Pkey:        "./missing.key.pem",
+		ClientCAs:   "./missing.ca.pem",
+	})
+	_, err = s.hooksDialOption()
+	assert.Error(t, err)
+}
+
+func TestServerGetBatchLimits(t *testing.T) {
+	dbRootpath := DefaultOption().GetDbRootPath()
+	s := DefaultServer()
+	s = s.WithOptions(s.Options.WithAuth(true).WithInMemoryStore(true).WithMaxResultItems(2))
+	assert.NoError(t, s.loadDefaultDatabase(dbRootpath))
+	assert.NoError(t, s.loadSystemDatabase(dbRootpath))
+	ctx, err := loginSysAdmin(s)
+	assert.NoError(t, err)
+
+	kvs := []*schema.KeyValue{
+		{Key: []byte("k1"), Value: []byte("v1")},
+		{Key: []byte("k2"), Value: []byte("v2")},
+		{Key: []byte("k3"), Value: []byte("v3")},
+	}
+	_, err = s.SetBatch(ctx, &schema.KVList{KVs: kvs})
+	assert.NoError(t, err)
+
+	list, err := s.GetBatch(ctx, &schema.KeyList{Keys: []*schema.Key{{Key: []byte("k1")}, {Key: []byte("k2")}, {Key: []byte("missing")}}})
+	assert.NoError(t, err)
+	assert.Len(t, list.Items, 2)
+
+	keys := &schema.KeyList{Keys: []*schema.Key{{Key: []byte("k1")}, {Key: []byte("k2")}, {Key: []byte("k3")}}}
+	_, err = s.GetBatch(ctx, keys)
+	assert.Equal(t, store.ErrMaxResultItems, err)
+	_, err = s.GetBatchSV(ctx, keys)
+	assert.Equal(t, store.ErrMaxResultItems, err)
+}
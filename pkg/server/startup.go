@@ -0,0 +1,233 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/codenotary/immudb/cmd/version"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/logger"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrServerStarting is returned by the methods whose context is done before the databases are loaded
+var ErrServerStarting = status.New(codes.Unavailable, "the server is starting, see the startup progress").Err()
+
+// Startup phases
+const (
+	startupPhaseStarting  = "starting"
+	startupPhaseMigrating = "migrating data directory"
+	startupPhaseSystemDbs = "loading system databases"
+	startupPhaseDbs       = "loading databases"
+	startupPhaseReady     = "ready"
+)
+
+// startupProgress tracks the work done at startup, opening large databases can take a long time, so that the server
+// can report how far it is instead of appearing hung
+type startupProgress struct {
+	sync.RWMutex
+	log            logger.Logger
+	phase          string
+	done           int
+	total          int
+	startedAt      time.Time
+	phaseStartedAt time.Time
+	readyAt        time.Time
+	readyc         chan struct{}
+}
+
+func newStartupProgress(log logger.Logger) *startupProgress {
+	now := time.Now()
+	return &startupProgress{log: log, phase: startupPhaseStarting, startedAt: now, phaseStartedAt: now, readyc: make(chan struct{})}
+}
+
+// begin starts a phase made of total steps, 0 when they are not known
+func (p *startupProgress) begin(phase string, total int) {
+	p.Lock()
+	defer p.Unlock()
+	p.phase = phase
+	p.done = 0
+	p.total = total
+	p.phaseStartedAt = time.Now()
+	if total > 0 {
+		p.log.Infof("Startup: %s, 0/%d", phase, total)
+	} else {
+		p.log.Infof("Startup: %s", phase)
+	}
+}
+
+// step records the completion of one of the steps of the current phase
+func (p *startupProgress) step(name string) {
+	p.Lock()
+	p.done++
+	p.Unlock()
+	progress := p.report()
+	p.log.Infof("Startup: %s, %s done %d/%d (%d%%), ETA %s",
+		progress.Phase, name, progress.Done, progress.Total, progress.Percent,
+		(time.Duration(progress.EtaMs) * time.Millisecond).Round(time.Second))
+}
+
+// replay returns the function reporting the entries replayed in the tree of database db when it is opened.
+// The replay is a nested phase counted in entries, the current phase is resumed once it completes.
+func (p *startupProgress) replay(db string) func(done, total uint64) {
+	phase := fmt.Sprintf("replaying %s tree", db)
+	var resumedPhase string
+	var resumedDone, resumedTotal int
+	var resumedAt time.Time
+	return func(done, total uint64) {
+		p.Lock()
+		if p.phase != phase {
+			resumedPhase, resumedDone, resumedTotal, resumedAt = p.phase, p.done, p.total, p.phaseStartedAt
+			p.phase, p.total, p.phaseStartedAt = phase, int(total), time.Now()
+		}
+		p.done = int(done)
+		p.Unlock()
+		progress := p.report()
+		p.log.Infof("Startup: %s, %d/%d entries (%d%%), ETA %s",
+			phase, done, total, progress.Percent, (time.Duration(progress.EtaMs) * time.Millisecond).Round(time.Second))
+		if done == total {
+			p.Lock()
+			p.phase, p.done, p.total, p.phaseStartedAt = resumedPhase, resumedDone, resumedTotal, resumedAt
+			p.Unlock()
+		}
+	}
+}
+
+// ready marks the end of the startup
+func (p *startupProgress) ready() {
+	p.Lock()
+	defer p.Unlock()
+	if p.phase == startupPhaseReady {
+		return
+	}
+	p.phase = startupPhaseReady
+	p.done, p.total = 0, 0
+	p.readyAt = time.Now()
+	close(p.readyc)
+	p.log.Infof("Startup: ready in %s", p.readyAt.Sub(p.startedAt).Round(time.Millisecond))
+}
+
+func (p *startupProgress) isReady() bool {
+	select {
+	case <-p.readyc:
+		return true
+	default:
+		return false
+	}
+}
+
+// wait waits until the startup ends or ctx is done
+func (p *startupProgress) wait(ctx context.Context) error {
+	select {
+	case <-p.readyc:
+		return nil
+	case <-ctx.Done():
+		return ErrServerStarting
+	}
+}
+
+func (p *startupProgress) report() *schema.StartupProgress {
+	p.RLock()
+	defer p.RUnlock()
+	progress := &schema.StartupProgress{
+		Phase: p.phase,
+		Done:  uint32(p.done),
+		Total: uint32(p.total),
+		Ready: p.phase == startupPhaseReady,
+	}
+	end := time.Now()
+	if progress.Ready {
+		end = p.readyAt
+		progress.Percent = 100
+	}
+	progress.ElapsedMs = end.Sub(p.startedAt).Milliseconds()
+	if p.total > 0 {
+		progress.Percent = uint32(p.done * 100 / p.total)
+		if p.done > 0 {
+			perStep := time.Since(p.phaseStartedAt) / time.Duration(p.done)
+			progress.EtaMs = (perStep * time.Duration(p.total-p.done)).Milliseconds()
+		}
+	}
+	return progress
+}
+
+// ServeHTTP serves the startup progress as JSON, with a 503 status until the server is ready
+func (p *startupProgress) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	progress := p.report()
+	w.Header().Set("Content-Type", "application/json")
+	if !progress.Ready {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(progress)
+}
+
+// startupInterceptors hold the methods invoked while the databases are loaded until the startup ends, except
+// Health and StartupProgress which report its progress without authentication, as no user can log in yet
+func (s *ImmuServer) startupInterceptors(adminPort bool) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	unary := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if s.startup.isReady() {
+			return handler(ctx, req)
+		}
+		if s.Options.AdminPort > 0 {
+			if err := checkPort(info.FullMethod, adminPort); err != nil {
+				return nil, err
+			}
+		}
+		switch methodName(info.FullMethod) {
+		case "Health":
+			if err := checkContextAPIVersion(ctx); err != nil {
+				return nil, err
+			}
+			return &schema.HealthResponse{
+				Version:      version.VersionStr(),
+				ApiVersion:   schema.APIVersion,
+				Capabilities: s.capabilities(),
+				Startup:      s.startup.report(),
+			}, nil
+		case "StartupProgress":
+			return s.startup.report(), nil
+		}
+		if err := s.startup.wait(ctx); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+	stream := func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := s.startup.wait(ss.Context()); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+	return unary, stream
+}
+
+// StartupProgress returns the progress of the startup
+func (s *ImmuServer) StartupProgress(ctx context.Context, req *empty.Empty) (*schema.StartupProgress, error) {
+	if _, err := s.getDbIndexFromCtx(ctx, "StartupProgress"); err != nil {
+		return nil, err
+	}
+	return s.startup.report(), nil
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/logger"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestStartupProgress(t *testing.T) {
+	p := newStartupProgress(logger.NewSimpleLogger("immudb ", os.Stderr))
+	progress := p.report()
+	assert.Equal(t, startupPhaseStarting, progress.Phase)
+	assert.False(t, progress.Ready)
+
+	p.begin(startupPhaseDbs, 4)
+	time.Sleep(10 * time.Millisecond)
+	p.step("db1")
+	progress = p.report()
+	assert.Equal(t, startupPhaseDbs, progress.Phase)
+	assert.Equal(t, uint32(1), progress.Done)
+	assert.Equal(t, uint32(4), progress.Total)
+	assert.Equal(t, uint32(25), progress.Percent)
+	assert.True(t, progress.EtaMs >= 20)
+
+	rec := httptest.NewRecorder()
+	p.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/startup", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	p.ready()
+	progress = p.report()
+	assert.True(t, progress.Ready)
+	assert.Equal(t, uint32(100), progress.Percent)
+	assert.Equal(t, int64(0), progress.EtaMs)
+	elapsed := progress.ElapsedMs
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, elapsed, p.report().ElapsedMs)
+
+	rec = httptest.NewRecorder()
+	p.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/startup", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var served schema.StartupProgress
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&served))
+	assert.Equal(t, startupPhaseReady, served.Phase)
+	assert.True(t, served.Ready)
+}
+
+func TestStartupReplayProgress(t *testing.T) {
+	p := newStartupProgress(logger.NewSimpleLogger("immudb ", os.Stderr))
+	p.begin(startupPhaseDbs, 2)
+	p.step("db1")
+
+	replay := p.replay("db2")
+	replay(0, 200)
+	replay(50, 200)
+	progress := p.report()
+	assert.Equal(t, "replaying db2 tree", progress.Phase)
+	assert.Equal(t, uint32(50), progress.Done)
+	assert.Equal(t, uint32(200), progress.Total)
+	assert.Equal(t, uint32(25), progress.Percent)
+
+	// the loading of the databases is resumed once the tree is replayed
+	replay(200, 200)
+	progress = p.report()
+	assert.Equal(t, startupPhaseDbs, progress.Phase)
+	assert.Equal(t, uint32(1), progress.Done)
+	assert.Equal(t, uint32(2), progress.Total)
+}
+
+func TestStartupInterceptors(t *testing.T) {
+	s := newInmemoryAuthServer()
+	ui, si := s.startupInterceptors(false)
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return nil, nil
+	}
+
+	res, err := ui(context.Background(), &empty.Empty{}, &grpc.UnaryServerInfo{FullMethod: "/immudb.schema.ImmuService/Health"}, handler)
+	assert.NoError(t, err)
+	assert.False(t, called)
+	assert.False(t, res.(*schema.HealthResponse).Startup.Ready)
+	res, err = ui(context.Background(), &empty.Empty{}, &grpc.UnaryServerInfo{FullMethod: "/immudb.schema.ImmuService/StartupProgress"}, handler)
+	assert.NoError(t, err)
+	assert.False(t, called)
+	assert.Equal(t, startupPhaseStarting, res.(*schema.StartupProgress).Phase)
+
+	// the other methods are held until the startup ends
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = ui(ctx, &schema.Key{}, &grpc.UnaryServerInfo{FullMethod: "/immudb.schema.ImmuService/Get"}, handler)
+	assert.Equal(t, ErrServerStarting, err)
+	assert.False(t, called)
+	err = si(nil, &mockServerStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: "/immudb.schema.ImmuService/SetBatchStream"},
+		func(srv interface{}, stream grpc.ServerStream) error {
+			called = true
+			return nil
+		})
+	assert.Equal(t, ErrServerStarting, err)
+	assert.False(t, called)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		s.startup.ready()
+	}()
+	_, err = ui(context.Background(), &schema.Key{}, &grpc.UnaryServerInfo{FullMethod: "/immudb.schema.ImmuService/Get"}, handler)
+	assert.NoError(t, err)
+	assert.True(t, called)
+}
+
+func TestServerStartupProgress(t *testing.T) {
+	s := newInmemoryAuthServer()
+	s.startup.ready()
+	ctx, err := loginSysAdmin(s)
+	assert.NoError(t, err)
+
+	progress, err := s.StartupProgress(ctx, &empty.Empty{})
+	assert.NoError(t, err)
+	assert.True(t, progress.Ready)
+
+	health, err := s.Health(context.Background(), &empty.Empty{})
+	assert.NoError(t, err)
+	assert.True(t, health.Startup.Ready)
+
+	readerCtx := loginAs(t, s, ctx, "reader", auth.PermissionR)
+	_, err = s.StartupProgress(readerCtx, &empty.Empty{})
+	assert.Error(t, err)
+}
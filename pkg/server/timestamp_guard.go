@@ -0,0 +1,144 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"sync"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/store"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Errors returned by the timestamp guard
+var (
+	ErrTimestampTooOld    = status.New(codes.FailedPrecondition, "timestamp goes backwards beyond the allowed clock skew").Err()
+	ErrTimestampInFuture  = status.New(codes.FailedPrecondition, "timestamp is ahead of the server time source beyond the allowed clock skew").Err()
+	ErrTimestampNotSigned = status.New(codes.InvalidArgument, "missing timestamp").Err()
+)
+
+// TimeSource provides the trusted time used to validate the timestamps of structured values
+type TimeSource interface {
+	Now() time.Time
+}
+
+type systemTimeSource struct{}
+
+func (systemTimeSource) Now() time.Time {
+	return time.Now()
+}
+
+// timestampLoadDepth is how many of the latest entries are looked at to find the last committed timestamp
+const timestampLoadDepth = 16
+
+// timestampGuard rejects structured values whose timestamp is not consistent with the previously committed ones
+// or with the trusted time source. A zero maxSkew disables the guard.
+type timestampGuard struct {
+	sync.Mutex
+	source  TimeSource
+	maxSkew time.Duration
+	last    time.Time
+}
+
+func newTimestampGuard(source TimeSource, maxSkew time.Duration) *timestampGuard {
+	if source == nil {
+		source = systemTimeSource{}
+	}
+	return &timestampGuard{source: source, maxSkew: maxSkew}
+}
+
+// write validates the given unix timestamps, in seconds, runs write and records the most recent timestamp once the
+// values are written. The guard is held from the check through the commit, so concurrent writers of structured values
+// cannot both pass against the same last timestamp.
+func (g *timestampGuard) write(write func() error, timestamps ...uint64) error {
+	if g == nil || g.maxSkew <= 0 {
+		return write()
+	}
+	g.Lock()
+	defer g.Unlock()
+	latest, err := g.check(timestamps...)
+	if err != nil {
+		return err
+	}
+	if err := write(); err != nil {
+		return err
+	}
+	g.commit(latest)
+	return nil
+}
+
+// check validates the given timestamps and returns the most recent one. The guard must be held.
+func (g *timestampGuard) check(timestamps ...uint64) (time.Time, error) {
+	now := g.source.Now()
+	var latest time.Time
+	for _, ts := range timestamps {
+		if ts == 0 {
+			return time.Time{}, ErrTimestampNotSigned
+		}
+		t := time.Unix(int64(ts), 0)
+		if t.After(now.Add(g.maxSkew)) {
+			return time.Time{}, ErrTimestampInFuture
+		}
+		if t.Before(g.last.Add(-g.maxSkew)) {
+			return time.Time{}, ErrTimestampTooOld
+		}
+		if t.After(latest) {
+			latest = t
+		}
+	}
+	return latest, nil
+}
+
+// commit records t as the last timestamp if it is the most recent one. The guard must be held.
+func (g *timestampGuard) commit(t time.Time) {
+	if t.After(g.last) {
+		g.last = t
+	}
+}
+
+// load records the timestamp of the latest structured value among the last entries of the store, so that the guard
+// holds across restarts. Entries which are not structured values, or whose timestamp is ahead of the time source,
+// are skipped.
+func (g *timestampGuard) load(st *store.Store) {
+	if g == nil || g.maxSkew <= 0 {
+		return
+	}
+	root, err := st.CurrentRoot()
+	if err != nil || root.GetRoot() == nil {
+		return
+	}
+	g.Lock()
+	defer g.Unlock()
+	limit := g.source.Now().Add(g.maxSkew)
+	for i := uint64(0); i < timestampLoadDepth && i <= root.Index; i++ {
+		item, err := st.ByIndex(schema.Index{Index: root.Index - i})
+		if err != nil {
+			continue
+		}
+		sitem, err := item.ToSItem()
+		if err != nil || sitem.GetValue().GetTimestamp() == 0 {
+			continue
+		}
+		if t := time.Unix(int64(sitem.Value.Timestamp), 0); !t.After(limit) {
+			g.commit(t)
+			return
+		}
+	}
+}
@@ -51,23 +51,34 @@ type ImmuServer struct {
 	Logger              logger.Logger
 	Options             Options
 	GrpcServer          *grpc.Server
+	AdminGrpcServer     *grpc.Server
 	Pid                 PIDFile
 	quit                chan struct{}
 	databasenameToIndex map[string]int64
+	dbCreationMu        *sync.Mutex // held from the name check of a new database until it is registered
 	userdata            *usernameToUserdataMap
 	multidbmode         bool
 	Cc                  CorruptionChecker
+	usage               *usageTracker
+	loadShedder         *loadShedder
+	logSink             *logSink
+	startup             *startupProgress
+	logs                *logger.RingLogger
 }
 
 // DefaultServer ...
 func DefaultServer() *ImmuServer {
+	log := logger.NewSimpleLogger("immudb ", os.Stderr)
 	return &ImmuServer{
 		dbList:              NewDatabaseList(),
-		Logger:              logger.NewSimpleLogger("immudb ", os.Stderr),
+		Logger:              log,
 		Options:             DefaultOptions(),
 		quit:                make(chan struct{}),
 		databasenameToIndex: make(map[string]int64),
+		dbCreationMu:        &sync.Mutex{},
 		userdata:            &usernameToUserdataMap{Userdata: make(map[string]*auth.User)},
+		usage:               newUsageTracker(0, 0),
+		startup:             newStartupProgress(log),
 	}
 }
 
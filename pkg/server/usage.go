@@ -0,0 +1,347 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/store/sysstore"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// QUOTA_WARNING_HEADER is set on the responses sent to users who exceeded their soft quota
+const QUOTA_WARNING_HEADER = "immudb-quota-warning"
+
+// ErrQuotaExceeded is returned when a user who exceeded the hard quota tries to write
+var ErrQuotaExceeded = status.New(codes.ResourceExhausted, "write quota exceeded").Err()
+
+// writeMethods are the methods whose request size is accounted as written bytes
+var writeMethods = map[string]bool{
+	"Set":            true,
+	"SetSV":          true,
+	"SafeSet":        true,
+	"SafeSetSV":      true,
+	"SetBatch":       true,
+	"SetBatchSV":     true,
+	"SetBatchStream": true,
+	"Reference":      true,
+	"SafeReference":  true,
+	"ZAdd":           true,
+	"SafeZAdd":       true,
+	"BulkLoad":       true,
+}
+
+// usageFlushInterval is how often the usages whose written bytes changed since the last flush are persisted into the
+// system database, which is append-only: each flush adds an entry per changed user
+const usageFlushInterval = 5 * time.Minute
+
+// usageTracker keeps the operations and the written bytes of each user. Usages are restored from the system
+// database at startup. Those whose written bytes changed are persisted back periodically, so that the quotas survive
+// restarts, while the operation counters of read-only users are persisted only on shutdown. changed holds the users
+// who wrote since the last flush, touched those whose counters changed.
+type usageTracker struct {
+	sync.Mutex
+	softBytes uint64
+	hardBytes uint64
+	usages    map[string]*schema.UserUsage
+	warned    map[string]bool
+	changed   map[string]bool
+	touched   map[string]bool
+	done      chan struct{}
+	stopped   chan struct{}
+}
+
+func newUsageTracker(softBytes, hardBytes uint64) *usageTracker {
+	return &usageTracker{
+		softBytes: softBytes,
+		hardBytes: hardBytes,
+		usages:    map[string]*schema.UserUsage{},
+		warned:    map[string]bool{},
+		changed:   map[string]bool{},
+		touched:   map[string]bool{},
+	}
+}
+
+func (u *usageTracker) get(user string) *schema.UserUsage {
+	usage, ok := u.usages[user]
+	if !ok {
+		usage = &schema.UserUsage{User: user}
+		u.usages[user] = usage
+	}
+	u.touched[user] = true
+	return usage
+}
+
+// restore sets the usages persisted before the server started
+func (u *usageTracker) restore(usages []*schema.UserUsage) {
+	u.Lock()
+	defer u.Unlock()
+	for _, usage := range usages {
+		u.usages[usage.User] = usage
+	}
+}
+
+// takeChanged returns a copy of the usages whose written bytes changed since the previous call or, when touched is
+// set, of those whose operation counters changed too
+func (u *usageTracker) takeChanged(touched bool) []*schema.UserUsage {
+	u.Lock()
+	defer u.Unlock()
+	users := u.changed
+	if touched {
+		users = u.touched
+	}
+	var changed []*schema.UserUsage
+	for user := range users {
+		changed = append(changed, proto.Clone(u.usages[user]).(*schema.UserUsage))
+	}
+	u.changed = map[string]bool{}
+	if touched {
+		u.touched = map[string]bool{}
+	}
+	return changed
+}
+
+// check counts the operation and returns ErrQuotaExceeded when a write is attempted beyond the hard quota.
+// softExceeded is true when the user already wrote more than the soft quota, firstWarning only the first time.
+func (u *usageTracker) check(user string, write bool) (softExceeded bool, firstWarning bool, err error) {
+	u.Lock()
+	defer u.Unlock()
+	usage := u.get(user)
+	usage.Operations++
+	if write && u.hardBytes > 0 && usage.WrittenBytes >= u.hardBytes {
+		usage.RejectedOperations++
+		return true, false, ErrQuotaExceeded
+	}
+	if u.softBytes == 0 || usage.WrittenBytes < u.softBytes {
+		return false, false, nil
+	}
+	firstWarning = !u.warned[user]
+	u.warned[user] = true
+	return true, firstWarning, nil
+}
+
+// written accounts a successful write of the given size
+func (u *usageTracker) written(user string, bytes uint64) {
+	u.Lock()
+	defer u.Unlock()
+	usage := u.get(user)
+	usage.WriteOperations++
+	usage.WrittenBytes += bytes
+	u.changed[user] = true
+}
+
+func (u *usageTracker) list() *schema.UsageList {
+	u.Lock()
+	defer u.Unlock()
+	list := &schema.UsageList{}
+	for _, usage := range u.usages {
+		list.Usages = append(list.Usages, proto.Clone(usage).(*schema.UserUsage))
+	}
+	sort.Slice(list.Usages, func(i, j int) bool {
+		return list.Usages[i].User < list.Usages[j].User
+	})
+	return list
+}
+
+func (s *ImmuServer) quotaWarning(user string, log bool) metadata.MD {
+	if log {
+		s.Logger.Warningf("user %s exceeded the soft write quota of %d bytes", user, s.Options.QuotaSoftBytes)
+	}
+	return metadata.Pairs(QUOTA_WARNING_HEADER, fmt.Sprintf("soft write quota of %d bytes exceeded", s.Options.QuotaSoftBytes))
+}
+
+func (s *ImmuServer) usageUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	jsUser, err := auth.GetLoggedInUser(ctx)
+	if err != nil {
+		return handler(ctx, req)
+	}
+	write := writeMethods[methodName(info.FullMethod)]
+	softExceeded, firstWarning, err := s.usage.check(jsUser.Username, write)
+	if err != nil {
+		return nil, err
+	}
+	if softExceeded {
+		grpc.SetHeader(ctx, s.quotaWarning(jsUser.Username, firstWarning))
+	}
+	res, err := handler(ctx, req)
+	if write {
+		if bytes, ok := writtenBytes(req, res, err); ok {
+			s.usage.written(jsUser.Username, bytes)
+		}
+	}
+	return res, err
+}
+
+// writtenBytes returns the bytes a write accounts: the size of the request when it succeeded or, for bulk loads, the
+// bytes loaded, also when the load was interrupted after committing some batches
+func writtenBytes(req, res interface{}, err error) (uint64, bool) {
+	if _, ok := req.(*schema.BulkLoadRequest); ok {
+		reply, _ := res.(*schema.BulkLoadReply)
+		if err != nil {
+			reply = nil
+			for _, detail := range status.Convert(err).Details() {
+				if progress, ok := detail.(*schema.BulkLoadReply); ok {
+					reply = progress
+				}
+			}
+		}
+		if reply == nil {
+			return 0, false
+		}
+		return reply.Bytes, true
+	}
+	if m, ok := req.(proto.Message); ok && err == nil {
+		return uint64(proto.Size(m)), true
+	}
+	return 0, false
+}
+
+type usageServerStream struct {
+	grpc.ServerStream
+	bytes uint64
+}
+
+func (u *usageServerStream) RecvMsg(m interface{}) error {
+	err := u.ServerStream.RecvMsg(m)
+	if err == nil {
+		if msg, ok := m.(proto.Message); ok {
+			u.bytes += uint64(proto.Size(msg))
+		}
+	}
+	return err
+}
+
+func (s *ImmuServer) usageStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	jsUser, err := auth.GetLoggedInUser(ss.Context())
+	if err != nil {
+		return handler(srv, ss)
+	}
+	write := writeMethods[methodName(info.FullMethod)]
+	softExceeded, firstWarning, err := s.usage.check(jsUser.Username, write)
+	if err != nil {
+		return err
+	}
+	if softExceeded {
+		ss.SetHeader(s.quotaWarning(jsUser.Username, firstWarning))
+	}
+	us := &usageServerStream{ServerStream: ss}
+	if err = handler(srv, us); err == nil && write {
+		s.usage.written(jsUser.Username, us.bytes)
+	}
+	return err
+}
+
+// loadUsage restores the usages persisted in the system database
+func (s *ImmuServer) loadUsage() error {
+	list, err := s.dbList.GetByIndex(SystemDbIndex).Store.Scan(schema.ScanOptions{Prefix: []byte{sysstore.KeyPrefixUsage}})
+	if err != nil {
+		return err
+	}
+	usages := make([]*schema.UserUsage, 0, len(list.Items))
+	for _, item := range list.Items {
+		usage := &schema.UserUsage{}
+		if err := proto.Unmarshal(item.Value, usage); err != nil {
+			return err
+		}
+		usages = append(usages, usage)
+	}
+	s.usage.restore(usages)
+	return nil
+}
+
+// flushUsage persists the usages whose written bytes changed since the previous flush into the system database,
+// with touched set those whose operation counters changed too
+func (s *ImmuServer) flushUsage(touched bool) error {
+	changed := s.usage.takeChanged(touched)
+	if len(changed) == 0 {
+		return nil
+	}
+	list := schema.KVList{}
+	for _, usage := range changed {
+		value, err := proto.Marshal(usage)
+		if err != nil {
+			return err
+		}
+		list.KVs = append(list.KVs, &schema.KeyValue{Key: sysstore.AddKeyPrefix([]byte(usage.User), sysstore.KeyPrefixUsage), Value: value})
+	}
+	_, err := s.dbList.GetByIndex(SystemDbIndex).Store.SetBatch(list)
+	return err
+}
+
+// startUsageFlusher restores the persisted usages and starts persisting those whose written bytes changed every
+// usageFlushInterval.
+// Nothing is persisted without the system database, which is not created when auth is off.
+func (s *ImmuServer) startUsageFlusher() error {
+	if s.dbList.Length() <= SystemDbIndex {
+		return nil
+	}
+	if err := s.loadUsage(); err != nil {
+		return err
+	}
+	s.usage.done = make(chan struct{})
+	s.usage.stopped = make(chan struct{})
+	go func() {
+		defer close(s.usage.stopped)
+		ticker := time.NewTicker(usageFlushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.usage.done:
+				return
+			case <-ticker.C:
+				if err := s.flushUsage(false); err != nil {
+					s.Logger.Errorf("Unable to persist the usage: %v", err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// stopUsageFlusher stops the periodic flush and persists the last changes, it must be called before closing the
+// databases
+func (s *ImmuServer) stopUsageFlusher() {
+	if s.usage.done == nil {
+		return
+	}
+	close(s.usage.done)
+	<-s.usage.stopped
+	s.usage.done = nil
+	if err := s.flushUsage(true); err != nil {
+		s.Logger.Errorf("Unable to persist the usage: %v", err)
+	}
+}
+
+// Usage ...
+func (s *ImmuServer) Usage(ctx context.Context, req *empty.Empty) (*schema.UsageList, error) {
+	s.Logger.Debugf("Usage")
+	if _, err := s.getDbIndexFromCtx(ctx, "Usage"); err != nil {
+		return nil, err
+	}
+	return s.usage.list(), nil
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUsageQuotas(t *testing.T) {
+	s := newInmemoryAuthServer()
+	ctx, err := loginSysAdmin(s)
+	assert.NoError(t, err)
+	rwCtx := loginAs(t, s, ctx, "writer", auth.PermissionRW)
+
+	req := &schema.KeyValue{Key: []byte(`key`), Value: []byte(`value`)}
+	size := uint64(proto.Size(req))
+	s.usage.softBytes = size
+	s.usage.hardBytes = 2 * size
+
+	set := &grpc.UnaryServerInfo{FullMethod: "/immudb.schema.ImmuService/Set"}
+	get := &grpc.UnaryServerInfo{FullMethod: "/immudb.schema.ImmuService/Get"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &empty.Empty{}, nil
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err = s.usageUnaryInterceptor(rwCtx, req, set, handler)
+		assert.NoError(t, err)
+	}
+	_, err = s.usageUnaryInterceptor(rwCtx, req, set, handler)
+	assert.Equal(t, ErrQuotaExceeded, err)
+	_, err = s.usageUnaryInterceptor(rwCtx, &schema.Key{Key: []byte(`key`)}, get, handler)
+	assert.NoError(t, err)
+
+	_, err = s.Usage(rwCtx, &empty.Empty{})
+	assert.Error(t, err)
+	adminCtx := loginAs(t, s, ctx, "dbadmin", auth.PermissionAdmin)
+	_, err = s.Usage(adminCtx, &empty.Empty{})
+	assert.Error(t, err)
+
+	usages, err := s.Usage(ctx, &empty.Empty{})
+	assert.NoError(t, err)
+	var usage *schema.UserUsage
+	for _, u := range usages.Usages {
+		if u.User == "writer" {
+			usage = u
+		}
+	}
+	assert.NotNil(t, usage)
+	assert.Equal(t, uint64(4), usage.Operations)
+	assert.Equal(t, uint64(2), usage.WriteOperations)
+	assert.Equal(t, 2*size, usage.WrittenBytes)
+	assert.Equal(t, uint64(1), usage.RejectedOperations)
+}
+
+func TestUsageBulkLoad(t *testing.T) {
+	s := newInmemoryAuthServer()
+	ctx, err := loginSysAdmin(s)
+	assert.NoError(t, err)
+	s.usage.hardBytes = 10
+
+	bulkLoad := &grpc.UnaryServerInfo{FullMethod: "/immudb.schema.ImmuService/BulkLoad"}
+	req := &schema.BulkLoadRequest{Filename: "data.bulk"}
+	_, err = s.usageUnaryInterceptor(ctx, req, bulkLoad, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &schema.BulkLoadReply{Bytes: 6}, nil
+	})
+	assert.NoError(t, err)
+	_, err = s.usageUnaryInterceptor(ctx, req, bulkLoad, func(ctx context.Context, req interface{}) (interface{}, error) {
+		st, _ := status.New(codes.Internal, "interrupted").WithDetails(&schema.BulkLoadReply{Bytes: 4})
+		return (*schema.BulkLoadReply)(nil), st.Err()
+	})
+	assert.Error(t, err)
+	_, err = s.usageUnaryInterceptor(ctx, req, bulkLoad, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &schema.BulkLoadReply{}, nil
+	})
+	assert.Equal(t, ErrQuotaExceeded, err)
+	assert.Equal(t, uint64(10), s.usage.usages[auth.SysAdminUsername].WrittenBytes)
+}
+
+func TestUsagePersistence(t *testing.T) {
+	s := newInmemoryAuthServer()
+	assert.NoError(t, s.startUsageFlusher())
+	s.usage.check("writer", true)
+	s.usage.written("writer", 42)
+	s.stopUsageFlusher()
+
+	// a new tracker, as after a restart, restores the usage from the system database
+	s.usage = newUsageTracker(0, 0)
+	assert.NoError(t, s.loadUsage())
+	usages := s.usage.list().Usages
+	assert.Len(t, usages, 1)
+	assert.Equal(t, "writer", usages[0].User)
+	assert.Equal(t, uint64(42), usages[0].WrittenBytes)
+	assert.Equal(t, uint64(1), usages[0].WriteOperations)
+	assert.Empty(t, s.usage.takeChanged(true))
+}
+
+func TestUsageReadOnlyNotPersisted(t *testing.T) {
+	s := newInmemoryAuthServer()
+	assert.NoError(t, s.startUsageFlusher())
+	sysStore := s.dbList.GetByIndex(SystemDbIndex).Store
+	before := sysStore.CountAll()
+
+	for i := 0; i < 10; i++ {
+		_, _, err := s.usage.check("reader", false)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, s.flushUsage(false))
+	assert.Equal(t, before, sysStore.CountAll())
+
+	// the counters of read-only users are persisted once, on shutdown
+	s.stopUsageFlusher()
+	assert.Equal(t, before+1, sysStore.CountAll())
+	s.usage = newUsageTracker(0, 0)
+	assert.NoError(t, s.loadUsage())
+	assert.Equal(t, uint64(10), s.usage.list().Usages[0].Operations)
+}
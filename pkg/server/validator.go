@@ -0,0 +1,79 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/store"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validationTimeout bounds the time a write waits for an external validator
+const validationTimeout = 10 * time.Second
+
+// ErrWriteRejected is returned when the write validator rejects a write without giving a reason
+var ErrWriteRejected = status.New(codes.FailedPrecondition, "write rejected by the validator").Err()
+
+// WriteValidator is invoked before committing the writes of the entries with one of the validated prefixes, with the
+// name of the database and all the entries of the write. Returning an error rejects the whole write.
+type WriteValidator interface {
+	Validate(database string, entries []*schema.KeyValue) error
+}
+
+type grpcValidator struct {
+	client schema.ImmuValidatorClient
+}
+
+// NewGrpcValidator returns a WriteValidator delegating each decision to an external ImmuValidator service
+func NewGrpcValidator(conn *grpc.ClientConn) WriteValidator {
+	return &grpcValidator{client: schema.NewImmuValidatorClient(conn)}
+}
+
+func (v *grpcValidator) Validate(database string, entries []*schema.KeyValue) error {
+	ctx, cancel := context.WithTimeout(context.Background(), validationTimeout)
+	defer cancel()
+	res, err := v.client.Validate(ctx, &schema.ValidationRequest{Database: database, Entries: entries})
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "validation service unavailable: %v", err)
+	}
+	if !res.Allowed {
+		if res.Reason != "" {
+			return status.Error(codes.FailedPrecondition, res.Reason)
+		}
+		return ErrWriteRejected
+	}
+	return nil
+}
+
+// dbValidator binds a WriteValidator to a database for the store
+type dbValidator struct {
+	validator WriteValidator
+	database  string
+}
+
+func (v *dbValidator) Validate(entries []*schema.KeyValue) error {
+	return v.validator.Validate(v.database, entries)
+}
+
+func newDbValidator(validator WriteValidator, database string) store.WriteValidator {
+	return &dbValidator{validator: validator, database: database}
+}
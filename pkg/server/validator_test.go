@@ -0,0 +1,91 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type recordingValidator struct {
+	databases []string
+}
+
+func (v *recordingValidator) Validate(database string, entries []*schema.KeyValue) error {
+	v.databases = append(v.databases, database)
+	if string(entries[0].Value) == "invalid" {
+		return ErrWriteRejected
+	}
+	return nil
+}
+
+type fakeValidatorClient struct {
+	req *schema.ValidationRequest
+	res *schema.ValidationResponse
+	err error
+}
+
+func (c *fakeValidatorClient) Validate(ctx context.Context, in *schema.ValidationRequest, opts ...grpc.CallOption) (*schema.ValidationResponse, error) {
+	c.req = in
+	return c.res, c.err
+}
+
+func TestServerWriteValidator(t *testing.T) {
+	validator := &recordingValidator{}
+	s := DefaultServer()
+	s = s.WithOptions(s.Options.WithAuth(true).WithInMemoryStore(true).WithWriteValidator(validator).WithValidatorPrefixes([]string{"orders/"}))
+	dbRootpath := DefaultOption().GetDbRootPath()
+	assert.NoError(t, s.loadDefaultDatabase(dbRootpath))
+	assert.NoError(t, s.loadSystemDatabase(dbRootpath))
+	ctx, err := loginSysAdmin(s)
+	assert.NoError(t, err)
+
+	_, err = s.Set(ctx, &schema.KeyValue{Key: []byte("orders/1"), Value: []byte("valid")})
+	assert.NoError(t, err)
+	_, err = s.Set(ctx, &schema.KeyValue{Key: []byte("orders/2"), Value: []byte("invalid")})
+	assert.Equal(t, ErrWriteRejected, err)
+	_, err = s.Set(ctx, &schema.KeyValue{Key: []byte("other"), Value: []byte("invalid")})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{s.Options.GetDefaultDbName(), s.Options.GetDefaultDbName()}, validator.databases)
+}
+
+func TestGrpcValidator(t *testing.T) {
+	client := &fakeValidatorClient{res: &schema.ValidationResponse{Allowed: true}}
+	validator := &grpcValidator{client: client}
+	entries := []*schema.KeyValue{{Key: []byte("k"), Value: []byte("v")}}
+	assert.NoError(t, validator.Validate("db", entries))
+	assert.Equal(t, "db", client.req.Database)
+	assert.Equal(t, entries, client.req.Entries)
+
+	client.res = &schema.ValidationResponse{}
+	assert.Equal(t, ErrWriteRejected, validator.Validate("db", entries))
+
+	client.res = &schema.ValidationResponse{Reason: "unknown customer"}
+	err := validator.Validate("db", entries)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, "unknown customer", status.Convert(err).Message())
+
+	client.err = errors.New("connection refused")
+	assert.Equal(t, codes.Unavailable, status.Code(validator.Validate("db", entries)))
+}
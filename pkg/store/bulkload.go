@@ -0,0 +1,295 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"hash"
+	"io"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+)
+
+// A bulk load file starts with bulkLoadMagic followed by batches and a trailer:
+//	batch:   uint32 n > 0, n * (uint32 key length, key, uint32 value length, value), sha256 of the batch bytes
+//	trailer: uint32 0, uint64 total number of entries, sha256 of all the batch checksums (the file digest)
+// Integers are big endian and keys must be strictly ascending across the whole file.
+var bulkLoadMagic = []byte("IMMUBULK\x00\x01")
+
+// maxKeySize is the largest key badger accepts
+const maxKeySize = 65000
+
+// BulkWriter writes entries in the bulk load file format
+type BulkWriter struct {
+	w         *bufio.Writer
+	batchSize int
+	batch     []*schema.KeyValue
+	lastKey   []byte
+	entries   uint64
+	digest    hash.Hash
+	closed    bool
+}
+
+// NewBulkWriter creates a BulkWriter grouping entries in batches of batchSize
+func NewBulkWriter(w io.Writer, batchSize int) (*BulkWriter, error) {
+	if batchSize <= 0 {
+		return nil, errors.New("batch size must be greater than zero")
+	}
+	bw := &BulkWriter{
+		w:         bufio.NewWriter(w),
+		batchSize: batchSize,
+		digest:    sha256.New(),
+	}
+	if _, err := bw.w.Write(bulkLoadMagic); err != nil {
+		return nil, err
+	}
+	return bw, nil
+}
+
+// Add appends an entry, keys must be added in strictly ascending order
+func (bw *BulkWriter) Add(key, value []byte) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+	if bw.entries > 0 && bytes.Compare(key, bw.lastKey) <= 0 {
+		return ErrBulkLoadUnsorted
+	}
+	bw.lastKey = append(bw.lastKey[:0], key...)
+	bw.batch = append(bw.batch, &schema.KeyValue{Key: append([]byte{}, key...), Value: append([]byte{}, value...)})
+	bw.entries++
+	if len(bw.batch) >= bw.batchSize {
+		return bw.flushBatch()
+	}
+	return nil
+}
+
+// Close writes the pending batch and the trailer, then returns the file digest reported back by the server on load
+func (bw *BulkWriter) Close() ([]byte, error) {
+	if bw.closed {
+		return bw.digest.Sum(nil), nil
+	}
+	if err := bw.flushBatch(); err != nil {
+		return nil, err
+	}
+	var trailer [4 + 8]byte
+	binary.BigEndian.PutUint64(trailer[4:], bw.entries)
+	if _, err := bw.w.Write(trailer[:]); err != nil {
+		return nil, err
+	}
+	sum := bw.digest.Sum(nil)
+	if _, err := bw.w.Write(sum); err != nil {
+		return nil, err
+	}
+	bw.closed = true
+	return sum, bw.w.Flush()
+}
+
+func (bw *BulkWriter) flushBatch() error {
+	if len(bw.batch) == 0 {
+		return nil
+	}
+	h := sha256.New()
+	w := io.MultiWriter(bw.w, h)
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], uint32(len(bw.batch)))
+	if _, err := w.Write(b[:]); err != nil {
+		return err
+	}
+	for _, kv := range bw.batch {
+		for _, field := range [][]byte{kv.Key, kv.Value} {
+			binary.BigEndian.PutUint32(b[:], uint32(len(field)))
+			if _, err := w.Write(b[:]); err != nil {
+				return err
+			}
+			if _, err := w.Write(field); err != nil {
+				return err
+			}
+		}
+	}
+	sum := h.Sum(nil)
+	if _, err := bw.w.Write(sum); err != nil {
+		return err
+	}
+	bw.digest.Write(sum)
+	bw.batch = bw.batch[:0]
+	return nil
+}
+
+type bulkReader struct {
+	r            *bufio.Reader
+	maxValueSize int64
+	lastKey      []byte
+	entries      uint64
+	batches      uint64
+	digest       hash.Hash
+}
+
+func newBulkReader(r io.Reader, maxValueSize int64) (*bulkReader, error) {
+	br := &bulkReader{r: bufio.NewReader(r), maxValueSize: maxValueSize, digest: sha256.New()}
+	magic := make([]byte, len(bulkLoadMagic))
+	if _, err := io.ReadFull(br.r, magic); err != nil || !bytes.Equal(magic, bulkLoadMagic) {
+		return nil, ErrBulkLoadCorrupted
+	}
+	return br, nil
+}
+
+// next returns the next verified batch, or nil once the trailer has been read and verified
+func (br *bulkReader) next() (*schema.KVList, error) {
+	h := sha256.New()
+	r := io.TeeReader(br.r, h)
+	n, err := readUint32(r)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, br.readTrailer()
+	}
+	list := &schema.KVList{KVs: make([]*schema.KeyValue, 0, n)}
+	for i := uint32(0); i < n; i++ {
+		key, err := readField(r, maxKeySize)
+		if err != nil {
+			return nil, err
+		}
+		value, err := readField(r, br.maxValueSize)
+		if err != nil {
+			return nil, err
+		}
+		if checkKey(key) != nil {
+			return nil, ErrBulkLoadCorrupted
+		}
+		if br.entries > 0 && bytes.Compare(key, br.lastKey) <= 0 {
+			return nil, ErrBulkLoadUnsorted
+		}
+		br.lastKey = key
+		br.entries++
+		list.KVs = append(list.KVs, &schema.KeyValue{Key: key, Value: value})
+	}
+	sum := make([]byte, sha256.Size)
+	if _, err := io.ReadFull(br.r, sum); err != nil {
+		return nil, ErrBulkLoadCorrupted
+	}
+	if !bytes.Equal(sum, h.Sum(nil)) {
+		return nil, ErrBulkLoadCorrupted
+	}
+	br.digest.Write(sum)
+	br.batches++
+	return list, nil
+}
+
+func (br *bulkReader) readTrailer() error {
+	var b [8]byte
+	if _, err := io.ReadFull(br.r, b[:]); err != nil {
+		return ErrBulkLoadCorrupted
+	}
+	sum := make([]byte, sha256.Size)
+	if _, err := io.ReadFull(br.r, sum); err != nil {
+		return ErrBulkLoadCorrupted
+	}
+	if binary.BigEndian.Uint64(b[:]) != br.entries || !bytes.Equal(sum, br.digest.Sum(nil)) {
+		return ErrBulkLoadCorrupted
+	}
+	if _, err := br.r.ReadByte(); err != io.EOF {
+		return ErrBulkLoadCorrupted
+	}
+	return nil
+}
+
+func readUint32(r io.Reader) (uint32, error) {
+	var b [4]byte
+	if _, err := io.ReadFull(r, b[:]); err != nil {
+		return 0, ErrBulkLoadCorrupted
+	}
+	return binary.BigEndian.Uint32(b[:]), nil
+}
+
+// readField reads a length prefixed field, rejecting lengths above max. The field is read as it arrives instead of
+// being allocated upfront, so that a corrupted length does not allocate more than the bytes actually in the file.
+func readField(r io.Reader, max int64) ([]byte, error) {
+	l, err := readUint32(r)
+	if err != nil {
+		return nil, err
+	}
+	if int64(l) > max {
+		return nil, ErrBulkLoadCorrupted
+	}
+	var field bytes.Buffer
+	if n, err := io.CopyN(&field, r, int64(l)); err != nil || n != int64(l) {
+		return nil, ErrBulkLoadCorrupted
+	}
+	return field.Bytes(), nil
+}
+
+// BulkLoad ingests a file written by a BulkWriter. The whole file is verified first, so that a corrupted or
+// unsorted file is rejected without writing anything, then each batch is committed as a SetBatch would.
+// The reply holds the index of the last entry written and the file digest, which must match the one returned
+// by BulkWriter.Close.
+// Batches are committed one by one: when a commit fails the batches before it stay written, and the reply
+// returned along with the error tells how many batches and entries of the file are committed and the index of the
+// last one. Loading the same file again with skipBatches set to that number of batches resumes the load.
+func (t *Store) BulkLoad(r io.ReadSeeker, skipBatches uint64) (*schema.BulkLoadReply, error) {
+	br, err := newBulkReader(r, t.maxValueSize)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		list, err := br.next()
+		if err != nil {
+			return nil, err
+		}
+		if list == nil {
+			break
+		}
+	}
+	if skipBatches > br.batches {
+		return nil, ErrBulkLoadResume
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	if br, err = newBulkReader(r, t.maxValueSize); err != nil {
+		return nil, err
+	}
+	reply := &schema.BulkLoadReply{}
+	for {
+		list, err := br.next()
+		if err != nil {
+			return reply, err
+		}
+		if list == nil {
+			break
+		}
+		if br.batches > skipBatches {
+			index, err := t.SetBatch(*list)
+			if err != nil {
+				return reply, err
+			}
+			reply.Index = index.Index
+			for _, kv := range list.KVs {
+				reply.Bytes += uint64(len(kv.Key) + len(kv.Value))
+			}
+		}
+		reply.Entries = br.entries
+		reply.Batches = br.batches
+	}
+	reply.Digest = br.digest.Sum(nil)
+	return reply, nil
+}
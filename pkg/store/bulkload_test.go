@@ -0,0 +1,116 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeBulkFile(t *testing.T, n int, batchSize int) ([]byte, []byte) {
+	var buf bytes.Buffer
+	bw, err := NewBulkWriter(&buf, batchSize)
+	require.NoError(t, err)
+	for i := 0; i < n; i++ {
+		require.NoError(t, bw.Add([]byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	digest, err := bw.Close()
+	require.NoError(t, err)
+	return buf.Bytes(), digest
+}
+
+func TestBulkLoad(t *testing.T) {
+	st, closer := makeStore()
+	defer closer()
+
+	file, digest := writeBulkFile(t, 25, 10)
+	reply, err := st.BulkLoad(bytes.NewReader(file), 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(25), reply.Entries)
+	assert.Equal(t, uint64(3), reply.Batches)
+	assert.Equal(t, uint64(24), reply.Index)
+	assert.Equal(t, uint64(315), reply.Bytes)
+	assert.Equal(t, digest, reply.Digest)
+
+	st.tree.WaitUntil(reply.Index)
+	item, err := st.Get(schema.Key{Key: []byte(`key007`)})
+	require.NoError(t, err)
+	assert.Equal(t, []byte(`value7`), item.Value)
+}
+
+func TestBulkLoadRejectsInvalidFiles(t *testing.T) {
+	st, closer := makeStore()
+	defer closer()
+
+	file, _ := writeBulkFile(t, 25, 10)
+
+	// a corrupted byte in the last batch rejects the whole file
+	corrupted := append([]byte{}, file...)
+	corrupted[len(corrupted)-60] ^= 0xff
+	_, err := st.BulkLoad(bytes.NewReader(corrupted), 0)
+	assert.Equal(t, ErrBulkLoadCorrupted, err)
+
+	_, err = st.BulkLoad(bytes.NewReader(file[:len(file)-1]), 0)
+	assert.Equal(t, ErrBulkLoadCorrupted, err)
+
+	_, err = st.BulkLoad(bytes.NewReader([]byte(`not a bulk file`)), 0)
+	assert.Equal(t, ErrBulkLoadCorrupted, err)
+
+	// a huge value length is rejected before reading the value
+	huge := append(append([]byte{}, bulkLoadMagic...), 0, 0, 0, 1, 0, 0, 0, 1, 'k', 0xff, 0xff, 0xff, 0xff)
+	_, err = st.BulkLoad(bytes.NewReader(huge), 0)
+	assert.Equal(t, ErrBulkLoadCorrupted, err)
+
+	_, err = st.Get(schema.Key{Key: []byte(`key000`)})
+	assert.Equal(t, ErrKeyNotFound, err)
+
+	var buf bytes.Buffer
+	bw, err := NewBulkWriter(&buf, 10)
+	require.NoError(t, err)
+	require.NoError(t, bw.Add([]byte(`b`), []byte(`1`)))
+	assert.Equal(t, ErrBulkLoadUnsorted, bw.Add([]byte(`a`), []byte(`2`)))
+	assert.Equal(t, ErrBulkLoadUnsorted, bw.Add([]byte(`b`), []byte(`2`)))
+}
+
+func TestBulkLoadResume(t *testing.T) {
+	st, closer := makeStore()
+	defer closer()
+
+	file, digest := writeBulkFile(t, 25, 10)
+	_, err := st.BulkLoad(bytes.NewReader(file), 4)
+	assert.Equal(t, ErrBulkLoadResume, err)
+
+	reply, err := st.BulkLoad(bytes.NewReader(file), 2)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(25), reply.Entries)
+	assert.Equal(t, uint64(3), reply.Batches)
+	assert.Equal(t, uint64(4), reply.Index)
+	assert.Equal(t, uint64(65), reply.Bytes)
+	assert.Equal(t, digest, reply.Digest)
+
+	st.tree.WaitUntil(reply.Index)
+	_, err = st.Get(schema.Key{Key: []byte(`key019`)})
+	assert.Equal(t, ErrKeyNotFound, err)
+	item, err := st.Get(schema.Key{Key: []byte(`key020`)})
+	require.NoError(t, err)
+	assert.Equal(t, []byte(`value20`), item.Value)
+}
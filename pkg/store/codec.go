@@ -0,0 +1,251 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"math"
+	"sync"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/dgraph-io/badger/v2"
+)
+
+// bitCodecEntry marks the entries whose value starts with the list of the codecs it was encoded with
+const bitCodecEntry = byte(2)
+
+// CodecID identifies a codec in the entries it encoded, ids are never reused so that old entries stay readable
+type CodecID uint8
+
+// CodecKind tells what a codec does to a value
+type CodecKind uint8
+
+// Codec kinds
+const (
+	CodecCompression CodecKind = iota
+	CodecEncryption
+	CodecSerialization
+)
+
+func (k CodecKind) String() string {
+	switch k {
+	case CodecCompression:
+		return "compression"
+	case CodecEncryption:
+		return "encryption"
+	case CodecSerialization:
+		return "serialization"
+	}
+	return "unknown"
+}
+
+// Codec transforms the values of the entries before they are stored and after they are read back
+type Codec interface {
+	ID() CodecID
+	Name() string
+	Kind() CodecKind
+	Encode(value []byte) ([]byte, error)
+	Decode(value []byte) ([]byte, error)
+}
+
+// Built-in codec ids
+const (
+	CodecGzip CodecID = 1
+)
+
+var (
+	codecsMu sync.RWMutex
+	codecs   = map[CodecID]Codec{}
+)
+
+func init() {
+	if err := RegisterCodec(gzipCodec{}); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterCodec makes a codec available to the stores of the process. Codecs must be registered before any entry
+// encoded with them is written or read.
+func RegisterCodec(c Codec) error {
+	if c.ID() == 0 {
+		return ErrInvalidCodec
+	}
+	codecsMu.Lock()
+	defer codecsMu.Unlock()
+	if _, ok := codecs[c.ID()]; ok {
+		return ErrDuplicateCodec
+	}
+	codecs[c.ID()] = c
+	return nil
+}
+
+// LookupCodec returns the registered codec with the given id
+func LookupCodec(id CodecID) (Codec, bool) {
+	codecsMu.RLock()
+	defer codecsMu.RUnlock()
+	c, ok := codecs[id]
+	return c, ok
+}
+
+func lookupCodecs(ids []uint32) ([]Codec, error) {
+	if len(ids) > math.MaxUint8 {
+		return nil, ErrInvalidCodec
+	}
+	cs := make([]Codec, len(ids))
+	for i, id := range ids {
+		if id > math.MaxUint8 {
+			return nil, ErrUnknownCodec
+		}
+		c, ok := LookupCodec(CodecID(id))
+		if !ok {
+			return nil, ErrUnknownCodec
+		}
+		cs[i] = c
+	}
+	return cs, nil
+}
+
+// newEntry returns the badger entry storing kv, its value encoded with the codecs and prefixed by the labels and the
+// codec ids
+func newEntry(kv schema.KeyValue) (*badger.Entry, error) {
+	entry := &badger.Entry{Key: kv.Key, Value: kv.Value}
+	if len(kv.Codecs) > 0 {
+		cs, err := lookupCodecs(kv.Codecs)
+		if err != nil {
+			return nil, err
+		}
+		value := kv.Value
+		for _, c := range cs {
+			if value, err = c.Encode(value); err != nil {
+				return nil, err
+			}
+		}
+		header := make([]byte, 1+len(cs), 1+len(cs)+len(value))
+		header[0] = byte(len(cs))
+		for i, c := range cs {
+			header[1+i] = byte(c.ID())
+		}
+		entry.Value = append(header, value...)
+		entry.UserMeta |= bitCodecEntry
+	}
+	if len(kv.Labels) > 0 {
+		block, err := encodeLabels(kv.Labels)
+		if err != nil {
+			return nil, err
+		}
+		entry.Value = append(block, entry.Value...)
+		entry.UserMeta |= bitLabelEntry
+	}
+	return entry, nil
+}
+
+// entryCodecs splits a stored value, stripped of its labels, into the codecs it was encoded with and the encoded value
+func entryCodecs(userMeta byte, stored []byte) ([]Codec, []byte, error) {
+	if userMeta&bitReferenceEntry == bitReferenceEntry || userMeta&bitCodecEntry != bitCodecEntry {
+		return nil, stored, nil
+	}
+	if len(stored) == 0 || len(stored) < 1+int(stored[0]) {
+		return nil, nil, ErrInconsistentState
+	}
+	n := int(stored[0])
+	cs := make([]Codec, n)
+	for i := 0; i < n; i++ {
+		c, ok := LookupCodec(CodecID(stored[1+i]))
+		if !ok {
+			return nil, nil, ErrUnknownCodec
+		}
+		cs[i] = c
+	}
+	return cs, stored[1+n:], nil
+}
+
+// decodeEntry returns the labels and the value of an entry as it was written
+func decodeEntry(userMeta byte, stored []byte) (map[string]string, []byte, error) {
+	labels, stored, err := entryLabels(userMeta, stored)
+	if err != nil {
+		return nil, nil, err
+	}
+	cs, value, err := entryCodecs(userMeta, stored)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := len(cs) - 1; i >= 0; i-- {
+		if value, err = cs[i].Decode(value); err != nil {
+			return nil, nil, err
+		}
+	}
+	return labels, value, nil
+}
+
+// Codecs returns the codecs the current value of the key was encoded with, in the order they were applied
+func (t *Store) Codecs(key schema.Key) ([]Codec, error) {
+	if err := checkKey(key.Key); err != nil {
+		return nil, err
+	}
+	txn := t.db.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+	i, err := txn.Get(key.Key)
+	if err == nil && i.UserMeta()&bitReferenceEntry == bitReferenceEntry {
+		var refKey []byte
+		if refKey, err = i.ValueCopy(nil); err != nil {
+			return nil, mapError(err)
+		}
+		i, err = txn.Get(refKey)
+	}
+	if err != nil {
+		return nil, mapError(err)
+	}
+	stored, err := i.ValueCopy(nil)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	_, stored, err = entryLabels(i.UserMeta(), stored)
+	if err != nil {
+		return nil, err
+	}
+	cs, _, err := entryCodecs(i.UserMeta(), stored)
+	return cs, err
+}
+
+type gzipCodec struct{}
+
+func (gzipCodec) ID() CodecID     { return CodecGzip }
+func (gzipCodec) Name() string    { return "gzip" }
+func (gzipCodec) Kind() CodecKind { return CodecCompression }
+
+func (gzipCodec) Encode(value []byte) ([]byte, error) {
+	var b bytes.Buffer
+	w := gzip.NewWriter(&b)
+	if _, err := w.Write(value); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+func (gzipCodec) Decode(value []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(value))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
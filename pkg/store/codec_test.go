@@ -0,0 +1,120 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/stretchr/testify/require"
+)
+
+// reverseCodec is a toy serialization codec used to check that codecs are chained in order
+type reverseCodec struct{}
+
+func (reverseCodec) ID() CodecID     { return 200 }
+func (reverseCodec) Name() string    { return "reverse" }
+func (reverseCodec) Kind() CodecKind { return CodecSerialization }
+
+func (reverseCodec) Encode(value []byte) ([]byte, error) {
+	r := make([]byte, len(value))
+	for i, b := range value {
+		r[len(value)-1-i] = b
+	}
+	return r, nil
+}
+
+func (c reverseCodec) Decode(value []byte) ([]byte, error) {
+	return c.Encode(value)
+}
+
+func TestCodecRegistry(t *testing.T) {
+	c, ok := LookupCodec(CodecGzip)
+	require.True(t, ok)
+	require.Equal(t, "gzip", c.Name())
+	require.Equal(t, "compression", c.Kind().String())
+
+	require.Equal(t, ErrDuplicateCodec, RegisterCodec(gzipCodec{}))
+	_, ok = LookupCodec(200)
+	if !ok {
+		require.NoError(t, RegisterCodec(reverseCodec{}))
+	}
+}
+
+func TestSetWithCodecs(t *testing.T) {
+	st, closer := makeStore()
+	defer closer()
+	_, ok := LookupCodec(200)
+	if !ok {
+		require.NoError(t, RegisterCodec(reverseCodec{}))
+	}
+
+	value := bytes.Repeat([]byte("immudb "), 100)
+	index, err := st.Set(schema.KeyValue{Key: []byte("k"), Value: value, Codecs: []uint32{uint32(CodecGzip), 200}})
+	require.NoError(t, err)
+
+	item, err := st.Get(schema.Key{Key: []byte("k")})
+	require.NoError(t, err)
+	require.Equal(t, value, item.Value)
+	digest := api.Digest(index.Index, []byte("k"), value)
+	require.Equal(t, digest[:], item.Hash())
+	st.tree.WaitUntil(index.Index)
+	byIndex, err := st.ByIndex(schema.Index{Index: index.Index})
+	require.NoError(t, err)
+	require.Equal(t, value, byIndex.Value)
+
+	// the stored value is the header followed by the compressed and reversed payload
+	txn := st.db.NewTransactionAt(math.MaxUint64, false)
+	raw, err := txn.Get([]byte("k"))
+	require.NoError(t, err)
+	stored, err := raw.ValueCopy(nil)
+	require.NoError(t, err)
+	txn.Discard()
+	require.Equal(t, []byte{2, byte(CodecGzip), 200}, stored[:3])
+	require.Less(t, len(stored), len(value))
+
+	cs, err := st.Codecs(schema.Key{Key: []byte("k")})
+	require.NoError(t, err)
+	require.Len(t, cs, 2)
+	require.Equal(t, CodecGzip, cs[0].ID())
+	require.Equal(t, CodecID(200), cs[1].ID())
+
+	_, err = st.Reference(&schema.ReferenceOptions{Key: []byte("k"), Reference: []byte("r")})
+	require.NoError(t, err)
+	cs, err = st.Codecs(schema.Key{Key: []byte("r")})
+	require.NoError(t, err)
+	require.Len(t, cs, 2)
+
+	// entries written without codecs have none
+	_, err = st.Set(schema.KeyValue{Key: []byte("plain"), Value: []byte("v")})
+	require.NoError(t, err)
+	cs, err = st.Codecs(schema.Key{Key: []byte("plain")})
+	require.NoError(t, err)
+	require.Empty(t, cs)
+
+	same, err := st.Set(schema.KeyValue{Key: []byte("k"), Value: value}, WithSkipUnchanged(bytes.Equal))
+	require.NoError(t, err)
+	require.Equal(t, index.Index, same.Index)
+
+	_, err = st.Set(schema.KeyValue{Key: []byte("k"), Value: value, Codecs: []uint32{99}})
+	require.Equal(t, ErrUnknownCodec, err)
+	_, err = st.SetBatch(schema.KVList{KVs: []*schema.KeyValue{{Key: []byte("k"), Value: value, Codecs: []uint32{1000}}}})
+	require.Equal(t, ErrUnknownCodec, err)
+}
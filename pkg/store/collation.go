@@ -0,0 +1,172 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// Collation defines the order in which keys are returned by scans
+type Collation string
+
+// Available collations
+const (
+	// CollationBinary orders keys byte by byte, as they are laid out on disk
+	CollationBinary Collation = "binary"
+	// CollationCaseInsensitive orders keys ignoring the case of their letters
+	CollationCaseInsensitive Collation = "case-insensitive"
+	// CollationNumeric orders the runs of digits inside keys by their numeric value, e.g. item2 before item10
+	CollationNumeric Collation = "numeric"
+)
+
+const metadataFilename = "METADATA"
+
+// metadata holds the store settings which must survive a reopening
+type metadata struct {
+	Collation Collation `json:"collation"`
+}
+
+// ParseCollation returns the collation with the given name, an empty name means binary
+func ParseCollation(name string) (Collation, error) {
+	switch c := Collation(name); c {
+	case "":
+		return CollationBinary, nil
+	case CollationBinary, CollationCaseInsensitive, CollationNumeric:
+		return c, nil
+	}
+	return "", ErrInvalidCollation
+}
+
+// Compare returns an integer comparing two keys according to the collation. Keys equal for the collation
+// are compared byte by byte, so that distinct keys never collate as equal.
+func (c Collation) Compare(a, b []byte) int {
+	var r int
+	switch c {
+	case CollationCaseInsensitive:
+		r = bytes.Compare(bytes.ToLower(a), bytes.ToLower(b))
+	case CollationNumeric:
+		r = compareNumeric(a, b)
+	}
+	if r != 0 {
+		return r
+	}
+	return bytes.Compare(a, b)
+}
+
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+func digitRun(b []byte) (run []byte, rest []byte) {
+	i := 0
+	for i < len(b) && isDigit(b[i]) {
+		i++
+	}
+	return b[:i], b[i:]
+}
+
+func compareNumeric(a, b []byte) int {
+	for len(a) > 0 && len(b) > 0 {
+		if isDigit(a[0]) && isDigit(b[0]) {
+			var na, nb []byte
+			na, a = digitRun(a)
+			nb, b = digitRun(b)
+			na = bytes.TrimLeft(na, "0")
+			nb = bytes.TrimLeft(nb, "0")
+			if len(na) != len(nb) {
+				if len(na) < len(nb) {
+					return -1
+				}
+				return 1
+			}
+			if r := bytes.Compare(na, nb); r != 0 {
+				return r
+			}
+			continue
+		}
+		if a[0] != b[0] {
+			if a[0] < b[0] {
+				return -1
+			}
+			return 1
+		}
+		a, b = a[1:], b[1:]
+	}
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	}
+	return 0
+}
+
+// resolveCollation returns the collation of the store in dir, recording the requested one if the store has none yet.
+// An empty dir means an in memory store, which has nothing to persist.
+func resolveCollation(dir string, requested Collation) (Collation, error) {
+	if requested != "" {
+		if _, err := ParseCollation(string(requested)); err != nil {
+			return "", err
+		}
+	}
+	if dir == "" {
+		if requested == "" {
+			return CollationBinary, nil
+		}
+		return requested, nil
+	}
+	filename := filepath.Join(dir, metadataFilename)
+	content, err := ioutil.ReadFile(filename)
+	if err == nil {
+		var md metadata
+		if err = json.Unmarshal(content, &md); err != nil {
+			return "", err
+		}
+		stored, err := ParseCollation(string(md.Collation))
+		if err != nil {
+			return "", err
+		}
+		if requested != "" && requested != stored {
+			return "", ErrCollationMismatch
+		}
+		return stored, nil
+	}
+	if !os.IsNotExist(err) {
+		return "", err
+	}
+	// either a new store or one created before collations were introduced, which is binary
+	md := metadata{Collation: requested}
+	if md.Collation == "" {
+		md.Collation = CollationBinary
+	}
+	if content, err = json.Marshal(md); err != nil {
+		return "", err
+	}
+	if err = ioutil.WriteFile(filename, content, 0644); err != nil {
+		return "", err
+	}
+	return md.Collation, nil
+}
+
+// Collation returns the key collation used by scans
+func (t *Store) Collation() Collation {
+	return t.collation
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func openCollated(t *testing.T, dir string, collation Collation) (*Store, error) {
+	slog := logger.NewSimpleLoggerWithLevel("collation(immudb)", os.Stderr, logger.LogError)
+	opts, badgerOpts := DefaultOptions(dir, slog)
+	return Open(opts.WithCollation(collation), badgerOpts)
+}
+
+func scanKeys(t *testing.T, st *Store, options schema.ScanOptions) []string {
+	list, err := st.Scan(options)
+	require.NoError(t, err)
+	var keys []string
+	for _, item := range list.Items {
+		keys = append(keys, string(item.Key))
+	}
+	return keys
+}
+
+func TestCollationCompare(t *testing.T) {
+	require.Equal(t, -1, CollationBinary.Compare([]byte("B"), []byte("a")))
+	require.Equal(t, 1, CollationCaseInsensitive.Compare([]byte("B"), []byte("a")))
+	require.Equal(t, -1, CollationCaseInsensitive.Compare([]byte("A"), []byte("a")))
+	require.Equal(t, -1, CollationNumeric.Compare([]byte("item2"), []byte("item10")))
+	require.Equal(t, 1, CollationNumeric.Compare([]byte("item2b"), []byte("item2a")))
+	require.Equal(t, -1, CollationNumeric.Compare([]byte("item2"), []byte("item2a")))
+	require.Equal(t, -1, CollationNumeric.Compare([]byte("v002"), []byte("v2")))
+	require.Equal(t, 0, CollationNumeric.Compare([]byte("v2"), []byte("v2")))
+
+	c, err := ParseCollation("")
+	require.NoError(t, err)
+	require.Equal(t, CollationBinary, c)
+	_, err = ParseCollation("natural")
+	require.Equal(t, ErrInvalidCollation, err)
+}
+
+func TestScanCollated(t *testing.T) {
+	dir, err := ioutil.TempDir("", "immu_collation")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	st, err := openCollated(t, dir, CollationNumeric)
+	require.NoError(t, err)
+	for _, key := range []string{"item10", "item2", "item1", "other3"} {
+		_, err = st.Set(schema.KeyValue{Key: []byte(key), Value: []byte(key)})
+		require.NoError(t, err)
+	}
+	_, err = st.Reference(&schema.ReferenceOptions{Key: []byte("other3"), Reference: []byte("item3")})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"item1", "item2", "item10"}, scanKeys(t, st, schema.ScanOptions{Prefix: []byte("item")}))
+	require.Equal(t, []string{"item1", "item2", "other3", "item10"}, scanKeys(t, st, schema.ScanOptions{Prefix: []byte("item"), Deep: true}))
+	require.Equal(t, []string{"item10", "item2"}, scanKeys(t, st, schema.ScanOptions{Prefix: []byte("item"), Reverse: true, Limit: 2}))
+	require.Equal(t, []string{"item10"}, scanKeys(t, st, schema.ScanOptions{Prefix: []byte("item"), Offset: []byte("item2")}))
+	require.Equal(t, []string{"item1"}, scanKeys(t, st, schema.ScanOptions{Prefix: []byte("item"), Offset: []byte("item2"), Reverse: true}))
+
+	// the keys buffered to be sorted count towards the execution memory
+	st.maxExecutionMemory = len("item1") + len("item2")
+	_, err = st.Scan(schema.ScanOptions{Prefix: []byte("item")})
+	require.Equal(t, ErrMaxExecutionMemory, err)
+	st.maxExecutionMemory = 0
+	require.NoError(t, st.Close())
+
+	// reopening keeps the recorded collation
+	st, err = openCollated(t, dir, "")
+	require.NoError(t, err)
+	require.Equal(t, CollationNumeric, st.Collation())
+	require.Equal(t, []string{"item1", "item2", "item10"}, scanKeys(t, st, schema.ScanOptions{Prefix: []byte("item")}))
+	require.NoError(t, st.Close())
+
+	_, err = openCollated(t, dir, CollationCaseInsensitive)
+	require.Equal(t, ErrCollationMismatch, err)
+	_, err = openCollated(t, dir, "natural")
+	require.Equal(t, ErrInvalidCollation, err)
+}
+
+func TestScanCaseInsensitive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "immu_collation")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	st, err := openCollated(t, dir, CollationCaseInsensitive)
+	require.NoError(t, err)
+	defer st.Close()
+	for _, key := range []string{"Banana", "apple", "Cherry", "Apple"} {
+		_, err = st.Set(schema.KeyValue{Key: []byte(key), Value: []byte(key)})
+		require.NoError(t, err)
+	}
+	require.Equal(t, []string{"Apple", "apple", "Banana", "Cherry"}, scanKeys(t, st, schema.ScanOptions{}))
+}
+
+func TestScanCollatedPages(t *testing.T) {
+	dir, err := ioutil.TempDir("", "immu_collation")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	st, err := openCollated(t, dir, CollationNumeric)
+	require.NoError(t, err)
+	defer st.Close()
+	var want []string
+	for i := 1; i <= 50; i++ {
+		want = append(want, fmt.Sprintf("item%d", i))
+	}
+	for _, i := range rand.New(rand.NewSource(1)).Perm(len(want)) {
+		_, err = st.Set(schema.KeyValue{Key: []byte(want[i]), Value: []byte(want[i])})
+		require.NoError(t, err)
+	}
+
+	for _, reverse := range []bool{false, true} {
+		var got []string
+		var offset []byte
+		for {
+			page := scanKeys(t, st, schema.ScanOptions{Prefix: []byte("item"), Offset: offset, Limit: 7, Reverse: reverse})
+			if len(page) == 0 {
+				break
+			}
+			require.LessOrEqual(t, len(page), 7)
+			got = append(got, page...)
+			offset = []byte(page[len(page)-1])
+		}
+		if reverse {
+			for i, j := 0, len(got)-1; i < j; i, j = i+1, j-1 {
+				got[i], got[j] = got[j], got[i]
+			}
+		}
+		require.Equal(t, want, got, "reverse %v", reverse)
+	}
+}
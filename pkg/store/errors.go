@@ -35,6 +35,20 @@ var (
 	ErrInvalidRootIndex   = status.New(codes.InvalidArgument, "invalid root index").Err()
 	ErrObsoleteDataFormat = status.New(codes.Unknown, "data format in which elements are written on disk is not up to date to the current version of immudb server. Please upgrade to access to complete functionalities").Err()
 	ErrInconsistentDigest = status.New(codes.Unknown, "insertion order index hash is not equal to the digest of the related value").Err()
+	ErrMaxResultItems     = status.New(codes.ResourceExhausted, "query result exceeds the maximum number of items").Err()
+	ErrMaxResultBytes     = status.New(codes.ResourceExhausted, "query result exceeds the maximum size in bytes").Err()
+	ErrMaxExecutionMemory = status.New(codes.ResourceExhausted, "query exceeds the maximum execution memory").Err()
+	ErrBulkLoadCorrupted  = status.New(codes.InvalidArgument, "bulk load file is corrupted").Err()
+	ErrBulkLoadUnsorted   = status.New(codes.InvalidArgument, "bulk load file keys are not sorted").Err()
+	ErrBatchTooLarge      = status.New(codes.ResourceExhausted, "batch exceeds the entries or bytes a single transaction can hold").Err()
+	ErrBulkLoadResume     = status.New(codes.InvalidArgument, "bulk load file has fewer batches than the ones to skip").Err()
+	ErrInvalidCollation   = status.New(codes.InvalidArgument, "invalid collation, expected binary, case-insensitive or numeric").Err()
+	ErrCollationMismatch  = status.New(codes.FailedPrecondition, "store was created with a different collation").Err()
+	ErrInvalidCodec       = status.New(codes.InvalidArgument, "invalid codec").Err()
+	ErrUnknownCodec       = status.New(codes.InvalidArgument, "unknown codec").Err()
+	ErrDuplicateCodec     = status.New(codes.AlreadyExists, "codec already registered").Err()
+	ErrInvalidLabel       = status.New(codes.InvalidArgument, "invalid labels, expected at most 255 non empty names and values of at most 255 bytes").Err()
+	ErrUnknownProfile     = status.New(codes.InvalidArgument, "unknown store options profile, expected embedded-small, server-balanced, bulk-ingest or low-memory").Err()
 )
 
 // fixme(leogr): review codes and fix/remove errors which do not make sense in this context, finally correct comments accordingly.
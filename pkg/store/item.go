@@ -26,13 +26,18 @@ func itemToSchema(key []byte, item *badger.Item) (*schema.Item, error) {
 	if err != nil {
 		return nil, mapError(err)
 	}
+	labels, value, err := decodeEntry(item.UserMeta(), value)
+	if err != nil {
+		return nil, err
+	}
 	if key == nil || len(key) == 0 {
 		key = item.KeyCopy(key)
 	}
 	return &schema.Item{
-		Key:   key,
-		Value: value,
-		Index: item.Version() - 1,
+		Key:    key,
+		Value:  value,
+		Index:  item.Version() - 1,
+		Labels: labels,
 	}, nil
 }
 
@@ -0,0 +1,97 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"math"
+	"sort"
+)
+
+// bitLabelEntry marks the entries whose value starts with the labels attached to them. The labels are not part of
+// the tree leaf of the entry, which is computed from the key and the value only, so they are not verifiable.
+const bitLabelEntry = byte(4)
+
+// encodeLabels returns the labels block prefixed to the stored value: the number of labels followed by the length
+// prefixed name and value of each of them, sorted by name. Names and values are at most 255 bytes long.
+func encodeLabels(labels map[string]string) ([]byte, error) {
+	if len(labels) > math.MaxUint8 {
+		return nil, ErrInvalidLabel
+	}
+	names := make([]string, 0, len(labels))
+	for name, value := range labels {
+		if len(name) == 0 || len(name) > math.MaxUint8 || len(value) > math.MaxUint8 {
+			return nil, ErrInvalidLabel
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	block := []byte{byte(len(names))}
+	for _, name := range names {
+		block = append(block, byte(len(name)))
+		block = append(block, name...)
+		block = append(block, byte(len(labels[name])))
+		block = append(block, labels[name]...)
+	}
+	return block, nil
+}
+
+// entryLabels splits a stored value into the labels attached to the entry and the rest of the value
+func entryLabels(userMeta byte, stored []byte) (map[string]string, []byte, error) {
+	if userMeta&bitReferenceEntry == bitReferenceEntry || userMeta&bitLabelEntry != bitLabelEntry {
+		return nil, stored, nil
+	}
+	if len(stored) == 0 {
+		return nil, nil, ErrInconsistentState
+	}
+	n := int(stored[0])
+	labels := make(map[string]string, n)
+	rest := stored[1:]
+	for i := 0; i < n; i++ {
+		var name, value string
+		var ok bool
+		if name, rest, ok = splitLabel(rest); !ok {
+			return nil, nil, ErrInconsistentState
+		}
+		if value, rest, ok = splitLabel(rest); !ok {
+			return nil, nil, ErrInconsistentState
+		}
+		labels[name] = value
+	}
+	return labels, rest, nil
+}
+
+func splitLabel(b []byte) (string, []byte, bool) {
+	if len(b) == 0 || len(b) < 1+int(b[0]) {
+		return "", nil, false
+	}
+	return string(b[1 : 1+b[0]]), b[1+b[0]:], true
+}
+
+// matchLabels tells whether labels contains all the labels of the filter
+func matchLabels(labels map[string]string, filter map[string]string) bool {
+	for name, value := range filter {
+		if v, ok := labels[name]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// sameLabels tells whether the two label sets are equal, a nil set being equal to an empty one
+func sameLabels(a map[string]string, b map[string]string) bool {
+	return len(a) == len(b) && matchLabels(a, b)
+}
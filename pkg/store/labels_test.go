@@ -0,0 +1,111 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLabels(t *testing.T) {
+	st, closer := makeStore()
+	defer closer()
+
+	prod := map[string]string{"env": "prod", "source": "api"}
+	index, err := st.Set(schema.KeyValue{Key: []byte("job-1"), Value: []byte("a"), Labels: prod})
+	require.NoError(t, err)
+	_, err = st.Set(schema.KeyValue{Key: []byte("job-2"), Value: []byte("b"), Labels: map[string]string{"env": "dev", "source": "api"}})
+	require.NoError(t, err)
+	_, err = st.SetBatch(schema.KVList{KVs: []*schema.KeyValue{
+		{Key: []byte("job-3"), Value: bytes.Repeat([]byte("c"), 100), Labels: map[string]string{"env": "prod"}, Codecs: []uint32{uint32(CodecGzip)}},
+		{Key: []byte("job-4"), Value: []byte("d")},
+	}})
+	require.NoError(t, err)
+
+	item, err := st.Get(schema.Key{Key: []byte("job-1")})
+	require.NoError(t, err)
+	require.Equal(t, []byte("a"), item.Value)
+	require.Equal(t, prod, item.Labels)
+	// labels are not part of the merkle tree
+	digest := api.Digest(index.Index, []byte("job-1"), []byte("a"))
+	require.Equal(t, digest[:], item.Hash())
+
+	list, err := st.Scan(schema.ScanOptions{Prefix: []byte("job-"), Labels: map[string]string{"env": "prod"}})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 2)
+	require.Equal(t, []byte("job-1"), list.Items[0].Key)
+	require.Equal(t, []byte("job-3"), list.Items[1].Key)
+	require.Equal(t, bytes.Repeat([]byte("c"), 100), list.Items[1].Value)
+	cs, err := st.Codecs(schema.Key{Key: []byte("job-3")})
+	require.NoError(t, err)
+	require.Len(t, cs, 1)
+
+	list, err = st.Scan(schema.ScanOptions{Prefix: []byte("job-"), Labels: map[string]string{"env": "prod", "source": "api"}, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	require.Equal(t, []byte("job-1"), list.Items[0].Key)
+
+	list, err = st.Scan(schema.ScanOptions{Prefix: []byte("job-")})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 4)
+	require.Empty(t, list.Items[3].Labels)
+
+	// a reference is filtered by the labels of the entry it points to
+	_, err = st.Reference(&schema.ReferenceOptions{Key: []byte("job-2"), Reference: []byte("ref")})
+	require.NoError(t, err)
+	list, err = st.Scan(schema.ScanOptions{Prefix: []byte("ref"), Deep: true, Labels: map[string]string{"env": "dev"}})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+
+	// relabelling is a change even when the value is the same
+	same, err := st.Set(schema.KeyValue{Key: []byte("job-1"), Value: []byte("a"), Labels: prod}, WithSkipUnchanged(bytes.Equal))
+	require.NoError(t, err)
+	require.Equal(t, index.Index, same.Index)
+	relabelled, err := st.Set(schema.KeyValue{Key: []byte("job-1"), Value: []byte("a")}, WithSkipUnchanged(bytes.Equal))
+	require.NoError(t, err)
+	require.NotEqual(t, index.Index, relabelled.Index)
+
+	_, err = st.Set(schema.KeyValue{Key: []byte("job-5"), Value: []byte("e"), Labels: map[string]string{"": "x"}})
+	require.Equal(t, ErrInvalidLabel, err)
+	_, err = st.Set(schema.KeyValue{Key: []byte("job-5"), Value: []byte("e"), Labels: map[string]string{"env": strings.Repeat("x", 256)}})
+	require.Equal(t, ErrInvalidLabel, err)
+}
+
+func TestCollatedScanByLabels(t *testing.T) {
+	dir, err := ioutil.TempDir("", "immu_labels")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	st, err := openCollated(t, dir, CollationNumeric)
+	require.NoError(t, err)
+	defer st.Close()
+
+	for _, key := range []string{"n10", "n9", "n100"} {
+		_, err = st.Set(schema.KeyValue{Key: []byte(key), Value: []byte(key), Labels: map[string]string{"big": strings.Repeat("y", len(key)-2)}})
+		require.NoError(t, err)
+	}
+	list, err := st.Scan(schema.ScanOptions{Prefix: []byte("n"), Labels: map[string]string{"big": "y"}})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	require.Equal(t, []byte("n10"), list.Items[0].Key)
+}
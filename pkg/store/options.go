@@ -26,7 +26,18 @@ import (
 
 // Options ...
 type Options struct {
-	log logger.Logger
+	log                logger.Logger
+	maxResultItems     int
+	maxResultBytes     int
+	maxExecutionMemory int
+	collation          Collation
+
+	validator         WriteValidator
+	validatorPrefixes [][]byte
+
+	profile string
+
+	replayProgress func(done, total uint64)
 }
 
 // DefaultOptions ...
@@ -39,12 +50,60 @@ func DefaultOptions(path string, log logger.Logger) (Options, badger.Options) {
 	if runtime.GOOS == "windows" {
 		badgerOptions.Truncate = true
 	}
-	return Options{log}, badgerOptions
+	return Options{log: log}, badgerOptions
+}
+
+// WithMaxResultItems sets the maximum number of items a single query can return, 0 means no limit
+func (o Options) WithMaxResultItems(max int) Options {
+	o.maxResultItems = max
+	return o
+}
+
+// WithMaxResultBytes sets the maximum size in bytes of the keys and values a single query can return, 0 means no limit
+func (o Options) WithMaxResultBytes(max int) Options {
+	o.maxResultBytes = max
+	return o
+}
+
+// WithMaxExecutionMemory sets the maximum memory in bytes a single query can hold while it runs, its result included,
+// 0 means no limit
+func (o Options) WithMaxExecutionMemory(max int) Options {
+	o.maxExecutionMemory = max
+	return o
+}
+
+// WithCollation sets the key collation used by scans. It is recorded when the store is created, opening an existing
+// store with a different one fails, an empty collation uses the recorded one.
+func (o Options) WithCollation(collation Collation) Options {
+	o.collation = collation
+	return o
+}
+
+// WithWriteValidator sets the validator consulted before committing the writes having at least one key with one of
+// the prefixes, all the writes when no prefix is given
+func (o Options) WithWriteValidator(validator WriteValidator, prefixes ...[]byte) Options {
+	o.validator = validator
+	o.validatorPrefixes = prefixes
+	return o
+}
+
+// WithProfile sets the named preset the badger options are tuned with when the store is opened, see Profiles
+func (o Options) WithProfile(profile string) Options {
+	o.profile = profile
+	return o
+}
+
+// WithReplayProgress sets the function called while the tree entries committed after its last flush, if any, are
+// replayed when the store is opened
+func (o Options) WithReplayProgress(progress func(done, total uint64)) Options {
+	o.replayProgress = progress
+	return o
 }
 
 // WriteOptions ...
 type WriteOptions struct {
-	asyncCommit bool
+	asyncCommit   bool
+	skipUnchanged func(current, value []byte) bool
 }
 
 func makeWriteOptions(opts ...WriteOption) *WriteOptions {
@@ -64,3 +123,11 @@ func WithAsyncCommit(async bool) WriteOption {
 		opts.asyncCommit = async
 	}
 }
+
+// WithSkipUnchanged makes Set skip the write, returning the index of the current entry, when equal reports
+// the current value of the key and the new one as equal
+func WithSkipUnchanged(equal func(current, value []byte) bool) WriteOption {
+	return func(opts *WriteOptions) {
+		opts.skipUnchanged = equal
+	}
+}
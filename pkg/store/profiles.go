@@ -0,0 +1,117 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"sort"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/dgraph-io/badger/v2/options"
+)
+
+// Store options profiles. None of them shrinks the table size below the default one: badger bounds a transaction
+// to a fraction of it, so smaller tables would lower the largest batch a store can commit.
+const (
+	// ProfileEmbeddedSmall suits stores embedded in an application with a modest amount of data: fewer memtables,
+	// a small level one and value log files, a single compactor and synchronous writes
+	ProfileEmbeddedSmall = "embedded-small"
+	// ProfileServerBalanced suits a dedicated server: default table sizes, more compactors and synchronous writes
+	ProfileServerBalanced = "server-balanced"
+	// ProfileBulkIngest suits loading large amounts of data: large tables, more memtables and level zero tables
+	// before stalling, more compactors and asynchronous writes
+	ProfileBulkIngest = "bulk-ingest"
+	// ProfileLowMemory keeps the memory footprint low: a single memtable, tables and value log read through
+	// standard file I/O instead of being memory mapped, bloom filters loaded on demand and synchronous writes
+	ProfileLowMemory = "low-memory"
+)
+
+var profiles = map[string]func(badger.Options) badger.Options{
+	ProfileEmbeddedSmall: func(o badger.Options) badger.Options {
+		return o.
+			WithLevelOneSize(128 << 20).
+			WithNumMemtables(2).
+			WithNumLevelZeroTables(3).
+			WithNumLevelZeroTablesStall(6).
+			WithNumCompactors(1).
+			WithValueLogFileSize(128 << 20).
+			WithSyncWrites(true)
+	},
+	ProfileServerBalanced: func(o badger.Options) badger.Options {
+		return o.
+			WithMaxTableSize(64 << 20).
+			WithLevelOneSize(256 << 20).
+			WithNumMemtables(5).
+			WithNumLevelZeroTables(5).
+			WithNumLevelZeroTablesStall(10).
+			WithNumCompactors(4).
+			WithValueLogFileSize(1<<30 - 1).
+			WithSyncWrites(true)
+	},
+	ProfileBulkIngest: func(o badger.Options) badger.Options {
+		return o.
+			WithMaxTableSize(128 << 20).
+			WithLevelOneSize(512 << 20).
+			WithNumMemtables(8).
+			WithNumLevelZeroTables(10).
+			WithNumLevelZeroTablesStall(20).
+			WithNumCompactors(4).
+			WithValueLogFileSize(1<<30 - 1).
+			WithSyncWrites(false)
+	},
+	ProfileLowMemory: func(o badger.Options) badger.Options {
+		return o.
+			WithLevelOneSize(128 << 20).
+			WithNumMemtables(1).
+			WithNumLevelZeroTables(2).
+			WithNumLevelZeroTablesStall(4).
+			WithNumCompactors(1).
+			WithValueLogFileSize(16 << 20).
+			WithTableLoadingMode(options.FileIO).
+			WithValueLogLoadingMode(options.FileIO).
+			WithKeepL0InMemory(false).
+			WithLoadBloomsOnOpen(false).
+			WithSyncWrites(true)
+	},
+}
+
+// Profiles returns the names of the store options profiles
+func Profiles() []string {
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ValidProfile tells whether profile is the name of a store options profile, or empty
+func ValidProfile(profile string) bool {
+	_, ok := profiles[profile]
+	return profile == "" || ok
+}
+
+// applyProfile tunes the badger options according to the profile, an empty profile leaves them unchanged
+func applyProfile(profile string, o badger.Options) (badger.Options, error) {
+	if profile == "" {
+		return o, nil
+	}
+	apply, ok := profiles[profile]
+	if !ok {
+		return o, ErrUnknownProfile
+	}
+	return apply(o), nil
+}
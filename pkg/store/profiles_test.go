@@ -0,0 +1,91 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/logger"
+	"github.com/dgraph-io/badger/v2/options"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfiles(t *testing.T) {
+	require.Equal(t, []string{ProfileBulkIngest, ProfileEmbeddedSmall, ProfileLowMemory, ProfileServerBalanced}, Profiles())
+	require.True(t, ValidProfile(""))
+	require.True(t, ValidProfile(ProfileLowMemory))
+	require.False(t, ValidProfile("fast"))
+
+	slog := logger.NewSimpleLoggerWithLevel("profiles(immudb)", os.Stderr, logger.LogError)
+	_, badgerOpts := DefaultOptions("", slog)
+	tuned, err := applyProfile("", badgerOpts)
+	require.NoError(t, err)
+	require.Equal(t, badgerOpts.MaxTableSize, tuned.MaxTableSize)
+
+	tuned, err = applyProfile(ProfileLowMemory, badgerOpts)
+	require.NoError(t, err)
+	require.Equal(t, 1, tuned.NumMemtables)
+	require.Equal(t, options.FileIO, tuned.TableLoadingMode)
+	require.True(t, tuned.SyncWrites)
+
+	tuned, err = applyProfile(ProfileBulkIngest, badgerOpts)
+	require.NoError(t, err)
+	require.False(t, tuned.SyncWrites)
+
+	tuned, err = applyProfile(ProfileServerBalanced, badgerOpts)
+	require.NoError(t, err)
+	require.True(t, tuned.SyncWrites)
+
+	_, err = applyProfile("fast", badgerOpts)
+	require.Equal(t, ErrUnknownProfile, err)
+}
+
+func TestOpenWithProfile(t *testing.T) {
+	slog := logger.NewSimpleLoggerWithLevel("profiles(immudb)", os.Stderr, logger.LogError)
+	defaultOpts, defaultBadgerOpts := DefaultOptions("", slog)
+	defaultStore, err := Open(defaultOpts, defaultBadgerOpts.WithInMemory(true))
+	require.NoError(t, err)
+	maxEntries, maxSize := defaultStore.MaxBatch()
+	require.NoError(t, defaultStore.Close())
+
+	for _, profile := range Profiles() {
+		dir, err := ioutil.TempDir("", "immu_profile")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		opts, badgerOpts := DefaultOptions(dir, slog)
+		st, err := Open(opts.WithProfile(profile), badgerOpts)
+		require.NoError(t, err, profile)
+		_, err = st.Set(schema.KeyValue{Key: []byte("key"), Value: []byte(profile)})
+		require.NoError(t, err, profile)
+		item, err := st.Get(schema.Key{Key: []byte("key")})
+		require.NoError(t, err, profile)
+		require.Equal(t, []byte(profile), item.Value)
+		// profiles must not lower the largest batch a store can commit
+		entries, size := st.MaxBatch()
+		require.GreaterOrEqual(t, entries, maxEntries, profile)
+		require.GreaterOrEqual(t, size, maxSize, profile)
+		require.NoError(t, st.Close())
+	}
+
+	opts, badgerOpts := DefaultOptions("", slog)
+	_, err = Open(opts.WithProfile("fast"), badgerOpts.WithInMemory(true))
+	require.Equal(t, ErrUnknownProfile, err)
+}
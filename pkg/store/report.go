@@ -0,0 +1,151 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/golang/protobuf/proto"
+)
+
+// valueSizeBuckets are the inclusive upper bounds of the value size histogram, an additional unbounded bucket follows
+var valueSizeBuckets = []uint64{64, 256, 1 << 10, 4 << 10, 16 << 10, 64 << 10, 256 << 10, 1 << 20}
+
+// Report walks through all the entries of the store and summarizes keys per prefix, the distribution of value sizes and,
+// for structured values, the number of entries written per day. Sizes are those of the values as written, labels and
+// codec headers excluded and decoded.
+// Keys are grouped by their first prefixLength bytes, when prefixLength is 0 no per prefix summary is produced.
+// The store does not record whether a value was written as a structured one, so the daily growth is a heuristic:
+// see structuredTimestamp. Raw values are left out of it, unless they happen to look exactly like structured ones.
+func (t *Store) Report(prefixLength int) (*schema.StoreReport, error) {
+	txn := t.db.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+	it := txn.NewIterator(badger.IteratorOptions{
+		PrefetchValues: true,
+		AllVersions:    true,
+	})
+	defer it.Close()
+
+	report := &schema.StoreReport{}
+	for _, max := range valueSizeBuckets {
+		report.ValueSizes = append(report.ValueSizes, &schema.ValueSizeBucket{MaxSize: max})
+	}
+	report.ValueSizes = append(report.ValueSizes, &schema.ValueSizeBucket{})
+
+	prefixes := map[string]*schema.PrefixReport{}
+	growth := map[string]*schema.GrowthReport{}
+	now := time.Now()
+	var lastKey []byte
+	for it.Rewind(); it.Valid(); it.Next() {
+		item := it.Item()
+		key := item.Key()
+		if len(key) == 0 || key[0] == tsPrefix {
+			continue
+		}
+		stored, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, mapError(err)
+		}
+		_, value, err := decodeEntry(item.UserMeta(), stored)
+		if err != nil {
+			return nil, err
+		}
+		size := uint64(len(value))
+
+		newKey := !bytes.Equal(key, lastKey)
+		if newKey {
+			lastKey = item.KeyCopy(lastKey[:0])
+			report.Keys++
+		}
+		report.Entries++
+		report.ValueBytes += size
+
+		bucket := report.ValueSizes[len(report.ValueSizes)-1]
+		for i, max := range valueSizeBuckets {
+			if size <= max {
+				bucket = report.ValueSizes[i]
+				break
+			}
+		}
+		bucket.Entries++
+
+		if prefixLength > 0 {
+			prefix := key
+			if len(prefix) > prefixLength {
+				prefix = prefix[:prefixLength]
+			}
+			pr, ok := prefixes[string(prefix)]
+			if !ok {
+				pr = &schema.PrefixReport{Prefix: append([]byte{}, prefix...)}
+				prefixes[string(prefix)] = pr
+			}
+			if newKey {
+				pr.Keys++
+			}
+			pr.Entries++
+			pr.ValueBytes += size
+		}
+
+		if item.UserMeta()&bitReferenceEntry == bitReferenceEntry {
+			continue
+		}
+		ts, ok := structuredTimestamp(value, now)
+		if !ok {
+			continue
+		}
+		day := time.Unix(int64(ts), 0).UTC().Format("2006-01-02")
+		gr, ok := growth[day]
+		if !ok {
+			gr = &schema.GrowthReport{Day: day}
+			growth[day] = gr
+		}
+		gr.Entries++
+		gr.ValueBytes += size
+	}
+
+	for _, pr := range prefixes {
+		report.Prefixes = append(report.Prefixes, pr)
+	}
+	sort.Slice(report.Prefixes, func(i, j int) bool {
+		return bytes.Compare(report.Prefixes[i].Prefix, report.Prefixes[j].Prefix) < 0
+	})
+	for _, gr := range growth {
+		report.Growth = append(report.Growth, gr)
+	}
+	sort.Slice(report.Growth, func(i, j int) bool {
+		return report.Growth[i].Day < report.Growth[j].Day
+	})
+	return report, nil
+}
+
+// structuredTimestamp returns the timestamp of a value written as a structured one. A value is taken as structured
+// when it decodes as a Content, encodes back to the very same bytes and has a timestamp between the epoch and now.
+func structuredTimestamp(value []byte, now time.Time) (uint64, bool) {
+	c := schema.Content{}
+	if err := proto.Unmarshal(value, &c); err != nil || c.Timestamp == 0 || c.Timestamp > uint64(now.Unix()) {
+		return 0, false
+	}
+	if encoded, err := proto.Marshal(&c); err != nil || !bytes.Equal(encoded, value) {
+		return 0, false
+	}
+	return c.Timestamp, true
+}
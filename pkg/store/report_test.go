@@ -0,0 +1,82 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreReport(t *testing.T) {
+	st, closer := makeStore()
+	defer closer()
+
+	ts := time.Date(2020, 7, 1, 10, 0, 0, 0, time.UTC)
+	structured, err := proto.Marshal(&schema.Content{Timestamp: uint64(ts.Unix()), Payload: []byte(`item`)})
+	require.NoError(t, err)
+
+	st.Set(schema.KeyValue{Key: []byte(`aaa`), Value: []byte(`item1`)})
+	st.Set(schema.KeyValue{Key: []byte(`aaa`), Value: []byte(`item2`)})
+	st.Set(schema.KeyValue{Key: []byte(`abc`), Value: structured})
+	st.Set(schema.KeyValue{Key: []byte(`bbb`), Value: bytes.Repeat([]byte(`x`), 100)})
+	// raw values that decode as structured ones but are not encoded as such, or are timestamped in the future
+	future, err := proto.Marshal(&schema.Content{Timestamp: uint64(time.Now().Add(48 * time.Hour).Unix())})
+	require.NoError(t, err)
+	st.Set(schema.KeyValue{Key: []byte(`ddd`), Value: []byte{0x08, 0x01, 0x08, 0x05}})
+	st.Set(schema.KeyValue{Key: []byte(`ddd`), Value: future})
+	_, err = st.Set(schema.KeyValue{Key: []byte(`ccc`), Value: bytes.Repeat([]byte(`x`), 2<<20)})
+	require.NoError(t, err)
+	// labels and codec headers are not accounted, the values are measured as written
+	_, err = st.Set(schema.KeyValue{Key: []byte(`eee`), Value: structured, Labels: map[string]string{"env": "prod"}})
+	require.NoError(t, err)
+	_, err = st.Set(schema.KeyValue{Key: []byte(`eee`), Value: structured, Codecs: []uint32{uint32(CodecGzip)}})
+	require.NoError(t, err)
+
+	report, err := st.Report(1)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(6), report.Keys)
+	assert.Equal(t, uint64(9), report.Entries)
+	assert.Equal(t, uint64(10+3*len(structured)+100+2<<20+4+len(future)), report.ValueBytes)
+
+	require.Len(t, report.Prefixes, 5)
+	assert.Equal(t, []byte(`a`), report.Prefixes[0].Prefix)
+	assert.Equal(t, uint64(2), report.Prefixes[0].Keys)
+	assert.Equal(t, uint64(3), report.Prefixes[0].Entries)
+	assert.Equal(t, []byte(`c`), report.Prefixes[2].Prefix)
+
+	require.Len(t, report.ValueSizes, len(valueSizeBuckets)+1)
+	assert.Equal(t, uint64(7), report.ValueSizes[0].Entries)
+	assert.Equal(t, uint64(1), report.ValueSizes[1].Entries)
+	assert.Equal(t, uint64(0), report.ValueSizes[len(report.ValueSizes)-1].MaxSize)
+	assert.Equal(t, uint64(1), report.ValueSizes[len(report.ValueSizes)-1].Entries)
+
+	require.Len(t, report.Growth, 1)
+	assert.Equal(t, "2020-07-01", report.Growth[0].Day)
+	assert.Equal(t, uint64(3), report.Growth[0].Entries)
+	assert.Equal(t, uint64(3*len(structured)), report.Growth[0].ValueBytes)
+
+	report, err = st.Report(0)
+	require.NoError(t, err)
+	assert.Empty(t, report.Prefixes)
+}
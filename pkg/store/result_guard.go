@@ -0,0 +1,73 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import "github.com/codenotary/immudb/pkg/api/schema"
+
+// itemOverhead approximates the memory taken by an item besides its key, value and labels
+const itemOverhead = 128
+
+// resultGuard keeps track of the items collected by a query and enforces the store result limits. Besides the
+// result, the execution memory accounts for what a query buffers while it runs, e.g. the keys sorted by a collated
+// scan.
+type resultGuard struct {
+	maxItems  int
+	maxBytes  int
+	maxMemory int
+	items     int
+	bytes     int
+	memory    int
+}
+
+func (t *Store) newResultGuard() *resultGuard {
+	return &resultGuard{
+		maxItems:  t.maxResultItems,
+		maxBytes:  t.maxResultBytes,
+		maxMemory: t.maxExecutionMemory,
+	}
+}
+
+// add accounts for the given item, returning an error as soon as any of the limits is exceeded
+func (g *resultGuard) add(item *schema.Item) error {
+	g.items++
+	if g.maxItems > 0 && g.items > g.maxItems {
+		return ErrMaxResultItems
+	}
+	g.bytes += len(item.GetKey()) + len(item.GetValue())
+	if g.maxBytes > 0 && g.bytes > g.maxBytes {
+		return ErrMaxResultBytes
+	}
+	size := itemOverhead + len(item.GetKey()) + len(item.GetValue())
+	for name, value := range item.GetLabels() {
+		size += len(name) + len(value)
+	}
+	return g.reserve(size)
+}
+
+// reserve accounts for n bytes buffered by the query, returning an error when the execution memory limit is exceeded
+func (g *resultGuard) reserve(n int) error {
+	g.memory += n
+	if g.maxMemory > 0 && g.memory > g.maxMemory {
+		return ErrMaxExecutionMemory
+	}
+	return nil
+}
+
+// release gives back n bytes reserved by the query
+func (g *resultGuard) release(n int) {
+	g.memory -= n
+}
@@ -43,6 +43,9 @@ func (t *Store) SafeSet(options schema.SafeSetOptions) (proof *schema.Proof, err
 	if err = checkKey(kv.Key); err != nil {
 		return nil, err
 	}
+	if err = t.validateWrite(kv); err != nil {
+		return nil, err
+	}
 
 	prevRootIdx, err := getPrevRootIdx(t.tree.LastIndex(), options.RootIndex)
 	if err != nil {
@@ -51,15 +54,19 @@ func (t *Store) SafeSet(options schema.SafeSetOptions) (proof *schema.Proof, err
 
 	txn := t.db.NewTransactionAt(math.MaxUint64, true)
 	defer txn.Discard()
-	if err = txn.SetEntry(&badger.Entry{
-		Key:   kv.Key,
-		Value: kv.Value,
-	}); err != nil {
+	entry, err := newEntry(*kv)
+	if err != nil {
+		return nil, err
+	}
+	if err = txn.SetEntry(entry); err != nil {
 		err = mapError(err)
 		return
 	}
 
 	tsEntry := t.tree.NewEntry(kv.Key, kv.Value)
+	if err = t.journal(txn, tsEntry); err != nil {
+		return
+	}
 	index := tsEntry.Index()
 	leaf := tsEntry.HashCopy()
 
@@ -193,6 +200,9 @@ func (t *Store) SafeReference(options schema.SafeReferenceOptions) (proof *schem
 	}
 
 	tsEntry := t.tree.NewEntry(ro.Reference, i.Key())
+	if err = t.journal(txn, tsEntry); err != nil {
+		return
+	}
 
 	index := tsEntry.Index()
 	leaf := tsEntry.HashCopy()
@@ -264,6 +274,9 @@ func (t *Store) SafeZAdd(options schema.SafeZAddOptions) (proof *schema.Proof, e
 		return
 	}
 	tsEntry := t.tree.NewEntry(ik, i.Key())
+	if err = t.journal(txn, tsEntry); err != nil {
+		return
+	}
 	index := tsEntry.Index()
 	leaf := tsEntry.HashCopy()
 
@@ -17,7 +17,9 @@ limitations under the License.
 package store
 
 import (
+	"container/heap"
 	"math"
+	"sort"
 
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/dgraph-io/badger/v2"
@@ -36,6 +38,10 @@ func (t *Store) Scan(options schema.ScanOptions) (list *schema.ItemList, err err
 	txn := t.db.NewTransactionAt(math.MaxUint64, false)
 	defer txn.Discard()
 
+	if t.collation != CollationBinary {
+		return t.scanCollated(txn, options)
+	}
+
 	seek := options.Prefix
 	if options.Reverse {
 		seek = append(options.Prefix, 0xFF)
@@ -63,32 +69,24 @@ func (t *Store) Scan(options schema.ScanOptions) (list *schema.ItemList, err err
 		limit = uint64(t.db.MaxBatchCount())
 	}
 	var items []*schema.Item
+	guard := t.newResultGuard()
 	i := uint64(0)
 	for it.Seek(seek); it.Valid(); it.Next() {
-		var item *schema.Item
-		if it.Item().UserMeta()&bitReferenceEntry == bitReferenceEntry {
-			if !options.Deep {
-				continue
-			}
-			var refKey []byte
-			err = it.Item().Value(func(val []byte) error {
-				refKey = append([]byte{}, val...)
-				return nil
-			})
-			if err != nil {
-				return nil, err
-			}
-			if ref, err := txn.Get(refKey); err == nil {
-				item, err = itemToSchema(refKey, ref)
-				if err != nil {
-					return nil, err
-				}
-			}
-		} else {
-			item, err = itemToSchema(nil, it.Item())
-			if err != nil {
-				return nil, err
-			}
+		if it.Item().Key()[0] == tsPrefix {
+			continue
+		}
+		if it.Item().UserMeta()&bitReferenceEntry == bitReferenceEntry && !options.Deep {
+			continue
+		}
+		item, err := scanItem(txn, it.Item())
+		if err != nil {
+			return nil, err
+		}
+		if len(options.Labels) > 0 && (item == nil || !matchLabels(item.Labels, options.Labels)) {
+			continue
+		}
+		if err = guard.add(item); err != nil {
+			return nil, err
 		}
 		items = append(items, item)
 		if i++; i == limit {
@@ -101,6 +99,120 @@ func (t *Store) Scan(options schema.ScanOptions) (list *schema.ItemList, err err
 	return
 }
 
+// scanItem converts a scanned entry, following it when it is a reference
+func scanItem(txn *badger.Txn, i *badger.Item) (*schema.Item, error) {
+	if i.UserMeta()&bitReferenceEntry != bitReferenceEntry {
+		return itemToSchema(nil, i)
+	}
+	var refKey []byte
+	err := i.Value(func(val []byte) error {
+		refKey = append([]byte{}, val...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if ref, err := txn.Get(refKey); err == nil {
+		return itemToSchema(refKey, ref)
+	}
+	return nil, nil
+}
+
+// scanCollated serves a scan of a store whose keys are not ordered byte by byte: the keys having the prefix are
+// walked once, keeping only the page following the offset in collation order, then the page is sorted and read.
+// Memory is bounded by the limit, not by the number of keys having the prefix.
+func (t *Store) scanCollated(txn *badger.Txn, options schema.ScanOptions) (*schema.ItemList, error) {
+	var limit = options.Limit
+	if limit == 0 {
+		limit = uint64(t.db.MaxBatchCount())
+	}
+	before := func(a, b []byte) bool {
+		if options.Reverse {
+			return t.collation.Compare(a, b) > 0
+		}
+		return t.collation.Compare(a, b) < 0
+	}
+	it := txn.NewIterator(badger.IteratorOptions{Prefix: options.Prefix})
+	defer it.Close()
+
+	guard := t.newResultGuard()
+	page := &collatedPage{before: before}
+	for it.Rewind(); it.Valid(); it.Next() {
+		key := it.Item().Key()
+		if key[0] == tsPrefix {
+			continue
+		}
+		if it.Item().UserMeta()&bitReferenceEntry == bitReferenceEntry && !options.Deep {
+			continue
+		}
+		if len(options.Offset) > 0 && !before(options.Offset, key) {
+			continue
+		}
+		full := uint64(page.Len()) == limit
+		if full && !before(key, page.keys[0]) {
+			continue
+		}
+		if len(options.Labels) > 0 {
+			item, err := scanItem(txn, it.Item())
+			if err != nil {
+				return nil, err
+			}
+			if item == nil || !matchLabels(item.Labels, options.Labels) {
+				continue
+			}
+		}
+		if err := guard.reserve(len(key)); err != nil {
+			return nil, err
+		}
+		if full {
+			guard.release(len(page.keys[0]))
+			page.keys[0] = it.Item().KeyCopy(nil)
+			heap.Fix(page, 0)
+		} else {
+			heap.Push(page, it.Item().KeyCopy(nil))
+		}
+	}
+	keys := page.keys
+	sort.Slice(keys, func(i, j int) bool { return before(keys[i], keys[j]) })
+
+	var items []*schema.Item
+	for _, key := range keys {
+		entry, err := txn.Get(key)
+		if err != nil {
+			return nil, mapError(err)
+		}
+		item, err := scanItem(txn, entry)
+		if err != nil {
+			return nil, err
+		}
+		if err = guard.add(item); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return &schema.ItemList{Items: items}, nil
+}
+
+// collatedPage is a heap holding the first keys of a collated scan, its root being the last of them in scan order
+type collatedPage struct {
+	keys   [][]byte
+	before func(a, b []byte) bool
+}
+
+func (p *collatedPage) Len() int           { return len(p.keys) }
+func (p *collatedPage) Less(i, j int) bool { return p.before(p.keys[j], p.keys[i]) }
+func (p *collatedPage) Swap(i, j int)      { p.keys[i], p.keys[j] = p.keys[j], p.keys[i] }
+
+func (p *collatedPage) Push(x interface{}) {
+	p.keys = append(p.keys, x.([]byte))
+}
+
+func (p *collatedPage) Pop() interface{} {
+	last := p.keys[len(p.keys)-1]
+	p.keys = p.keys[:len(p.keys)-1]
+	return last
+}
+
 // ZScan The SCAN command is used in order to incrementally iterate over a collection of elements.
 func (t *Store) ZScan(options schema.ZScanOptions) (list *schema.ItemList, err error) {
 
@@ -140,8 +252,12 @@ func (t *Store) ZScan(options schema.ZScanOptions) (list *schema.ItemList, err e
 		limit = uint64(t.db.MaxBatchCount())
 	}
 	var items []*schema.Item
+	guard := t.newResultGuard()
 	i := uint64(0)
 	for it.Seek(seek); it.Valid(); it.Next() {
+		if it.Item().Key()[0] == tsPrefix {
+			continue
+		}
 		var item *schema.Item
 		if it.Item().UserMeta()&bitReferenceEntry == bitReferenceEntry {
 			var refKey []byte
@@ -164,6 +280,9 @@ func (t *Store) ZScan(options schema.ZScanOptions) (list *schema.ItemList, err e
 				return nil, err
 			}
 		}
+		if err = guard.add(item); err != nil {
+			return nil, err
+		}
 		items = append(items, item)
 		if i++; i == limit {
 			break
@@ -180,6 +299,7 @@ func (t *Store) IScan(options schema.IScanOptions) (list *schema.Page, err error
 
 	page := &schema.Page{}
 	page.More = true
+	guard := t.newResultGuard()
 
 	s := uint64(0)
 
@@ -200,6 +320,9 @@ func (t *Store) IScan(options schema.IScanOptions) (list *schema.Page, err error
 		if item == nil {
 			break
 		}
+		if err = guard.add(item); err != nil {
+			return nil, err
+		}
 		page.Items = append(page.Items, item)
 		s++
 		if uint64(len(page.Items)) >= options.PageSize {
@@ -174,3 +174,50 @@ func TestIScan(t *testing.T) {
 	_, err2 := st.IScan(deepScanOptions2)
 	assert.Error(t, ErrIndexNotFound, err2)
 }
+
+func TestStoreScanResultLimits(t *testing.T) {
+	st, closer := makeStore()
+	defer closer()
+
+	st.Set(schema.KeyValue{Key: []byte(`aaa`), Value: []byte(`item1`)})
+	st.Set(schema.KeyValue{Key: []byte(`abc`), Value: []byte(`item2`)})
+	st.Set(schema.KeyValue{Key: []byte(`abd`), Value: []byte(`item3`)})
+
+	st.maxResultItems = 2
+	_, err := st.Scan(schema.ScanOptions{Prefix: []byte(`a`)})
+	assert.Equal(t, ErrMaxResultItems, err)
+
+	list, err := st.Scan(schema.ScanOptions{Prefix: []byte(`a`), Limit: 2})
+	assert.NoError(t, err)
+	assert.Len(t, list.Items, 2)
+
+	st.maxResultItems = 0
+	st.maxResultBytes = 10
+	_, err = st.Scan(schema.ScanOptions{Prefix: []byte(`a`)})
+	assert.Equal(t, ErrMaxResultBytes, err)
+
+	_, err = st.History(schema.Key{Key: []byte(`aaa`)})
+	assert.NoError(t, err)
+
+	st.maxResultBytes = 0
+	list, err = st.Scan(schema.ScanOptions{Prefix: []byte(`a`)})
+	assert.NoError(t, err)
+	assert.Len(t, list.Items, 3)
+
+	keys := []*schema.Key{{Key: []byte(`aaa`)}, {Key: []byte(`missing`)}, {Key: []byte(`abc`)}}
+	list, err = st.GetBatch(keys)
+	assert.NoError(t, err)
+	assert.Len(t, list.Items, 2)
+
+	st.maxResultItems = 1
+	_, err = st.GetBatch(keys)
+	assert.Equal(t, ErrMaxResultItems, err)
+
+	st.maxResultItems = 0
+	st.maxExecutionMemory = itemOverhead + 10
+	_, err = st.GetBatch(keys)
+	assert.Equal(t, ErrMaxExecutionMemory, err)
+	_, err = st.Scan(schema.ScanOptions{Prefix: []byte(`a`)})
+	assert.Equal(t, ErrMaxExecutionMemory, err)
+	st.maxExecutionMemory = 0
+}
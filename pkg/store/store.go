@@ -23,6 +23,7 @@ import (
 	"errors"
 	"math"
 	"sync"
+	"sync/atomic"
 
 	"github.com/codenotary/immudb/pkg/api"
 	"github.com/codenotary/immudb/pkg/api/schema"
@@ -41,11 +42,23 @@ type Store struct {
 	tree *treeStore
 	wg   sync.WaitGroup
 	log  logger.Logger
+
+	maxResultItems     int
+	maxResultBytes     int
+	maxExecutionMemory int
+	maxValueSize       int64
+	collation          Collation
+
+	validator         WriteValidator
+	validatorPrefixes [][]byte
 }
 
 // Open opens the store with the specified options
 func Open(options Options, badgerOptions badger.Options) (*Store, error) {
-	badgerOpts := badgerOptions
+	badgerOpts, err := applyProfile(options.profile, badgerOptions)
+	if err != nil {
+		return nil, err
+	}
 	badgerOpts.ValueDir = badgerOptions.Dir
 	badgerOpts.NumVersionsToKeep = math.MaxInt64 // immutability, always keep all data
 
@@ -54,14 +67,31 @@ func Open(options Options, badgerOptions badger.Options) (*Store, error) {
 		return nil, mapError(err)
 	}
 
+	collationDir := badgerOpts.Dir
+	if badgerOpts.InMemory {
+		collationDir = ""
+	}
+	collation, err := resolveCollation(collationDir, options.collation)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
 	t := &Store{
 		db: db,
 		// fixme(leogr): cache size could be calculated using db.MaxBatchCount()
-		tree: newTreeStore(db, 750_000, options.log),
+		tree: newTreeStore(db, 750_000, options.log, options.replayProgress),
 		log:  options.log,
-	}
 
-	// fixme(leogr): need to get all keys inserted after the tree width, if any, and replay
+		maxResultItems:     options.maxResultItems,
+		maxResultBytes:     options.maxResultBytes,
+		maxExecutionMemory: options.maxExecutionMemory,
+		maxValueSize:       badgerOpts.ValueLogFileSize,
+		collation:          collation,
+
+		validator:         options.validator,
+		validatorPrefixes: options.validatorPrefixes,
+	}
 
 	t.log.Debugf("Store opened at path: %s", badgerOpts.Dir)
 	return t, nil
@@ -96,11 +126,14 @@ func (t *Store) CurrentRoot() (root *schema.Root, err error) {
 	return
 }
 
-// SetBatch adds many entries at once
+// SetBatch adds many entries at once, in a single transaction holding at most MaxBatch entries and bytes
 func (t *Store) SetBatch(list schema.KVList, options ...WriteOption) (index *schema.Index, err error) {
 	if len(list.GetKVs()) == 0 {
 		return nil, errors.New("Empty set")
 	}
+	if err = t.validateWrite(list.KVs...); err != nil {
+		return nil, err
+	}
 	opts := makeWriteOptions(options...)
 	txn := t.db.NewTransactionAt(math.MaxUint64, true)
 	defer txn.Discard()
@@ -109,16 +142,19 @@ func (t *Store) SetBatch(list schema.KVList, options ...WriteOption) (index *sch
 		if err = checkKey(kv.Key); err != nil {
 			return nil, err
 		}
-		if err = txn.SetEntry(&badger.Entry{
-			Key:   kv.Key,
-			Value: kv.Value,
-		}); err != nil {
-			err = mapError(err)
-			return
+		entry, err := newEntry(*kv)
+		if err != nil {
+			return nil, err
+		}
+		if err = txn.SetEntry(entry); err != nil {
+			return nil, mapError(err)
 		}
 	}
 
 	tsEntries := t.tree.NewBatch(&list)
+	if err = t.journal(txn, tsEntries...); err != nil {
+		return nil, err
+	}
 	ts := tsEntries[len(tsEntries)-1].ts
 	index = &schema.Index{
 		Index: ts - 1,
@@ -150,6 +186,27 @@ func (t *Store) SetBatch(list schema.KVList, options ...WriteOption) (index *sch
 	return
 }
 
+// MaxBatch returns the largest number of entries, and of key and value bytes, a single SetBatch can commit.
+// Half of the transaction is taken by the tree leaves committed together with the entries, so that the tree can be
+// rebuilt at open without a flush: with the default options a batch holds about 52k entries and 4.8 MB, half of what
+// it held before the leaves were journaled. The store profiles raising the table size raise it accordingly.
+func (t *Store) MaxBatch() (entries int64, size int64) {
+	return t.db.MaxBatchCount() / 2, t.db.MaxBatchSize() / 2
+}
+
+// journal stages in txn the tree leaves of the entries, discarding them when it can not
+func (t *Store) journal(txn *badger.Txn, entries ...*treeStoreEntry) error {
+	for _, entry := range entries {
+		if err := txn.SetEntry(entry.leaf()); err != nil {
+			for _, entry := range entries {
+				t.tree.Discard(entry)
+			}
+			return mapError(err)
+		}
+	}
+	return nil
+}
+
 // Set adds a new entry
 func (t *Store) Set(kv schema.KeyValue, options ...WriteOption) (index *schema.Index, err error) {
 	opts := makeWriteOptions(options...)
@@ -158,15 +215,34 @@ func (t *Store) Set(kv schema.KeyValue, options ...WriteOption) (index *schema.I
 	}
 	txn := t.db.NewTransactionAt(math.MaxUint64, true)
 	defer txn.Discard()
-	if err = txn.SetEntry(&badger.Entry{
-		Key:   kv.Key,
-		Value: kv.Value,
-	}); err != nil {
+	if opts.skipUnchanged != nil {
+		if i, err := txn.Get(kv.Key); err == nil && i.UserMeta()&bitReferenceEntry != bitReferenceEntry {
+			current, err := i.ValueCopy(nil)
+			var labels map[string]string
+			if err == nil {
+				labels, current, err = decodeEntry(i.UserMeta(), current)
+			}
+			if err == nil && sameLabels(labels, kv.Labels) && opts.skipUnchanged(current, kv.Value) {
+				return &schema.Index{Index: i.Version() - 1}, nil
+			}
+		}
+	}
+	if err = t.validateWrite(&kv); err != nil {
+		return nil, err
+	}
+	entry, err := newEntry(kv)
+	if err != nil {
+		return nil, err
+	}
+	if err = txn.SetEntry(entry); err != nil {
 		err = mapError(err)
 		return
 	}
 
 	tsEntry := t.tree.NewEntry(kv.Key, kv.Value)
+	if err = t.journal(txn, tsEntry); err != nil {
+		return nil, err
+	}
 	index = &schema.Index{
 		Index: tsEntry.ts - 1,
 	}
@@ -220,6 +296,26 @@ func (t *Store) Get(key schema.Key) (item *schema.Item, err error) {
 	return itemToSchema(key.Key, i)
 }
 
+// GetBatch fetches the current values of the keys, skipping the missing ones, within the result limits of the store
+func (t *Store) GetBatch(keys []*schema.Key) (*schema.ItemList, error) {
+	list := &schema.ItemList{}
+	guard := t.newResultGuard()
+	for _, key := range keys {
+		item, err := t.Get(*key)
+		if err == ErrKeyNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err = guard.add(item); err != nil {
+			return nil, err
+		}
+		list.Items = append(list.Items, item)
+	}
+	return list, nil
+}
+
 // CountAll returns the total number of entries
 func (t *Store) CountAll() (count uint64) {
 	txn := t.db.NewTransactionAt(math.MaxUint64, false)
@@ -229,7 +325,9 @@ func (t *Store) CountAll() (count uint64) {
 	})
 	defer it.Close()
 	for it.Rewind(); it.Valid(); it.Next() {
-		count++
+		if it.Item().Key()[0] != tsPrefix {
+			count++
+		}
 	}
 	return
 }
@@ -340,11 +438,15 @@ func (t *Store) History(key schema.Key) (list *schema.ItemList, err error) {
 	defer it.Close()
 
 	var items []*schema.Item
+	guard := t.newResultGuard()
 	for it.Rewind(); it.Valid(); it.Next() {
 		item, err := itemToSchema(key.Key, it.Item())
 		if err != nil {
 			return nil, err
 		}
+		if err = guard.add(item); err != nil {
+			return nil, err
+		}
 		items = append(items, item)
 	}
 	list = &schema.ItemList{
@@ -386,6 +488,9 @@ func (t *Store) Reference(refOpts *schema.ReferenceOptions, options ...WriteOpti
 	}
 
 	tsEntry := t.tree.NewEntry(refOpts.Reference, i.Key())
+	if err = t.journal(txn, tsEntry); err != nil {
+		return nil, err
+	}
 	index = &schema.Index{
 		Index: tsEntry.ts - 1,
 	}
@@ -446,6 +551,9 @@ func (t *Store) ZAdd(zaddOpts schema.ZAddOptions, options ...WriteOption) (index
 	}
 
 	tsEntry := t.tree.NewEntry(ik, i.Key())
+	if err = t.journal(txn, tsEntry); err != nil {
+		return nil, err
+	}
 
 	index = &schema.Index{
 		Index: tsEntry.ts - 1,
@@ -543,12 +651,62 @@ func (t *Store) Restore(kvChan chan *pb.KVList) (i uint64, err error) {
 	}
 }
 
+// CopyFrom loads into the store the whole content of src, including the merkle tree.
+// It's meant to be used on a newly created, empty store.
+// The tree of src is locked only while it is flushed, the copy then reads the snapshot of src at the flushed width
+// while src keeps accepting writes, the entries added later are not copied.
+func (t *Store) CopyFrom(src *Store) (err error) {
+	// wait for the entries already committed to be part of the tree
+	if ts := atomic.LoadUint64(&src.tree.ts); ts > 0 {
+		src.tree.WaitUntil(ts - 1)
+	}
+	src.tree.Lock()
+	src.tree.flush()
+	w := src.tree.w
+	src.tree.Unlock()
+	if w == 0 {
+		return nil
+	}
+
+	t.tree.Lock()
+	defer t.tree.Unlock()
+	if t.tree.w > 0 {
+		return ErrInvalidRequest
+	}
+
+	ldr := t.db.NewKVLoader(16)
+	stream := src.db.NewStreamAt(w)
+	stream.NumGo = 16
+	stream.LogPrefix = "Badger.Streaming"
+	stream.Send = func(list *pb.KVList) error {
+		for _, kv := range list.Kv {
+			if err := ldr.Set(kv); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err = stream.Orchestrate(context.Background()); err != nil {
+		return mapError(err)
+	}
+	if err = ldr.Finish(); err != nil {
+		return mapError(err)
+	}
+	t.tree.loadTreeState()
+	return nil
+}
+
 // HealthCheck ...
 func (t *Store) HealthCheck() bool {
 	_, err := t.Get(schema.Key{Key: []byte{255}})
 	return err == nil || err == ErrKeyNotFound
 }
 
+// PendingEntries returns the number of written entries still waiting to be added into the merkle tree
+func (t *Store) PendingEntries() uint64 {
+	return t.tree.Pending()
+}
+
 // DbSize ...
 func (t *Store) DbSize() (int64, int64) {
 	return t.db.Size()
@@ -17,6 +17,7 @@ limitations under the License.
 package store
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"fmt"
 	"io/ioutil"
@@ -409,6 +410,107 @@ func TestRestoreHistoryCheck(t *testing.T) {
 
 }
 
+func TestSetSkipUnchanged(t *testing.T) {
+	st, closer := makeStore()
+	defer closer()
+
+	skip := WithSkipUnchanged(bytes.Equal)
+	first, err := st.Set(schema.KeyValue{Key: []byte(`key`), Value: []byte(`value`)}, skip)
+	assert.NoError(t, err)
+	st.tree.WaitUntil(first.Index)
+
+	again, err := st.Set(schema.KeyValue{Key: []byte(`key`), Value: []byte(`value`)}, skip)
+	assert.NoError(t, err)
+	assert.Equal(t, first.Index, again.Index)
+
+	changed, err := st.Set(schema.KeyValue{Key: []byte(`key`), Value: []byte(`other`)}, skip)
+	assert.NoError(t, err)
+	assert.Equal(t, first.Index+1, changed.Index)
+
+	forced, err := st.Set(schema.KeyValue{Key: []byte(`key`), Value: []byte(`other`)})
+	assert.NoError(t, err)
+	assert.Equal(t, changed.Index+1, forced.Index)
+	st.tree.WaitUntil(forced.Index)
+
+	history, err := st.History(schema.Key{Key: []byte(`key`)})
+	assert.NoError(t, err)
+	assert.Len(t, history.Items, 3)
+}
+
+func TestCopyFrom(t *testing.T) {
+	src, srcCloser := makeStore()
+	defer srcCloser()
+	dst, dstCloser := makeStore()
+	defer dstCloser()
+
+	empty, emptyCloser := makeStore()
+	defer emptyCloser()
+	assert.NoError(t, dst.CopyFrom(empty))
+
+	for n := uint64(0); n <= 4; n++ {
+		_, err := src.Set(schema.KeyValue{Key: []byte(strconv.FormatUint(n, 10)), Value: []byte(`value`)})
+		assert.NoError(t, err)
+	}
+	index, err := src.Set(schema.KeyValue{Key: []byte(`1`), Value: []byte(`secondval`)})
+	assert.NoError(t, err)
+	src.tree.WaitUntil(index.Index)
+
+	assert.NoError(t, dst.CopyFrom(src))
+
+	srcRoot, err := src.CurrentRoot()
+	assert.NoError(t, err)
+	dstRoot, err := dst.CurrentRoot()
+	assert.NoError(t, err)
+	assert.Equal(t, srcRoot, dstRoot)
+
+	item, err := dst.Get(schema.Key{Key: []byte(`1`)})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(`secondval`), item.Value)
+	assert.Equal(t, index.Index, item.Index)
+
+	history, err := dst.History(schema.Key{Key: []byte(`1`)})
+	assert.NoError(t, err)
+	assert.Len(t, history.Items, 2)
+
+	next, err := dst.Set(schema.KeyValue{Key: []byte(`new`), Value: []byte(`value`)})
+	assert.NoError(t, err)
+	assert.Equal(t, index.Index+1, next.Index)
+
+	assert.Equal(t, ErrInvalidRequest, dst.CopyFrom(src))
+}
+
+func TestCopyFromWhileWriting(t *testing.T) {
+	src, srcCloser := makeStore()
+	defer srcCloser()
+	dst, dstCloser := makeStore()
+	defer dstCloser()
+
+	for n := 0; n < 100; n++ {
+		_, err := src.Set(schema.KeyValue{Key: []byte(strconv.Itoa(n)), Value: []byte(`value`)})
+		assert.NoError(t, err)
+	}
+	written := make(chan error)
+	go func() {
+		for n := 100; n < 200; n++ {
+			if _, err := src.Set(schema.KeyValue{Key: []byte(strconv.Itoa(n)), Value: []byte(`value`)}); err != nil {
+				written <- err
+				return
+			}
+		}
+		written <- nil
+	}()
+	assert.NoError(t, dst.CopyFrom(src))
+	assert.NoError(t, <-written)
+
+	// the copy is a snapshot of src, consistent with its later history
+	dstRoot, err := dst.CurrentRoot()
+	assert.NoError(t, err)
+	assert.True(t, dstRoot.Index >= 99)
+	safeItem, err := src.SafeGet(schema.SafeGetOptions{Key: []byte(`199`), RootIndex: &schema.Index{Index: dstRoot.Index}})
+	assert.NoError(t, err)
+	assert.True(t, safeItem.Proof.Verify(safeItem.Item.Hash(), *dstRoot))
+}
+
 func TestInsertionOrderIndex(t *testing.T) {
 	st, closer := makeStore()
 	defer closer()
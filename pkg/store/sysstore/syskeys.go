@@ -22,6 +22,10 @@ import "bytes"
 const (
 	//All user keys in the key/value store are prefixed by this keys to distinguish them from keys that have other purposes
 	KeyPrefixUser = iota + 1
+	//Usage of each user, persisted to keep the write quotas across restarts
+	KeyPrefixUsage
+	//Writes executed on behalf of end users, keyed by database and index
+	KeyPrefixOnBehalfOf
 )
 
 // AddKeyPrefix ...
@@ -20,6 +20,7 @@ import (
 	"container/heap"
 	"crypto/sha256"
 	"encoding/binary"
+	"math"
 	"reflect"
 	"sync"
 	"sync/atomic"
@@ -115,6 +116,19 @@ func (t treeStoreEntry) HashCopy() []byte {
 	return append([]byte{}, t.h[:]...)
 }
 
+// leaf returns the layer 0 node of the entry. It is committed together with the entry, while the other layers
+// are only stored when the tree is flushed, so that the tree can be rebuilt at open if it has not been flushed.
+func (t treeStoreEntry) leaf() *badger.Entry {
+	return &badger.Entry{
+		Key:      treeKey(0, t.ts-1),
+		Value:    refTreeKey(*t.h, *t.r),
+		UserMeta: bitTreeEntry,
+	}
+}
+
+// replayProgressStep is the number of leaves replayed between two progress reports
+const replayProgressStep = 10_000
+
 type treeStore struct {
 	// A 64-bit integer must be at the top for memory alignment
 	ts          uint64 // badger timestamp
@@ -128,20 +142,24 @@ type treeStore struct {
 	rcache      ring.Buffer
 	cPos        [256]uint64
 	cSize       uint64
+	progress    func(done, total uint64)
 	sync.RWMutex
 	closeOnce sync.Once
 }
 
-func newTreeStore(db *badger.DB, cacheSize uint64, log logger.Logger) *treeStore {
+// newTreeStore loads the tree stored in db, progress, when not nil, is called while the leaves committed after the
+// last flush are replayed
+func newTreeStore(db *badger.DB, cacheSize uint64, log logger.Logger, progress func(done, total uint64)) *treeStore {
 
 	t := &treeStore{
-		db:     db,
-		log:    log,
-		c:      make(chan *treeStoreEntry, cacheSize),
-		quit:   make(chan struct{}),
-		caches: [256]ring.Buffer{},
-		cPos:   [256]uint64{},
-		cSize:  cacheSize,
+		db:       db,
+		log:      log,
+		c:        make(chan *treeStoreEntry, cacheSize),
+		quit:     make(chan struct{}),
+		caches:   [256]ring.Buffer{},
+		cPos:     [256]uint64{},
+		cSize:    cacheSize,
+		progress: progress,
 	}
 
 	t.makeCaches()
@@ -168,6 +186,70 @@ func (t *treeStore) loadTreeState() {
 		t.ts = t.w
 		return nil
 	})
+	if from := t.frozenWidth(); from < t.w {
+		t.replay(from)
+	}
+}
+
+// frozenWidth returns the largest width, up to the number of leaves, whose upper layers have all been stored.
+// Leaves are committed with their entries but the upper layers only when the tree is flushed, the leaves past
+// this width have to be replayed.
+func (t *treeStore) frozenWidth() uint64 {
+	w := t.cPos[0]
+	for l := uint(1); l < 64 && w>>l > 0; l++ {
+		if max := (t.cPos[l]+1)<<l - 1; w > max {
+			w = max
+		}
+	}
+	return w
+}
+
+// replay appends again the leaves stored from index _from_ onwards, rebuilding the upper layers of the tree.
+// Missing leaves belong to entries whose commit has not completed, they are appended as discarded.
+func (t *treeStore) replay(from uint64) {
+	total := t.w - from
+	t.w = from
+	t.lastFlushed = from
+	t.log.Infof("Replaying %d tree entries from index %d", total, from)
+	t.reportReplay(0, total)
+
+	txn := t.db.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+	it := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer it.Close()
+
+	prefix := []byte{tsPrefix, 0}
+	for it.Seek(treeKey(0, from)); it.ValidForPrefix(prefix); it.Next() {
+		_, index := decodeTreeKey(it.Item().Key())
+		for t.w < index {
+			h := api.Digest(t.w+1, []byte{}, []byte{})
+			t.append(&h, nil)
+		}
+		v, err := it.Item().ValueCopy(nil)
+		if err != nil {
+			t.log.Errorf("Unable to replay tree entry %d: %s", index, err)
+			break
+		}
+		h, ref, err := decodeRefTreeKey(v)
+		if err != nil && err != ErrObsoleteDataFormat {
+			t.log.Errorf("Unable to replay tree entry %d: %s", index, err)
+			break
+		}
+		t.append(&h, ref)
+		if done := t.w - from; done%replayProgressStep == 0 {
+			t.reportReplay(done, total)
+		}
+	}
+	// the entries past a leaf that could not be replayed are given new indexes
+	t.ts = t.w
+	t.reportReplay(t.w-from, total)
+	t.log.Infof("Tree of width %d replayed", t.w)
+}
+
+func (t *treeStore) reportReplay(done, total uint64) {
+	if t.progress != nil {
+		t.progress(done, total)
+	}
 }
 
 func (t *treeStore) makeCaches() {
@@ -220,6 +302,14 @@ func (t *treeStore) LastIndex() uint64 {
 	return atomic.LoadUint64(&t.ts) - 1
 }
 
+// Pending returns the number of entries leased but not yet added into the merkletree.
+// It's thread-safe.
+func (t *treeStore) Pending() uint64 {
+	t.RLock()
+	defer t.RUnlock()
+	return atomic.LoadUint64(&t.ts) - t.w
+}
+
 // NewEntry acquires a lease for a new entry and returns it. The entry must be used with Commit() or Discard().
 // It's thread-safe.
 func (t *treeStore) NewEntry(key []byte, value []byte) *treeStoreEntry {
@@ -274,16 +364,7 @@ func (t *treeStore) worker() {
 		for min := pq.Min(); min == t.w+1; min = pq.Min() {
 
 			item := heap.Pop(&pq).(*treeStoreEntry)
-
-			// insertion order index reference creation
-			c := refTreeKey(*item.h, *item.r)
-			// insertion order index cache save
-			t.rcache.Set(item.ts-1, c)
-
-			merkletree.AppendHash(t, item.h)
-			if t.w%2 == 0 && (t.w-t.lastFlushed) >= t.cSize/2 {
-				t.flush()
-			}
+			t.append(item.h, *item.r)
 		}
 		t.Unlock()
 	}
@@ -296,6 +377,20 @@ func (t *treeStore) worker() {
 	t.quit <- struct{}{}
 }
 
+// append adds the leaf _h_ of the entry having key _ref_ to the tree, flushing it every half cache size.
+// It should be only called when _t_ is locked, _h_ is overwritten.
+func (t *treeStore) append(h *[sha256.Size]byte, ref []byte) {
+	// insertion order index reference creation
+	c := refTreeKey(*h, ref)
+	// insertion order index cache save
+	t.rcache.Set(t.w, c)
+
+	merkletree.AppendHash(t, h)
+	if t.w%2 == 0 && (t.w-t.lastFlushed) >= t.cSize/2 {
+		t.flush()
+	}
+}
+
 // flush should be only called when the tree is in a consistent state and _t_ is locked.
 // It always flushes the last portion (ie. items not yet flushed) of buffers in batch,
 // in case of failure previous stored state will be preserved and cache indexes will be not advanced.
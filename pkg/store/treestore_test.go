@@ -17,9 +17,12 @@ limitations under the License.
 package store
 
 import (
+	"fmt"
 	"os"
 	"testing"
 
+	"github.com/codenotary/immudb/pkg/api"
+	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/logger"
 
 	"github.com/stretchr/testify/assert"
@@ -31,7 +34,7 @@ func TestNewTreeStore(t *testing.T) {
 	defer db.Close()
 	log := logger.NewSimpleLoggerWithLevel("test", os.Stderr, logger.LogDebug)
 
-	ts := newTreeStore(db.DB, 1000, log)
+	ts := newTreeStore(db.DB, 1000, log, nil)
 	assert.Zero(t, ts.Width())
 
 	// add two items
@@ -45,8 +48,67 @@ func TestNewTreeStore(t *testing.T) {
 	ts.Close()
 	db.Restart()
 
-	ts = newTreeStore(db.DB, 1000, log)
+	ts = newTreeStore(db.DB, 1000, log, nil)
 	assert.Equal(t, uint64(2), ts.Width())
 
 	ts.Close()
 }
+
+func TestTreeStoreReplay(t *testing.T) {
+	db := makeBadger()
+	defer db.Close()
+	log := logger.NewSimpleLoggerWithLevel("test", os.Stderr, logger.LogDebug)
+
+	st := &Store{db: db.DB, tree: newTreeStore(db.DB, 1000, log, nil), log: log}
+	for i := 0; i < 10; i++ {
+		_, err := st.Set(schema.KeyValue{Key: []byte(fmt.Sprintf("key%d", i)), Value: []byte("value")})
+		assert.NoError(t, err)
+	}
+	_, err := st.SetBatch(schema.KVList{KVs: []*schema.KeyValue{
+		{Key: []byte("batch1"), Value: []byte("value")},
+		{Key: []byte("batch2"), Value: []byte("value")},
+	}})
+	assert.NoError(t, err)
+	st.tree.WaitUntil(11)
+	root, err := st.CurrentRoot()
+	assert.NoError(t, err)
+
+	// a lease never committed leaves a gap before the next entry
+	st.tree.NewEntry([]byte("lost"), []byte("value"))
+	_, err = st.Set(schema.KeyValue{Key: []byte("last"), Value: []byte("value")})
+	assert.NoError(t, err)
+
+	// the tree is not flushed before the restart, as after a crash
+	db.Restart()
+
+	var done, total uint64
+	tree := newTreeStore(db.DB, 1000, log, func(d, t uint64) {
+		done, total = d, t
+	})
+	assert.Equal(t, uint64(14), tree.Width())
+	// the first leaf has no parent to rebuild
+	assert.Equal(t, uint64(13), done)
+	assert.Equal(t, uint64(13), total)
+	assert.Equal(t, uint64(13), tree.LastIndex())
+	discarded := api.Digest(13, []byte{}, []byte{})
+	assert.Equal(t, discarded, *tree.Get(0, 12))
+
+	replayed := &Store{db: db.DB, tree: tree, log: log}
+	item, err := replayed.ByIndex(schema.Index{Index: 5})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("key5"), item.Key)
+	// the replayed tree is consistent with the root returned before the restart
+	safeItem, err := replayed.SafeGet(schema.SafeGetOptions{Key: []byte("last"), RootIndex: &schema.Index{Index: root.Index}})
+	assert.NoError(t, err)
+	assert.True(t, safeItem.Proof.Verify(safeItem.Item.Hash(), *root))
+	tree.Close()
+
+	// once flushed nothing is replayed
+	done, total = 0, 0
+	tree = newTreeStore(db.DB, 1000, log, func(d, t uint64) {
+		done, total = d, t
+	})
+	assert.Equal(t, uint64(14), tree.Width())
+	assert.Zero(t, total)
+	tree.Close()
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WriteValidator is invoked synchronously with all the entries of a write before they are committed, so that
+// business rules can reject data before it becomes immutable. Returning an error rejects the whole write.
+// References and sorted set members only point to entries already written, so they are not validated, neither are
+// the entries replayed by Restore.
+type WriteValidator interface {
+	Validate(entries []*schema.KeyValue) error
+}
+
+// validateWrite runs the validator when one of the entries has one of the validated prefixes, or on every write
+// when no prefix has been given
+func (t *Store) validateWrite(entries ...*schema.KeyValue) error {
+	if t.validator == nil || !t.validated(entries) {
+		return nil
+	}
+	err := t.validator.Validate(entries)
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Errorf(codes.FailedPrecondition, "write rejected: %v", err)
+}
+
+func (t *Store) validated(entries []*schema.KeyValue) bool {
+	if len(t.validatorPrefixes) == 0 {
+		return true
+	}
+	for _, kv := range entries {
+		for _, prefix := range t.validatorPrefixes {
+			if bytes.HasPrefix(kv.Key, prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/logger"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// positiveValidator rejects the writes having an entry whose value starts with a minus sign
+type positiveValidator struct {
+	calls int
+}
+
+func (v *positiveValidator) Validate(entries []*schema.KeyValue) error {
+	v.calls++
+	for _, kv := range entries {
+		if len(kv.Value) > 0 && kv.Value[0] == '-' {
+			return errors.New("negative amount")
+		}
+	}
+	return nil
+}
+
+func TestWriteValidator(t *testing.T) {
+	dir, err := ioutil.TempDir("", "immu_validator")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	validator := &positiveValidator{}
+	opts, badgerOpts := DefaultOptions(dir, logger.NewSimpleLoggerWithLevel("validator(immudb)", os.Stderr, logger.LogError))
+	st, err := Open(opts.WithWriteValidator(validator, []byte("account:")), badgerOpts)
+	require.NoError(t, err)
+	defer st.Close()
+
+	_, err = st.Set(schema.KeyValue{Key: []byte("account:1"), Value: []byte("10")})
+	require.NoError(t, err)
+	_, err = st.Set(schema.KeyValue{Key: []byte("account:1"), Value: []byte("-5")})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Equal(t, "write rejected: negative amount", status.Convert(err).Message())
+	item, err := st.Get(schema.Key{Key: []byte("account:1")})
+	require.NoError(t, err)
+	require.Equal(t, []byte("10"), item.Value)
+
+	// the whole batch is rejected when one of its entries is
+	_, err = st.SetBatch(schema.KVList{KVs: []*schema.KeyValue{
+		{Key: []byte("account:2"), Value: []byte("1")},
+		{Key: []byte("account:3"), Value: []byte("-1")},
+	}})
+	require.Error(t, err)
+	_, err = st.Get(schema.Key{Key: []byte("account:2")})
+	require.Equal(t, ErrKeyNotFound, err)
+
+	_, err = st.SafeSet(schema.SafeSetOptions{Kv: &schema.KeyValue{Key: []byte("account:4"), Value: []byte("-1")}})
+	require.Error(t, err)
+
+	// the writes without validated prefixes are not validated
+	calls := validator.calls
+	_, err = st.Set(schema.KeyValue{Key: []byte("note:1"), Value: []byte("-1")})
+	require.NoError(t, err)
+	require.Equal(t, calls, validator.calls)
+	_, err = st.SetBatch(schema.KVList{KVs: []*schema.KeyValue{
+		{Key: []byte("note:2"), Value: []byte("-1")},
+		{Key: []byte("account:5"), Value: []byte("1")},
+	}})
+	require.Error(t, err)
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verification
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+)
+
+// ErrVerificationFailed is returned when a proof does not verify
+var ErrVerificationFailed = errors.New("proof verification failed")
+
+// Uint64 decodes a uint64 encoded either as a JSON number or, as the REST gateway does, as a JSON string
+type Uint64 uint64
+
+// UnmarshalJSON ...
+func (u *Uint64) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	*u = Uint64(v)
+	return nil
+}
+
+// MarshalJSON encodes the value as a JSON string, like the REST gateway does
+func (u Uint64) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(strconv.FormatUint(uint64(u), 10))), nil
+}
+
+// JSONItem is the REST gateway encoding of an item
+type JSONItem struct {
+	Key   []byte `json:"key"`
+	Value []byte `json:"value"`
+	Index Uint64 `json:"index"`
+}
+
+// JSONProof is the REST gateway encoding of a proof
+type JSONProof struct {
+	Leaf            []byte   `json:"leaf"`
+	Index           Uint64   `json:"index"`
+	Root            []byte   `json:"root"`
+	At              Uint64   `json:"at"`
+	InclusionPath   [][]byte `json:"inclusionPath"`
+	ConsistencyPath [][]byte `json:"consistencyPath"`
+}
+
+// JSONSafeItem is the REST gateway encoding of a SafeGet response
+type JSONSafeItem struct {
+	Item  JSONItem  `json:"item"`
+	Proof JSONProof `json:"proof"`
+}
+
+// JSONRoot is the REST gateway encoding of a root
+type JSONRoot struct {
+	Root  []byte `json:"root"`
+	Index Uint64 `json:"index"`
+}
+
+// VerifySafeItemJSON verifies a SafeGet response returned by the REST gateway against the previously trusted
+// root, both JSON encoded. An empty prevRoot skips the consistency check.
+// On success it returns the JSON encoded root to be trusted for the next verification.
+func VerifySafeItemJSON(safeItem []byte, prevRoot []byte) ([]byte, error) {
+	var si JSONSafeItem
+	if err := json.Unmarshal(safeItem, &si); err != nil {
+		return nil, err
+	}
+	var pr JSONRoot
+	if len(prevRoot) > 0 {
+		if err := json.Unmarshal(prevRoot, &pr); err != nil {
+			return nil, err
+		}
+	}
+	if si.Proof.Index != si.Item.Index {
+		return nil, ErrVerificationFailed
+	}
+	leaf := Digest(uint64(si.Item.Index), si.Item.Key, si.Item.Value)
+	p := &Proof{
+		Leaf:            si.Proof.Leaf,
+		Index:           uint64(si.Proof.Index),
+		Root:            si.Proof.Root,
+		At:              uint64(si.Proof.At),
+		InclusionPath:   si.Proof.InclusionPath,
+		ConsistencyPath: si.Proof.ConsistencyPath,
+	}
+	if !p.Verify(leaf[:], Root{Index: uint64(pr.Index), Root: pr.Root}) {
+		return nil, ErrVerificationFailed
+	}
+	return json.Marshal(&JSONRoot{Root: p.Root, Index: si.Proof.At})
+}
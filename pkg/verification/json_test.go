@@ -0,0 +1,65 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verification
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/codenotary/merkletree"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifySafeItemJSON(t *testing.T) {
+	store, _ := buildTree(10)
+	root := merkletree.Root(store)
+	prev, _ := buildTree(4)
+	prevRoot := merkletree.Root(prev)
+	leaf := Digest(5, []byte("5"), []byte("value"))
+
+	safeItem, err := json.Marshal(&JSONSafeItem{
+		Item: JSONItem{Key: []byte("5"), Value: []byte("value"), Index: 5},
+		Proof: JSONProof{
+			Leaf:            leaf[:],
+			Index:           5,
+			Root:            root[:],
+			At:              9,
+			InclusionPath:   merkletree.InclusionProof(store, 9, 5).ToSlice(),
+			ConsistencyPath: merkletree.ConsistencyProof(store, 9, 3).ToSlice(),
+		},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, string(safeItem), `"at":"9"`)
+
+	newRoot, err := VerifySafeItemJSON(safeItem, nil)
+	assert.NoError(t, err)
+	var jr JSONRoot
+	assert.NoError(t, json.Unmarshal(newRoot, &jr))
+	assert.Equal(t, Uint64(9), jr.Index)
+	assert.Equal(t, root[:], jr.Root)
+
+	trusted, _ := json.Marshal(&JSONRoot{Root: prevRoot[:], Index: 3})
+	_, err = VerifySafeItemJSON(safeItem, trusted)
+	assert.NoError(t, err)
+
+	untrusted := []byte(`{"root":"` + "AAAA" + `","index":3}`)
+	_, err = VerifySafeItemJSON(safeItem, untrusted)
+	assert.Equal(t, ErrVerificationFailed, err)
+
+	_, err = VerifySafeItemJSON([]byte(`{`), nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package verification verifies immudb inclusion and consistency proofs.
+// It only depends on the standard library, so it can be used where the gRPC client and the store can not be built,
+// e.g. on embedded devices or in WASM builds.
+package verification
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// Prefixes for leaves and nodes, as defined by RFC 6962
+const (
+	LeafPrefix = byte(0)
+	NodePrefix = byte(1)
+)
+
+// Root is the merkle tree root at a given index
+type Root struct {
+	Index uint64
+	Root  []byte
+}
+
+// Proof proves that Leaf is included at Index into the tree whose last index is At and, through ConsistencyPath,
+// that a previous root is included into Root's history
+type Proof struct {
+	Leaf            []byte
+	Index           uint64
+	Root            []byte
+	At              uint64
+	InclusionPath   [][]byte
+	ConsistencyPath [][]byte
+}
+
+// Digest returns the hash computed from the union of item's members.
+func Digest(index uint64, key, value []byte) [sha256.Size]byte {
+	kl, vl := len(key), len(value)
+	c := make([]byte, 1+8+8+kl+vl)
+	c[0] = LeafPrefix
+	binary.BigEndian.PutUint64(c[1:1+8], index)
+	binary.BigEndian.PutUint64(c[1+8:1+8+8], uint64(kl))
+	copy(c[1+8+8:], key)
+	copy(c[1+8+8+kl:], value)
+	return sha256.Sum256(c)
+}
+
+// Verify returns true iff the proof proves that the given leaf is included into p.Root's history at position p.Index
+// and that the provided prevRoot is included into p.Root's history.
+// Providing a zerovalue for prevRoot signals that no previous root is available, thus consistency proof will be skipped.
+func (p *Proof) Verify(leaf []byte, prevRoot Root) bool {
+	if p == nil || !bytes.Equal(leaf, p.Leaf) {
+		return false
+	}
+	if !VerifyInclusion(p.InclusionPath, p.At, p.Index, p.Root, p.Leaf) {
+		return false
+	}
+	// we cannot check consistency when the previous root is not provided
+	if prevRoot.Index == 0 && len(prevRoot.Root) == 0 {
+		return true
+	}
+	return VerifyConsistency(p.ConsistencyPath, p.At, prevRoot.Index, p.Root, prevRoot.Root)
+}
+
+// VerifyInclusion returns true when path proves that the given leaf is the (i+1)th leaf
+// of the tree defined by root and width = (at + 1), otherwise false.
+func VerifyInclusion(path [][]byte, at, i uint64, root, leaf []byte) bool {
+	if i > at || (at > 0 && len(path) == 0) {
+		return false
+	}
+
+	h := toHash(leaf)
+	for _, v := range path {
+		c := [sha256.Size*2 + 1]byte{NodePrefix}
+		if i%2 == 0 && i != at {
+			copy(c[1:], h[:])
+			copy(c[sha256.Size+1:], v)
+		} else {
+			copy(c[1:], v)
+			copy(c[sha256.Size+1:], h[:])
+		}
+		h = sha256.Sum256(c[:])
+		i /= 2
+		at /= 2
+	}
+
+	return at == i && h == toHash(root)
+}
+
+// VerifyConsistency returns true when path proves that the first (first+1) inputs are equal in both
+// (sub-)trees described by firstRoot and secondRoot, respectively constructed up to the (first+1)th leaf
+// and the (second+1)th leaf, otherwise false.
+func VerifyConsistency(path [][]byte, second, first uint64, secondRoot, firstRoot []byte) bool {
+	secondHash, firstHash := toHash(secondRoot), toHash(firstRoot)
+
+	l := len(path)
+	if first == second && firstHash == secondHash && l == 0 {
+		return true
+	}
+
+	if !(first < second) || l == 0 {
+		return false
+	}
+
+	pp := make([][sha256.Size]byte, 0, l+1)
+	if isPowerOfTwo(first + 1) {
+		pp = append(pp, firstHash)
+	}
+	for _, v := range path {
+		pp = append(pp, toHash(v))
+	}
+
+	fn := first
+	sn := second
+
+	for fn%2 == 1 {
+		fn >>= 1
+		sn >>= 1
+	}
+
+	fr, sr := pp[0], pp[0]
+
+	tmp := [sha256.Size*2 + 1]byte{NodePrefix}
+	for step, c := range pp {
+		if step == 0 {
+			continue
+		}
+		if sn == 0 {
+			return false
+		}
+		if fn%2 == 1 || fn == sn {
+			copy(tmp[1:], c[:])
+
+			copy(tmp[sha256.Size+1:], fr[:])
+			fr = sha256.Sum256(tmp[:])
+
+			copy(tmp[sha256.Size+1:], sr[:])
+			sr = sha256.Sum256(tmp[:])
+
+			for fn%2 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			copy(tmp[1:], sr[:])
+			copy(tmp[sha256.Size+1:], c[:])
+			sr = sha256.Sum256(tmp[:])
+		}
+		fn >>= 1
+		sn >>= 1
+	}
+
+	return fr == firstHash && sr == secondHash && sn == 0
+}
+
+func toHash(b []byte) (h [sha256.Size]byte) {
+	copy(h[:], b)
+	return
+}
+
+func isPowerOfTwo(x uint64) bool {
+	return (x != 0) && ((x & (x - 1)) == 0)
+}
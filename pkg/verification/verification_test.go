@@ -0,0 +1,90 @@
+/*
+Copyright 2019-2020 vChain, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verification
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/codenotary/merkletree"
+	"github.com/stretchr/testify/assert"
+)
+
+// index=1, key=key, value=value
+var testLeaf = [32]uint8{0x62, 0x2e, 0x82, 0xa6, 0x42, 0x48, 0x2c, 0x58, 0x96, 0x92, 0x3, 0xba, 0xda, 0x74, 0x40, 0x97, 0xc9, 0xdf, 0xff, 0x2f, 0xf3, 0x14, 0x36, 0xc7, 0xd9, 0x57, 0x21, 0x73, 0x7c, 0x5e, 0xed, 0xa9}
+
+func TestDigest(t *testing.T) {
+	assert.Equal(t, testLeaf, Digest(1, []byte(`key`), []byte(`value`)))
+}
+
+func buildTree(n uint64) (merkletree.Storer, [][]byte) {
+	store := merkletree.NewMemStore()
+	var leaves [][]byte
+	for i := uint64(0); i < n; i++ {
+		leaf := Digest(i, []byte(strconv.FormatUint(i, 10)), []byte("value"))
+		leaves = append(leaves, append([]byte{}, leaf[:]...))
+		merkletree.AppendHash(store, &leaf)
+	}
+	return store, leaves
+}
+
+func TestVerifyInclusionAndConsistency(t *testing.T) {
+	const n = 17
+	roots := make([][]byte, n)
+	for w := uint64(1); w <= n; w++ {
+		store, _ := buildTree(w)
+		root := merkletree.Root(store)
+		roots[w-1] = root[:]
+	}
+	store, leaves := buildTree(n)
+	for at := uint64(0); at < n; at++ {
+		for i := uint64(0); i <= at; i++ {
+			path := merkletree.InclusionProof(store, at, i).ToSlice()
+			assert.True(t, VerifyInclusion(path, at, i, roots[at], leaves[i]), "inclusion of %d at %d", i, at)
+			assert.False(t, VerifyInclusion(path, at, i, roots[at], leaves[(i+1)%n]))
+
+			path = merkletree.ConsistencyProof(store, at, i).ToSlice()
+			assert.True(t, VerifyConsistency(path, at, i, roots[at], roots[i]), "consistency of %d with %d", i, at)
+			if i < at {
+				assert.False(t, VerifyConsistency(path, at, i, roots[at], roots[at]))
+			}
+		}
+	}
+}
+
+func TestProofVerify(t *testing.T) {
+	store, leaves := buildTree(10)
+	root := merkletree.Root(store)
+	prev, _ := buildTree(4)
+	prevRoot := merkletree.Root(prev)
+
+	p := &Proof{
+		Leaf:            leaves[5],
+		Index:           5,
+		Root:            root[:],
+		At:              9,
+		InclusionPath:   merkletree.InclusionProof(store, 9, 5).ToSlice(),
+		ConsistencyPath: merkletree.ConsistencyProof(store, 9, 3).ToSlice(),
+	}
+	assert.True(t, p.Verify(leaves[5], Root{}))
+	assert.True(t, p.Verify(leaves[5], Root{Index: 3, Root: prevRoot[:]}))
+	assert.False(t, p.Verify(leaves[4], Root{}))
+	assert.False(t, p.Verify(leaves[5], Root{Index: 3, Root: root[:]}))
+
+	var nilProof *Proof
+	assert.False(t, nilProof.Verify(leaves[5], Root{}))
+}
@@ -17,16 +17,13 @@ limitations under the License.
 package suite
 
 import (
-	"bytes"
-	"context"
 	"fmt"
-	"io"
 	"io/ioutil"
 	"os"
-	"strconv"
 
 	"github.com/codenotary/immudb/pkg/bm"
 	"github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/client/loadgen"
 	"github.com/codenotary/immudb/pkg/server"
 )
 
@@ -46,71 +43,39 @@ var immuClient, _ = client.NewImmuClient(client.DefaultOptions())
 
 // RPCBenchmarks ...
 var RPCBenchmarks = []bm.Bm{
-	makeRPCBenchmark("sequential write", Concurrency, Iterations, sequentialSet),
-	makeRPCBenchmark("batch write", Concurrency, Iterations, batchSet),
-	makeRPCBenchmark("batch write no concurrency", 1, Iterations, batchSet),
+	makeRPCBenchmark("sequential write", Concurrency, Iterations, loadgen.SetOp(loadgen.SequentialKeys(""), len(V))),
+	makeRPCBenchmark("batch write", Concurrency, Iterations/BatchSize, loadgen.SetBatchOp(loadgen.SequentialKeys(""), BatchSize, len(V))),
+	makeRPCBenchmark("batch write no concurrency", 1, Iterations/BatchSize, loadgen.SetBatchOp(loadgen.SequentialKeys(""), BatchSize, len(V))),
 }
 
-func sequentialSet(bm *bm.Bm, start int, end int) error {
-	for i := start; i < end; i++ {
-		key := []byte(strconv.FormatUint(uint64(i), 10))
-		if _, err := immuClient.Set(context.Background(), key, V); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func batchSet(bm *bm.Bm, start int, end int) error {
-	var keyReaders []io.Reader
-	var valueReaders []io.Reader
-	for i := start; i < end; i++ {
-		key := []byte(strconv.FormatUint(uint64(i), 10))
-		keyReaders = append(keyReaders, bytes.NewReader(key))
-		valueReaders = append(valueReaders, bytes.NewReader(V))
-		if i%BatchSize == 0 || i == end-1 {
-			if _, err := immuClient.SetBatch(context.Background(), &client.BatchRequest{
-				Keys:   keyReaders,
-				Values: valueReaders,
-			}); err != nil {
-				return err
-			}
-			keyReaders = nil
-			valueReaders = nil
-		}
-	}
-	return nil
-}
-
-func makeRPCBenchmark(name string, concurrency int, iterations int,
-	work func(bm *bm.Bm, start int, end int) error) bm.Bm {
-	return bm.Bm{
-		CreateStore: false,
+func makeRPCBenchmark(name string, concurrency int, operations int, op loadgen.Op) bm.Bm {
+	b := loadgen.Benchmark(immuClient, loadgen.Workload{
 		Name:        name,
 		Concurrency: concurrency,
-		Iterations:  iterations,
-		Before: func(bm *bm.Bm) {
-			go func() {
-				if err := immuServer.Start(); err != nil {
-					_, _ = fmt.Fprintln(os.Stderr, err)
-					os.Exit(1)
-				}
-			}()
-		},
-		After: func(bm *bm.Bm) {
-			if err := immuClient.Disconnect(); err != nil {
+		Operations:  operations,
+		Op:          op,
+	})
+	b.Before = func(bm *bm.Bm) {
+		go func() {
+			if err := immuServer.Start(); err != nil {
 				_, _ = fmt.Fprintln(os.Stderr, err)
 				os.Exit(1)
 			}
-			if err := immuServer.Stop(); err != nil {
-				_, _ = fmt.Fprintln(os.Stderr, err)
-				os.Exit(1)
-			}
-			if err := os.RemoveAll(tmpDir); err != nil {
-				_, _ = fmt.Fprintln(os.Stderr, err)
-				os.Exit(1)
-			}
-		},
-		Work: work,
+		}()
+	}
+	b.After = func(bm *bm.Bm) {
+		if err := immuClient.Disconnect(); err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		if err := immuServer.Stop(); err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		if err := os.RemoveAll(tmpDir); err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
 	}
+	return b
 }